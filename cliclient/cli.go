@@ -1,12 +1,15 @@
 package cliClient
 
 import (
+	"errors"
 	"fmt"
 	"html/template"
 	"math"
 	"os"
+	"sort"
 	"strconv"
 	"strings"
+	"time"
 
 	sbRadio "github.com/dh1tw/remoteRadio/sb_radio"
 	"github.com/dh1tw/remoteRadio/utils"
@@ -55,6 +58,16 @@ func (r *remoteRadio) populateCliCmds() {
 
 	r.cliCmds = append(r.cliCmds, cliGetMode)
 
+	cliQsy := cliCmd{
+		Cmd:         qsy,
+		Name:        "qsy",
+		Parameters:  "Frequency [kHz], Mode and optionally Filter bandwidth [Hz]",
+		Description: "Change Frequency, Mode and Filter together (all or nothing)",
+		Example:     "qsy 14074 PKTUSB 3000",
+	}
+
+	r.cliCmds = append(r.cliCmds, cliQsy)
+
 	cliSetVfo := cliCmd{
 		Cmd:         setVfo,
 		Name:        "set_vfo",
@@ -226,6 +239,126 @@ func (r *remoteRadio) populateCliCmds() {
 
 	r.cliCmds = append(r.cliCmds, cliGetTuningStep)
 
+	cliSetCtcssTone := cliCmd{
+		Cmd:         setCtcssTone,
+		Name:        "set_ctcss_tone",
+		Shortcut:    "C",
+		Parameters:  "CTCSS Tone [Hz] or 0 (off)",
+		Description: "Set the CTCSS tone which is transmitted",
+		Example:     "C 88.5",
+	}
+
+	r.cliCmds = append(r.cliCmds, cliSetCtcssTone)
+
+	cliGetCtcssTone := cliCmd{
+		Cmd:         getCtcssTone,
+		Name:        "get_ctcss_tone",
+		Shortcut:    "c",
+		Description: "Get the CTCSS tone which is transmitted",
+	}
+
+	r.cliCmds = append(r.cliCmds, cliGetCtcssTone)
+
+	cliSetCtcssSql := cliCmd{
+		Cmd:         setCtcssSql,
+		Name:        "set_ctcss_sql",
+		Shortcut:    "",
+		Parameters:  "CTCSS Tone [Hz] or 0 (off)",
+		Description: "Set the CTCSS tone squelch",
+		Example:     "set_ctcss_sql 88.5",
+	}
+
+	r.cliCmds = append(r.cliCmds, cliSetCtcssSql)
+
+	cliGetCtcssSql := cliCmd{
+		Cmd:         getCtcssSql,
+		Name:        "get_ctcss_sql",
+		Shortcut:    "",
+		Description: "Get the CTCSS tone squelch",
+	}
+
+	r.cliCmds = append(r.cliCmds, cliGetCtcssSql)
+
+	cliSetDcsCode := cliCmd{
+		Cmd:         setDcsCode,
+		Name:        "set_dcs_code",
+		Shortcut:    "D",
+		Parameters:  "DCS Code or 0 (off)",
+		Description: "Set the DCS code which is transmitted",
+		Example:     "D 023",
+	}
+
+	r.cliCmds = append(r.cliCmds, cliSetDcsCode)
+
+	cliGetDcsCode := cliCmd{
+		Cmd:         getDcsCode,
+		Name:        "get_dcs_code",
+		Shortcut:    "d",
+		Description: "Get the DCS code which is transmitted",
+	}
+
+	r.cliCmds = append(r.cliCmds, cliGetDcsCode)
+
+	cliSetDcsSql := cliCmd{
+		Cmd:         setDcsSql,
+		Name:        "set_dcs_sql",
+		Shortcut:    "",
+		Parameters:  "DCS Code or 0 (off)",
+		Description: "Set the DCS squelch",
+		Example:     "set_dcs_sql 023",
+	}
+
+	r.cliCmds = append(r.cliCmds, cliSetDcsSql)
+
+	cliGetDcsSql := cliCmd{
+		Cmd:         getDcsSql,
+		Name:        "get_dcs_sql",
+		Shortcut:    "",
+		Description: "Get the DCS squelch",
+	}
+
+	r.cliCmds = append(r.cliCmds, cliGetDcsSql)
+
+	cliSetRptrShift := cliCmd{
+		Cmd:         setRptrShift,
+		Name:        "set_rptr_shift",
+		Shortcut:    "R",
+		Parameters:  "Repeater Shift (+, - or NONE)",
+		Description: "Set the repeater shift",
+		Example:     "R -",
+	}
+
+	r.cliCmds = append(r.cliCmds, cliSetRptrShift)
+
+	cliGetRptrShift := cliCmd{
+		Cmd:         getRptrShift,
+		Name:        "get_rptr_shift",
+		Shortcut:    "r",
+		Description: "Get the repeater shift",
+	}
+
+	r.cliCmds = append(r.cliCmds, cliGetRptrShift)
+
+	cliSetRptrOffs := cliCmd{
+		Cmd:         setRptrOffs,
+		Name:        "set_rptr_offs",
+		Shortcut:    "O",
+		Parameters:  "Repeater Offset [Hz]",
+		Description: "Set the repeater offset",
+		Example:     "O 600000",
+	}
+
+	r.cliCmds = append(r.cliCmds, cliSetRptrOffs)
+
+	cliGetRptrOffs := cliCmd{
+		Cmd:         getRptrOffs,
+		Name:        "get_rptr_offs",
+		Shortcut:    "o",
+		Description: "Get the repeater offset [Hz]",
+	}
+
+	r.cliCmds = append(r.cliCmds, cliGetRptrOffs)
+
 	cliSetPowerStat := cliCmd{
 		Cmd:         setPowerStat,
 		Name:        "set_powerstat",
@@ -319,6 +452,150 @@ func (r *remoteRadio) populateCliCmds() {
 
 	r.cliCmds = append(r.cliCmds, cliGetPollingInterval)
 
+	cliGetMeters := cliCmd{
+		Cmd:         getMeters,
+		Name:        "get_meters",
+		Shortcut:    "",
+		Description: "Get the latest meter readings (S-Meter while receiving; Power, SWR, ALC... while transmitting)",
+	}
+
+	r.cliCmds = append(r.cliCmds, cliGetMeters)
+
+	cliMemList := cliCmd{
+		Cmd:         memList,
+		Name:        "mem_list",
+		Shortcut:    "",
+		Description: "List all memory channels which are in use",
+	}
+
+	r.cliCmds = append(r.cliCmds, cliMemList)
+
+	cliMemRead := cliCmd{
+		Cmd:         memRead,
+		Name:        "mem_read",
+		Shortcut:    "",
+		Parameters:  "Channel",
+		Description: "Read a memory channel",
+		Example:     "mem_read 12",
+	}
+
+	r.cliCmds = append(r.cliCmds, cliMemRead)
+
+	cliMemWrite := cliCmd{
+		Cmd:         memWrite,
+		Name:        "mem_write",
+		Shortcut:    "",
+		Parameters:  "Channel, Frequency [kHz], Mode, [name=, tx=[kHz], txmode=, ctcss=[Hz], ctcss_sql=[Hz], dcs=, dcs_sql=]",
+		Description: "Write a memory channel",
+		Example:     "mem_write 12 145600 FM name=DB0XYZ tx=145000 ctcss=88.5",
+	}
+
+	r.cliCmds = append(r.cliCmds, cliMemWrite)
+
+	cliMemClear := cliCmd{
+		Cmd:         memClear,
+		Name:        "mem_clear",
+		Shortcut:    "",
+		Parameters:  "Channel",
+		Description: "Clear a memory channel",
+		Example:     "mem_clear 12",
+	}
+
+	r.cliCmds = append(r.cliCmds, cliMemClear)
+
+	cliMemRecall := cliCmd{
+		Cmd:         memRecall,
+		Name:        "mem_recall",
+		Shortcut:    "",
+		Parameters:  "Channel",
+		Description: "Load a memory channel into the current VFO",
+		Example:     "mem_recall 12",
+	}
+
+	r.cliCmds = append(r.cliCmds, cliMemRecall)
+
+	cliLock := cliCmd{
+		Cmd:         lock,
+		Name:        "lock",
+		Shortcut:    "",
+		Parameters:  "[takeover]",
+		Description: "Acquire exclusive control of the radio (admins can take over)",
+		Example:     "lock takeover",
+	}
+
+	r.cliCmds = append(r.cliCmds, cliLock)
+
+	cliGetLock := cliCmd{
+		Cmd:         getLock,
+		Name:        "get_lock",
+		Shortcut:    "",
+		Description: "Print who holds exclusive control of the radio",
+	}
+
+	r.cliCmds = append(r.cliCmds, cliGetLock)
+
+	cliUnlock := cliCmd{
+		Cmd:         unlock,
+		Name:        "unlock",
+		Shortcut:    "",
+		Description: "Release exclusive control of the radio",
+	}
+
+	r.cliCmds = append(r.cliCmds, cliUnlock)
+
+	cliCw := cliCmd{
+		Cmd:         cw,
+		Name:        "cw",
+		Shortcut:    "",
+		Parameters:  "Text",
+		Description: "Send a CW message through the keyer of the radio",
+		Example:     "cw TU 5NN 14",
+	}
+
+	r.cliCmds = append(r.cliCmds, cliCw)
+
+	cliCwMacro := cliCmd{
+		Cmd:         cwMacro,
+		Name:        "cw_macro",
+		Shortcut:    "",
+		Parameters:  "[Macro]",
+		Description: "Send a stored CW message (see [cw.macros]); lists the macros without parameter",
+		Example:     "cw_macro cq",
+	}
+
+	r.cliCmds = append(r.cliCmds, cliCwMacro)
+
+	cliCwStop := cliCmd{
+		Cmd:         cwStop,
+		Name:        "cw_stop",
+		Shortcut:    "",
+		Description: "Abort the CW message in progress",
+	}
+
+	r.cliCmds = append(r.cliCmds, cliCwStop)
+
+	cliSetCwSpeed := cliCmd{
+		Cmd:         setCwSpeed,
+		Name:        "set_cw_speed",
+		Shortcut:    "",
+		Parameters:  "WPM (0 = keep the radio's speed)",
+		Description: "Set the speed of the following CW messages",
+		Example:     "set_cw_speed 28",
+	}
+
+	r.cliCmds = append(r.cliCmds, cliSetCwSpeed)
+
+	cliSetLogLevel := cliCmd{
+		Cmd:         setLogLevel,
+		Name:        "set_log_level",
+		Shortcut:    "",
+		Parameters:  "Level [0-5, NONE, BUG, ERR, WARN, VERBOSE, TRACE]",
+		Description: "Set the level of the rig's debug output forwarded by the server",
+		Example:     "set_log_level TRACE",
+	}
+
+	r.cliCmds = append(r.cliCmds, cliSetLogLevel)
+
 	cliSetPrintUpdates := cliCmd{
 		Cmd:         setPrintRigUpdates,
 		Name:        "set_print_rig_updates",
@@ -329,6 +606,15 @@ func (r *remoteRadio) populateCliCmds() {
 
 	r.cliCmds = append(r.cliCmds, cliSetPrintUpdates)
 
+	cliGetPending := cliCmd{
+		Cmd:         getPending,
+		Name:        "get_pending",
+		Shortcut:    "",
+		Description: "Print the requests which haven't been acknowledged by the server",
+	}
+
+	r.cliCmds = append(r.cliCmds, cliGetPending)
+
 	cliDumpCaps := cliCmd{
 		Cmd:         dumpCaps,
 		Name:        "dump_caps",
@@ -459,6 +745,59 @@ func setMode(r *remoteRadio, args []string) {
 	}
 }
 
+// qsy sets the frequency, the mode and optionally the filter in one
+// atomic request; if the radio rejects any of them, none are applied.
+func qsy(r *remoteRadio, args []string) {
+
+	if len(args) < 2 || len(args) > 3 {
+		fmt.Println("ERROR: Wrong number of arguments")
+		return
+	}
+
+	freq, err := strconv.ParseFloat(args[0], 10)
+	if err != nil {
+		fmt.Println("ERROR: Frequency [kHz] must be float")
+		return
+	}
+
+	mode := strings.ToUpper(args[1])
+
+	if ok := utils.StringInSlice(mode, r.caps.Modes); !ok {
+		fmt.Println("ERROR: Unsupported Mode")
+		return
+	}
+
+	req := r.initSetState()
+	req.Atomic = true
+	req.Vfo.Frequency = freq * 1000
+	req.Md.HasFrequency = true
+	req.Vfo.Mode = mode
+	req.Md.HasMode = true
+
+	if len(args) == 3 {
+
+		pbWidth, err := strconv.ParseInt(args[2], 10, 32)
+		if err != nil {
+			fmt.Println("ERROR: Filter width [Hz] must be integer")
+			return
+		}
+
+		filters, ok := r.caps.Filters[mode]
+		if !ok {
+			fmt.Println("WARN: No Filters found for this Mode in Rig Caps")
+		}
+		if ok := utils.Int32InSlice(int32(pbWidth), filters.Value); !ok {
+			fmt.Println("WARN: unspported passband width")
+		}
+		req.Vfo.PbWidth = int32(pbWidth)
+		req.Md.HasPbWidth = true
+	}
+
+	if err := r.sendCatRequest(req); err != nil {
+		fmt.Println("ERROR:", err)
+	}
+}
+
 func getVfo(r *remoteRadio, args []string) {
 	fmt.Println("Current Vfo:", r.state.CurrentVfo)
 }
@@ -862,10 +1201,189 @@ func setTuningStep(r *remoteRadio, args []string) {
 	}
 }
 
+func getCtcssTone(r *remoteRadio, args []string) {
+	fmt.Println("CTCSS Tone:", formatCtcssTone(r.state.Vfo.CtcssTone))
+}
+
+func setCtcssTone(r *remoteRadio, args []string) {
+	if !checkArgs(args, 1) {
+		return
+	}
+
+	tone, err := parseCtcssTone(args[0])
+	if err != nil {
+		fmt.Println("ERROR:", err)
+		return
+	}
+
+	if tone != 0 && !utils.Uint32InSlice(tone, r.caps.CtcssList) {
+		fmt.Println("WARN: CTCSS tone not supported by Rig")
+	}
+
+	req := r.initSetState()
+	req.Vfo.CtcssTone = tone
+	req.Md.HasCtcssTone = true
+
+	if err := r.sendCatRequest(req); err != nil {
+		fmt.Println("ERROR:", err)
+	}
+}
+
+func getCtcssSql(r *remoteRadio, args []string) {
+	fmt.Println("CTCSS Squelch:", formatCtcssTone(r.state.Vfo.CtcssSql))
+}
+
+func setCtcssSql(r *remoteRadio, args []string) {
+	if !checkArgs(args, 1) {
+		return
+	}
+
+	tone, err := parseCtcssTone(args[0])
+	if err != nil {
+		fmt.Println("ERROR:", err)
+		return
+	}
+
+	if tone != 0 && !utils.Uint32InSlice(tone, r.caps.CtcssList) {
+		fmt.Println("WARN: CTCSS tone not supported by Rig")
+	}
+
+	req := r.initSetState()
+	req.Vfo.CtcssSql = tone
+	req.Md.HasCtcssSql = true
+
+	if err := r.sendCatRequest(req); err != nil {
+		fmt.Println("ERROR:", err)
+	}
+}
+
+func getDcsCode(r *remoteRadio, args []string) {
+	fmt.Println("DCS Code:", formatDcsCode(r.state.Vfo.DcsCode))
+}
+
+func setDcsCode(r *remoteRadio, args []string) {
+	if !checkArgs(args, 1) {
+		return
+	}
+
+	code, err := strconv.ParseUint(args[0], 10, 32)
+	if err != nil {
+		fmt.Println("ERROR: DCS code must be a positive integer")
+		return
+	}
+
+	if code != 0 && !utils.Uint32InSlice(uint32(code), r.caps.DcsList) {
+		fmt.Println("WARN: DCS code not supported by Rig")
+	}
+
+	req := r.initSetState()
+	req.Vfo.DcsCode = uint32(code)
+	req.Md.HasDcsCode = true
+
+	if err := r.sendCatRequest(req); err != nil {
+		fmt.Println("ERROR:", err)
+	}
+}
+
+func getDcsSql(r *remoteRadio, args []string) {
+	fmt.Println("DCS Squelch:", formatDcsCode(r.state.Vfo.DcsSql))
+}
+
+func setDcsSql(r *remoteRadio, args []string) {
+	if !checkArgs(args, 1) {
+		return
+	}
+
+	code, err := strconv.ParseUint(args[0], 10, 32)
+	if err != nil {
+		fmt.Println("ERROR: DCS code must be a positive integer")
+		return
+	}
+
+	if code != 0 && !utils.Uint32InSlice(uint32(code), r.caps.DcsList) {
+		fmt.Println("WARN: DCS code not supported by Rig")
+	}
+
+	req := r.initSetState()
+	req.Vfo.DcsSql = uint32(code)
+	req.Md.HasDcsSql = true
+
+	if err := r.sendCatRequest(req); err != nil {
+		fmt.Println("ERROR:", err)
+	}
+}
+
+func getRptrShift(r *remoteRadio, args []string) {
+	fmt.Println("Repeater Shift:", r.state.Vfo.RptrShift)
+}
+
+func setRptrShift(r *remoteRadio, args []string) {
+	if !checkArgs(args, 1) {
+		return
+	}
+
+	shift := strings.ToUpper(args[0])
+	switch shift {
+	case "NONE", "+", "-":
+	default:
+		fmt.Println("ERROR: Repeater shift must be +, - or NONE")
+		return
+	}
+
+	req := r.initSetState()
+	req.Vfo.RptrShift = shift
+	req.Md.HasRptrShift = true
+
+	if err := r.sendCatRequest(req); err != nil {
+		fmt.Println("ERROR:", err)
+	}
+}
+
+func getRptrOffs(r *remoteRadio, args []string) {
+	fmt.Printf("Repeater Offset: %dHz\n", r.state.Vfo.RptrOffset)
+}
+
+func setRptrOffs(r *remoteRadio, args []string) {
+	if !checkArgs(args, 1) {
+		return
+	}
+
+	offs, err := strconv.ParseUint(args[0], 10, 31)
+	if err != nil {
+		fmt.Println("ERROR: Repeater offset [Hz] must be a positive integer")
+		return
+	}
+
+	req := r.initSetState()
+	req.Vfo.RptrOffset = int32(offs)
+	req.Md.HasRptrOffset = true
+
+	if err := r.sendCatRequest(req); err != nil {
+		fmt.Println("ERROR:", err)
+	}
+}
+
 func getPollingInterval(r *remoteRadio, args []string) {
 	fmt.Printf("Rig polling interval: %dms\n", r.state.PollingInterval)
 }
 
+func getMeters(r *remoteRadio, args []string) {
+	if len(r.meters.Values) == 0 {
+		fmt.Println("no meter readings received")
+		return
+	}
+	names := make([]string, 0, len(r.meters.Values))
+	for name := range r.meters.Values {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	age := time.Since(time.Unix(0, r.meters.Timestamp)) / time.Millisecond
+	fmt.Printf("Meters (PTT: %v, %dms ago):\n", r.meters.Ptt, age)
+	for _, name := range names {
+		fmt.Printf("  %s: %.2f\n", name, r.meters.Values[name])
+	}
+}
+
 func setPollingInterval(r *remoteRadio, args []string) {
 	if !checkArgs(args, 1) {
 		return
@@ -887,6 +1405,259 @@ func setPollingInterval(r *remoteRadio, args []string) {
 	}
 }
 
+func memList(r *remoteRadio, args []string) {
+	if err := r.sendMemoryRequest("list", 0, nil); err != nil {
+		fmt.Println("ERROR:", err)
+	}
+}
+
+func memRead(r *remoteRadio, args []string) {
+	if ok := checkArgs(args, 1); !ok {
+		return
+	}
+
+	ch, err := strconv.Atoi(args[0])
+	if err != nil {
+		fmt.Println("ERROR: channel must be of type int")
+		return
+	}
+
+	if err := r.sendMemoryRequest("read", int32(ch), nil); err != nil {
+		fmt.Println("ERROR:", err)
+	}
+}
+
+func memWrite(r *remoteRadio, args []string) {
+	if len(args) < 3 {
+		fmt.Println("ERROR: Wrong number of arguments")
+		return
+	}
+
+	ch, err := strconv.Atoi(args[0])
+	if err != nil {
+		fmt.Println("ERROR: channel must be of type int")
+		return
+	}
+
+	data, err := parseMemChannel(args[1:])
+	if err != nil {
+		fmt.Println("ERROR:", err)
+		return
+	}
+
+	if !utils.StringInSlice(data.Mode, r.caps.Modes) {
+		fmt.Println("ERROR: unsupported mode")
+		return
+	}
+
+	if err := r.sendMemoryRequest("write", int32(ch), &data); err != nil {
+		fmt.Println("ERROR:", err)
+	}
+}
+
+func memClear(r *remoteRadio, args []string) {
+	if ok := checkArgs(args, 1); !ok {
+		return
+	}
+
+	ch, err := strconv.Atoi(args[0])
+	if err != nil {
+		fmt.Println("ERROR: channel must be of type int")
+		return
+	}
+
+	if err := r.sendMemoryRequest("clear", int32(ch), nil); err != nil {
+		fmt.Println("ERROR:", err)
+	}
+}
+
+func memRecall(r *remoteRadio, args []string) {
+	if ok := checkArgs(args, 1); !ok {
+		return
+	}
+
+	ch, err := strconv.Atoi(args[0])
+	if err != nil {
+		fmt.Println("ERROR: channel must be of type int")
+		return
+	}
+
+	if err := r.sendMemoryRequest("recall", int32(ch), nil); err != nil {
+		fmt.Println("ERROR:", err)
+	}
+}
+
+// parseMemChannel parses the frequency [kHz], the mode and the optional
+// key=value settings of a memory channel.
+func parseMemChannel(args []string) (sbRadio.Channel, error) {
+
+	c := sbRadio.Channel{}
+	c.Split = &sbRadio.Split{}
+
+	freq, err := strconv.ParseFloat(args[0], 64)
+	if err != nil {
+		return c, errors.New("frequency [kHz] must be float")
+	}
+	c.Frequency = freq * 1000
+	c.Mode = strings.ToUpper(args[1])
+
+	for _, arg := range args[2:] {
+		kv := strings.SplitN(arg, "=", 2)
+		if len(kv) != 2 {
+			return c, fmt.Errorf("invalid setting '%s' (expected key=value)", arg)
+		}
+		key, value := kv[0], kv[1]
+
+		switch key {
+		case "name":
+			c.Name = value
+		case "tx":
+			txFreq, err := strconv.ParseFloat(value, 64)
+			if err != nil {
+				return c, errors.New("tx frequency [kHz] must be float")
+			}
+			c.Split.Enabled = true
+			c.Split.Frequency = txFreq * 1000
+			if c.Split.Mode == "" {
+				c.Split.Mode = c.Mode
+			}
+		case "txmode":
+			c.Split.Mode = strings.ToUpper(value)
+		case "ctcss", "ctcss_sql":
+			tone, err := strconv.ParseFloat(value, 64)
+			if err != nil {
+				return c, fmt.Errorf("%s [Hz] must be float", key)
+			}
+			if key == "ctcss" {
+				c.CtcssTone = uint32(tone*10 + 0.5)
+			} else {
+				c.CtcssSql = uint32(tone*10 + 0.5)
+			}
+		case "dcs", "dcs_sql":
+			code, err := strconv.ParseUint(value, 10, 32)
+			if err != nil {
+				return c, fmt.Errorf("%s must be of type int", key)
+			}
+			if key == "dcs" {
+				c.DcsCode = uint32(code)
+			} else {
+				c.DcsSql = uint32(code)
+			}
+		default:
+			return c, fmt.Errorf("unknown setting '%s'", key)
+		}
+	}
+
+	return c, nil
+}
+
+func lock(r *remoteRadio, args []string) {
+	takeover := false
+	if len(args) > 0 {
+		if args[0] != "takeover" {
+			fmt.Println("ERROR: unknown parameter", args[0])
+			return
+		}
+		takeover = true
+	}
+
+	if err := r.sendLockRequest(true, takeover); err != nil {
+		fmt.Println("ERROR:", err)
+	}
+}
+
+func getLock(r *remoteRadio, args []string) {
+	if len(r.state.LockHolder) == 0 {
+		fmt.Println("Radio is not locked")
+		return
+	}
+	fmt.Println("Radio locked by:", r.state.LockHolder)
+	if r.state.LockExpires > 0 {
+		fmt.Println("Lock expires:", time.Unix(0, r.state.LockExpires).Format(time.Stamp))
+	}
+}
+
+func unlock(r *remoteRadio, args []string) {
+	if err := r.sendLockRequest(false, false); err != nil {
+		fmt.Println("ERROR:", err)
+	}
+}
+
+func cw(r *remoteRadio, args []string) {
+	if len(args) == 0 {
+		fmt.Println("ERROR: CW text missing")
+		return
+	}
+
+	if err := r.sendMorse(strings.Join(args, " "), false); err != nil {
+		fmt.Println("ERROR:", err)
+	}
+}
+
+func cwMacro(r *remoteRadio, args []string) {
+	if len(args) == 0 {
+		names := make([]string, 0, len(r.cwMacros))
+		for name := range r.cwMacros {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			fmt.Println(name+":", r.cwMacros[name])
+		}
+		return
+	}
+
+	if !checkArgs(args, 1) {
+		return
+	}
+
+	text, ok := r.cwMacros[strings.ToLower(args[0])]
+	if !ok {
+		fmt.Println("ERROR: unknown CW macro", args[0])
+		return
+	}
+
+	if err := r.sendMorse(text, false); err != nil {
+		fmt.Println("ERROR:", err)
+	}
+}
+
+func cwStop(r *remoteRadio, args []string) {
+	if err := r.sendMorse("", true); err != nil {
+		fmt.Println("ERROR:", err)
+	}
+}
+
+func setCwSpeed(r *remoteRadio, args []string) {
+	if !checkArgs(args, 1) {
+		return
+	}
+
+	speed, err := strconv.Atoi(args[0])
+	if err != nil || speed < 0 {
+		fmt.Println("ERROR: speed must be a positive integer (WPM)")
+		return
+	}
+
+	r.cwSpeed = speed
+}
+
+func setLogLevel(r *remoteRadio, args []string) {
+	if !checkArgs(args, 1) {
+		return
+	}
+
+	level, err := utils.ParseLogLevel(args[0])
+	if err != nil {
+		fmt.Println("ERROR:", err)
+		return
+	}
+
+	if err := r.sendLogLevelRequest(level); err != nil {
+		fmt.Println("ERROR:", err)
+	}
+}
+
 func setPrintRigUpdates(r *remoteRadio, args []string) {
 	if !checkArgs(args, 1) {
 		return
@@ -901,6 +1672,16 @@ func setPrintRigUpdates(r *remoteRadio, args []string) {
 	r.printRigUpdates = ru
 }
 
+func getPending(r *remoteRadio, args []string) {
+	if len(r.pending) == 0 {
+		fmt.Println("No pending requests")
+		return
+	}
+	for id, sent := range r.pending {
+		fmt.Printf("Request %s pending since %dms\n", id, time.Since(sent)/time.Millisecond)
+	}
+}
+
 func dumpCaps(r *remoteRadio, args []string) {
 	r.PrintCapabilities()
 }
@@ -941,6 +1722,30 @@ func checkArgs(args []string, length int) bool {
 	return true
 }
 
+// parseCtcssTone converts a tone given in Hz (e.g. "88.5") into tenths
+// of Hz, as used by Hamlib.
+func parseCtcssTone(arg string) (uint32, error) {
+	tone, err := strconv.ParseFloat(arg, 32)
+	if err != nil || tone < 0 {
+		return 0, errors.New("CTCSS tone [Hz] must be a positive number")
+	}
+	return uint32(math.Floor(tone*10 + 0.5)), nil
+}
+
+func formatCtcssTone(tone uint32) string {
+	if tone == 0 {
+		return "off"
+	}
+	return fmt.Sprintf("%.1fHz", float32(tone)/10)
+}
+
+func formatDcsCode(code uint32) string {
+	if code == 0 {
+		return "off"
+	}
+	return fmt.Sprintf("%03d", code)
+}
+
 func valueInValueList(vName string, vList []*sbRadio.Value) bool {
 	for _, value := range vList {
 		if value.Name == vName {
@@ -5,7 +5,9 @@ import (
 	"html/template"
 	"log"
 	"reflect"
+	"strings"
 	"sync"
+	"time"
 
 	"os"
 
@@ -13,14 +15,30 @@ import (
 	"github.com/dh1tw/remoteRadio/comms"
 	"github.com/dh1tw/remoteRadio/events"
 	sbRadio "github.com/dh1tw/remoteRadio/sb_radio"
+	sbStatus "github.com/dh1tw/remoteRadio/sb_status"
+	"github.com/dh1tw/remoteRadio/serverstatus"
 	"github.com/dh1tw/remoteRadio/utils"
 	"github.com/spf13/viper"
 )
 
 type RemoteRadioSettings struct {
 	CatResponseCh   chan []byte
+	StateDeltaCh    chan []byte
+	EchoCh          chan []byte
+	MetersCh        chan []byte
+	MorseProgressCh chan []byte
+	AlarmCh         chan []byte
+	LogCh           chan []byte
 	RadioStatusCh   chan []byte
+	ErrorCh         chan []byte
+	AckCh           chan []byte
 	CatRequestTopic string
+	LockTopic       string
+	MemRequestTopic string
+	ResyncTopic     string
+	LogLevelTopic   string
+	MorseTopic      string
+	MemResponseCh   chan []byte
 	ToWireCh        chan comms.IOMsg
 	CapabilitiesCh  chan []byte
 	WaitGroup       *sync.WaitGroup
@@ -29,6 +47,7 @@ type RemoteRadioSettings struct {
 
 type remoteRadio struct {
 	state           sbRadio.State
+	meters          sbRadio.Meters
 	newState        sbRadio.SetState
 	caps            sbRadio.Capabilities
 	settings        RemoteRadioSettings
@@ -36,6 +55,11 @@ type remoteRadio struct {
 	printRigUpdates bool
 	userID          string
 	radioOnline     bool
+	status          *serverstatus.Tracker
+	pending         map[string]time.Time
+	lastResync      time.Time
+	cwMacros        map[string]string // name -> CW text
+	cwSpeed         int               // WPM; 0 = keep the rig's speed
 }
 
 type cliCmd struct {
@@ -59,8 +83,11 @@ func HandleRemoteRadio(rs RemoteRadioSettings) {
 	r.state.Vfo.Levels = make(map[string]float32)
 	r.state.Vfo.Parameters = make(map[string]float32)
 	r.state.Vfo.Split = &sbRadio.Split{}
+	r.state.Channel = &sbRadio.Channel{}
 
 	r.settings = rs
+	r.pending = make(map[string]time.Time)
+	r.status = serverstatus.NewTracker()
 
 	r.cliCmds = make([]cliCmd, 0, 30)
 	r.populateCliCmds()
@@ -71,6 +98,9 @@ func HandleRemoteRadio(rs RemoteRadioSettings) {
 		r.userID = "unknown_" + utils.RandStringRunes(5)
 	}
 
+	r.cwMacros = viper.GetStringMapString("cw.macros")
+	r.cwSpeed = viper.GetInt("cw.speed")
+
 	// rs.Events.Pub(true, events.ForwardCat)
 
 	fmt.Println("Rig command: ")
@@ -83,8 +113,26 @@ func HandleRemoteRadio(rs RemoteRadioSettings) {
 		case msg := <-rs.CatResponseCh:
 			r.deserializeCatResponse(msg)
 			// r.PrintState()
+		case msg := <-rs.StateDeltaCh:
+			r.deserializeStateDelta(msg)
+		case msg := <-rs.EchoCh:
+			r.deserializeEcho(msg)
+		case msg := <-rs.MetersCh:
+			r.deserializeMeters(msg)
+		case msg := <-rs.LogCh:
+			r.deserializeLogLine(msg)
+		case msg := <-rs.MorseProgressCh:
+			r.deserializeMorseProgress(msg)
+		case msg := <-rs.AlarmCh:
+			r.deserializeAlarm(msg)
 		case msg := <-rs.RadioStatusCh:
 			r.deserializeRadioStatus(msg)
+		case msg := <-rs.ErrorCh:
+			r.deserializeError(msg)
+		case msg := <-rs.AckCh:
+			r.deserializeAck(msg)
+		case msg := <-rs.MemResponseCh:
+			r.deserializeMemResponse(msg)
 		case msg := <-cliInputCh:
 			r.parseCli(msg.([]string))
 		case <-shutdownCh:
@@ -96,16 +144,92 @@ func HandleRemoteRadio(rs RemoteRadioSettings) {
 
 func (r *remoteRadio) deserializeRadioStatus(data []byte) error {
 
-	rStatus := sbRadio.Status{}
+	rStatus := sbStatus.Status{}
 	if err := rStatus.Unmarshal(data); err != nil {
 		return err
 	}
 
-	if r.radioOnline != rStatus.GetOnline() {
-		r.radioOnline = rStatus.GetOnline()
+	rigConnected := r.status.RigConnected()
+
+	if online := r.status.Update(rStatus); r.radioOnline != online {
+		r.radioOnline = online
 		fmt.Println("Update Radio Online:", r.radioOnline)
 	}
 
+	if r.status.RigConnected() != rigConnected {
+		if r.status.RigConnected() {
+			fmt.Println("Rig connected")
+		} else {
+			fmt.Println("Rig disconnected:", r.status.RigError())
+		}
+	}
+
+	return nil
+}
+
+// deserializeError shows the operator why (a part of) a SetState
+// could not be applied by the server.
+func (r *remoteRadio) deserializeError(data []byte) error {
+
+	rErr := sbRadio.Error{}
+	if err := rErr.Unmarshal(data); err != nil {
+		return err
+	}
+
+	if rErr.GetUserId() != r.userID {
+		fmt.Printf("Error: unable to set %s (requested by %s): %s\n",
+			rErr.GetField(), rErr.GetUserId(), rErr.GetError())
+		return nil
+	}
+
+	fmt.Printf("Error: unable to set %s: %s\n", rErr.GetField(), rErr.GetError())
+
+	return nil
+}
+
+// deserializeAck matches the acknowledgement from the server with
+// our pending requests.
+func (r *remoteRadio) deserializeAck(data []byte) error {
+
+	ack := sbRadio.Ack{}
+	if err := ack.Unmarshal(data); err != nil {
+		return err
+	}
+
+	sent, ok := r.pending[ack.GetRequestId()]
+	if !ok || ack.GetUserId() != r.userID {
+		// not our request
+		return nil
+	}
+	delete(r.pending, ack.GetRequestId())
+
+	status := "confirmed"
+	if len(ack.GetRejected()) > 0 {
+		rejected := make([]string, 0, len(ack.GetRejected()))
+		for _, e := range ack.GetRejected() {
+			rejected = append(rejected, e.GetField())
+		}
+		status = "rejected"
+		if len(ack.GetApplied()) > 0 {
+			status = "partially applied"
+		}
+		if ack.GetRolledBack() {
+			status = "rolled back"
+		}
+		fmt.Printf("Request %s %s; rejected: %s\n", ack.GetRequestId(),
+			status, strings.Join(rejected, ", "))
+	}
+
+	if ack.GetRollbackError() != "" {
+		fmt.Printf("Request %s: %s\n", ack.GetRequestId(), ack.GetRollbackError())
+	}
+
+	if r.printRigUpdates {
+		fmt.Printf("Request %s %s after %dms (state version %d)\n",
+			ack.GetRequestId(), status,
+			time.Since(sent)/time.Millisecond, ack.GetStateVersion())
+	}
+
 	return nil
 }
 
@@ -115,6 +239,7 @@ func (r *remoteRadio) sendCatRequest(req sbRadio.SetState) error {
 		return err
 	}
 
+	r.pending[req.GetRequestId()] = time.Now()
 	msg := comms.IOMsg{}
 	msg.Data = data
 	msg.Topic = r.settings.CatRequestTopic
@@ -142,6 +267,12 @@ Current Vfo: {{.CurrentVfo}}
     Mode: {{.Vfo.Split.Mode}}
     PbWidth: {{.Vfo.Split.PbWidth}}
   Tuning Step: {{.Vfo.TuningStep}}
+  CTCSS Tone: {{.Vfo.CtcssTone}}
+  CTCSS Squelch: {{.Vfo.CtcssSql}}
+  DCS Code: {{.Vfo.DcsCode}}
+  DCS Squelch: {{.Vfo.DcsSql}}
+  Repeater Shift: {{.Vfo.RptrShift}}
+  Repeater Offset: {{.Vfo.RptrOffset}}Hz
   Functions: {{range $f := .Vfo.Functions}}{{$f}} {{end}}
   Levels: {{range $name, $val := .Vfo.Levels}}
     {{$name}}: {{$val}} {{end}}
@@ -149,6 +280,8 @@ Current Vfo: {{.CurrentVfo}}
     {{$name}}: {{$val}} {{end}}
 Radio On: {{.RadioOn}}
 Ptt: {{.Ptt}}
+Memory Channel: {{.Channel.Channel}}
+Locked by: {{.LockHolder}}
 Update Rate: {{.PollingInterval}}
 
 `,
@@ -192,6 +325,9 @@ Tuning Steps [Hz]: {{range $mode, $tsList := .TuningSteps}}
   {{$mode}}:		{{range $ts := $tsList.Value}}{{$ts}} {{end}} {{end}}
 Preamps: {{range $preamp := .Preamps}}{{$preamp}}dB {{end}}
 Attenuators: {{range $att := .Attenuators}}{{$att}}dB {{end}} 
+Memory Channels: {{.MemFirst}}..{{.MemLast}}
+CTCSS Tones [0.1Hz]: {{range $tone := .CtcssList}}{{$tone}} {{end}}
+DCS Codes: {{range $code := .DcsList}}{{$code}} {{end}}
 
 `,
 ))
@@ -238,6 +374,109 @@ func (r *remoteRadio) deserializeCatResponse(msg []byte) error {
 		return err
 	}
 
+	return r.updateState(ns)
+}
+
+// deserializeStateDelta merges a StateDelta into the local copy of the
+// radio's state. If updates have been missed, a snapshot of the state
+// is requested from the server.
+func (r *remoteRadio) deserializeStateDelta(msg []byte) error {
+
+	delta := sbRadio.StateDelta{}
+	if err := delta.Unmarshal(msg); err != nil {
+		return err
+	}
+
+	// already contained in the last snapshot
+	if delta.GetVersion() <= r.state.Version {
+		return nil
+	}
+
+	ns, err := utils.MergeStateDelta(&r.state, &delta)
+	if err != nil {
+		return r.sendResyncRequest()
+	}
+
+	return r.updateState(ns)
+}
+
+// deserializeMeters keeps the latest meter readings. They are shown
+// with the get_meters command.
+func (r *remoteRadio) deserializeMeters(msg []byte) error {
+	meters := sbRadio.Meters{}
+	if err := meters.Unmarshal(msg); err != nil {
+		return err
+	}
+	r.meters = meters
+	return nil
+}
+
+// deserializeLogLine prints the debug output of the rig library
+// which has been forwarded by the server.
+func (r *remoteRadio) deserializeLogLine(msg []byte) error {
+	line := sbRadio.LogLine{}
+	if err := line.Unmarshal(msg); err != nil {
+		return err
+	}
+	text := fmt.Sprintf("%s: %s", utils.LogLevelName(int(line.GetLevel())),
+		strings.TrimRight(line.GetMsg(), "\n"))
+	r.settings.Events.Pub(text, events.RadioLog)
+	fmt.Println("Rig log", text)
+	return nil
+}
+
+// deserializeEcho shows the value which has been requested by a client
+// before the rig has applied it. Echoes which don't apply to the current
+// state are ignored, since the next StateDelta will contain the value.
+func (r *remoteRadio) deserializeEcho(msg []byte) error {
+
+	echo := sbRadio.StateDelta{}
+	if err := echo.Unmarshal(msg); err != nil {
+		return err
+	}
+
+	if echo.GetBaseVersion() != r.state.Version {
+		return nil
+	}
+
+	ns, err := utils.MergeStateDelta(&r.state, &echo)
+	if err != nil {
+		return err
+	}
+
+	return r.updateState(ns)
+}
+
+// sendResyncRequest asks the server for a snapshot of the state. While
+// the snapshot is on its way, further gaps don't trigger new requests.
+func (r *remoteRadio) sendResyncRequest() error {
+
+	if time.Since(r.lastResync) < time.Second {
+		return nil
+	}
+	r.lastResync = time.Now()
+
+	req := sbRadio.ResyncRequest{
+		UserId:  r.userID,
+		Version: r.state.Version,
+	}
+
+	data, err := req.Marshal()
+	if err != nil {
+		return err
+	}
+
+	msg := comms.IOMsg{}
+	msg.Data = data
+	msg.Topic = r.settings.ResyncTopic
+
+	r.settings.ToWireCh <- msg
+
+	return nil
+}
+
+func (r *remoteRadio) updateState(ns sbRadio.State) error {
+
 	if ns.CurrentVfo != r.state.CurrentVfo {
 		r.state.CurrentVfo = ns.CurrentVfo
 		if r.printRigUpdates {
@@ -304,6 +543,48 @@ func (r *remoteRadio) deserializeCatResponse(msg []byte) error {
 			}
 		}
 
+		if ns.Vfo.GetCtcssTone() != r.state.Vfo.CtcssTone {
+			r.state.Vfo.CtcssTone = ns.Vfo.GetCtcssTone()
+			if r.printRigUpdates {
+				fmt.Println("Updated CTCSS Tone:", formatCtcssTone(r.state.Vfo.CtcssTone))
+			}
+		}
+
+		if ns.Vfo.GetCtcssSql() != r.state.Vfo.CtcssSql {
+			r.state.Vfo.CtcssSql = ns.Vfo.GetCtcssSql()
+			if r.printRigUpdates {
+				fmt.Println("Updated CTCSS Squelch:", formatCtcssTone(r.state.Vfo.CtcssSql))
+			}
+		}
+
+		if ns.Vfo.GetDcsCode() != r.state.Vfo.DcsCode {
+			r.state.Vfo.DcsCode = ns.Vfo.GetDcsCode()
+			if r.printRigUpdates {
+				fmt.Println("Updated DCS Code:", formatDcsCode(r.state.Vfo.DcsCode))
+			}
+		}
+
+		if ns.Vfo.GetDcsSql() != r.state.Vfo.DcsSql {
+			r.state.Vfo.DcsSql = ns.Vfo.GetDcsSql()
+			if r.printRigUpdates {
+				fmt.Println("Updated DCS Squelch:", formatDcsCode(r.state.Vfo.DcsSql))
+			}
+		}
+
+		if ns.Vfo.GetRptrShift() != r.state.Vfo.RptrShift {
+			r.state.Vfo.RptrShift = ns.Vfo.GetRptrShift()
+			if r.printRigUpdates {
+				fmt.Println("Updated Repeater Shift:", r.state.Vfo.RptrShift)
+			}
+		}
+
+		if ns.Vfo.GetRptrOffset() != r.state.Vfo.RptrOffset {
+			r.state.Vfo.RptrOffset = ns.Vfo.GetRptrOffset()
+			if r.printRigUpdates {
+				fmt.Printf("Updated Repeater Offset: %dHz\n", r.state.Vfo.RptrOffset)
+			}
+		}
+
 		if ns.Vfo.Functions != nil {
 			if !reflect.DeepEqual(ns.Vfo.Functions, r.state.Vfo.Functions) {
 				if err := r.updateFunctions(ns.Vfo.GetFunctions()); err != nil {
@@ -344,6 +625,31 @@ func (r *remoteRadio) deserializeCatResponse(msg []byte) error {
 		}
 	}
 
+	if ns.GetPttOffReason() != r.state.PttOffReason {
+		r.state.PttOffReason = ns.GetPttOffReason()
+		if len(r.state.PttOffReason) > 0 {
+			fmt.Println("PTT forced off by server:", r.state.PttOffReason)
+		}
+	}
+
+	if ns.GetChannel().GetChannel() != r.state.Channel.GetChannel() {
+		r.state.Channel = &sbRadio.Channel{Channel: ns.GetChannel().GetChannel()}
+		if r.printRigUpdates {
+			fmt.Println("Updated Memory Channel:", r.state.Channel.Channel)
+		}
+	}
+
+	if ns.GetLockHolder() != r.state.LockHolder {
+		r.state.LockHolder = ns.GetLockHolder()
+		if len(r.state.LockHolder) > 0 {
+			fmt.Println("Radio locked by", r.state.LockHolder)
+		} else {
+			fmt.Println("Radio unlocked")
+		}
+	}
+	r.state.LockExpires = ns.GetLockExpires()
+	r.state.Version = ns.GetVersion()
+
 	if ns.GetPollingInterval() != r.state.PollingInterval {
 		r.state.PollingInterval = ns.GetPollingInterval()
 		if r.printRigUpdates {
@@ -481,6 +787,216 @@ func (r *remoteRadio) updateParams(newParams map[string]float32) error {
 	return nil
 }
 
+// sendLockRequest asks the server to acquire or release exclusive
+// control of the radio.
+func (r *remoteRadio) sendLockRequest(acquire, takeover bool) error {
+	req := sbRadio.Lock{
+		UserId:    r.userID,
+		Acquire:   acquire,
+		Takeover:  takeover,
+		RequestId: utils.RandStringRunes(8),
+	}
+
+	data, err := req.Marshal()
+	if err != nil {
+		return err
+	}
+
+	r.pending[req.GetRequestId()] = time.Now()
+
+	msg := comms.IOMsg{}
+	msg.Data = data
+	msg.Topic = r.settings.LockTopic
+
+	r.settings.ToWireCh <- msg
+
+	return nil
+}
+
+// deserializeMorseProgress reports the progress of our CW messages.
+func (r *remoteRadio) deserializeMorseProgress(msg []byte) error {
+	p := sbRadio.MorseProgress{}
+	if err := p.Unmarshal(msg); err != nil {
+		return err
+	}
+
+	if p.GetUserId() != r.userID {
+		return nil
+	}
+
+	if !p.GetDone() {
+		if r.printRigUpdates {
+			fmt.Printf("CW: %d/%d characters sent\n", p.GetSent(), p.GetTotal())
+		}
+		return nil
+	}
+
+	delete(r.pending, p.GetRequestId())
+
+	status := "sent"
+	switch {
+	case p.GetError() != "":
+		status = "failed: " + p.GetError()
+	case p.GetAborted():
+		status = "aborted"
+	}
+	fmt.Printf("CW %s (%d/%d characters)\n", status, p.GetSent(), p.GetTotal())
+
+	return nil
+}
+
+// deserializeAlarm reports that the SWR / ALC protection of the server
+// has unkeyed the transmitter.
+func (r *remoteRadio) deserializeAlarm(msg []byte) error {
+	a := sbRadio.Alarm{}
+	if err := a.Unmarshal(msg); err != nil {
+		return err
+	}
+
+	band := ""
+	if a.GetBand() != "" {
+		band = " (" + a.GetBand() + ")"
+	}
+
+	fmt.Printf("ALARM: %s protection unkeyed %s on %.3fkHz%s: %s %.2f above the limit of %.2f for %dms, RFPOWER %.2f\n",
+		a.GetMeter(), a.GetUserId(), a.GetFrequency()/1000, band,
+		a.GetMeter(), a.GetValue(), a.GetLimit(), a.GetDuration(), a.GetRfpower())
+
+	return nil
+}
+
+// sendMorse asks the server to send a CW message or to abort the
+// message in progress.
+func (r *remoteRadio) sendMorse(text string, abort bool) error {
+	req := sbRadio.SendMorse{
+		UserId:    r.userID,
+		RequestId: utils.RandStringRunes(8),
+		Text:      text,
+		Speed:     int32(r.cwSpeed),
+		Abort:     abort,
+	}
+
+	data, err := req.Marshal()
+	if err != nil {
+		return err
+	}
+
+	if !abort {
+		r.pending[req.GetRequestId()] = time.Now()
+	}
+
+	msg := comms.IOMsg{}
+	msg.Data = data
+	msg.Topic = r.settings.MorseTopic
+
+	r.settings.ToWireCh <- msg
+
+	return nil
+}
+
+// sendLogLevelRequest asks the server to change the level of the
+// forwarded debug output of the rig library.
+func (r *remoteRadio) sendLogLevelRequest(level int) error {
+	req := sbRadio.SetLogLevel{
+		UserId:    r.userID,
+		Level:     int32(level),
+		RequestId: utils.RandStringRunes(8),
+	}
+
+	data, err := req.Marshal()
+	if err != nil {
+		return err
+	}
+
+	r.pending[req.GetRequestId()] = time.Now()
+
+	msg := comms.IOMsg{}
+	msg.Data = data
+	msg.Topic = r.settings.LogLevelTopic
+
+	r.settings.ToWireCh <- msg
+
+	return nil
+}
+
+// sendMemoryRequest asks the server to list, read, write, clear or
+// recall a memory channel.
+func (r *remoteRadio) sendMemoryRequest(op string, ch int32, data *sbRadio.Channel) error {
+	req := sbRadio.MemoryRequest{
+		UserId:    r.userID,
+		RequestId: utils.RandStringRunes(8),
+		Operation: op,
+		Channel:   ch,
+		Data:      data,
+	}
+
+	msg, err := req.Marshal()
+	if err != nil {
+		return err
+	}
+
+	r.pending[req.GetRequestId()] = time.Now()
+
+	r.settings.ToWireCh <- comms.IOMsg{
+		Data:  msg,
+		Topic: r.settings.MemRequestTopic,
+	}
+
+	return nil
+}
+
+// deserializeMemResponse prints the memory channels which
+// we have requested.
+func (r *remoteRadio) deserializeMemResponse(data []byte) error {
+
+	resp := sbRadio.MemoryResponse{}
+	if err := resp.Unmarshal(data); err != nil {
+		return err
+	}
+
+	if resp.GetUserId() != r.userID {
+		return nil
+	}
+
+	if len(resp.GetChannels()) == 0 {
+		fmt.Println("No memory channels in use")
+		return nil
+	}
+
+	for _, c := range resp.GetChannels() {
+		fmt.Println(formatChannel(c))
+	}
+
+	return nil
+}
+
+func formatChannel(c *sbRadio.Channel) string {
+	if c.GetFrequency() == 0 {
+		return fmt.Sprintf("%3d: empty", c.GetChannel())
+	}
+
+	s := fmt.Sprintf("%3d: %-10s %.3fkHz %s %dHz", c.GetChannel(),
+		c.GetName(), c.GetFrequency()/1000, c.GetMode(), c.GetPbWidth())
+
+	if c.GetSplit().GetEnabled() {
+		s += fmt.Sprintf(" TX: %.3fkHz %s", c.Split.GetFrequency()/1000, c.Split.GetMode())
+	}
+	if c.GetCtcssTone() > 0 {
+		s += fmt.Sprintf(" CTCSS: %.1fHz", float32(c.GetCtcssTone())/10)
+	}
+	if c.GetCtcssSql() > 0 {
+		s += fmt.Sprintf(" CTCSS SQL: %.1fHz", float32(c.GetCtcssSql())/10)
+	}
+	if c.GetDcsCode() > 0 {
+		s += fmt.Sprintf(" DCS: %d", c.GetDcsCode())
+	}
+	if c.GetDcsSql() > 0 {
+		s += fmt.Sprintf(" DCS SQL: %d", c.GetDcsSql())
+	}
+
+	return s
+}
+
 func (r *remoteRadio) initSetState() sbRadio.SetState {
 	request := sbRadio.SetState{}
 
@@ -489,6 +1005,7 @@ func (r *remoteRadio) initSetState() sbRadio.SetState {
 	request.Vfo.Split = &sbRadio.Split{}
 	request.Md = &sbRadio.MetaData{}
 	request.UserId = r.userID
+	request.RequestId = utils.RandStringRunes(8)
 
 	return request
 }
@@ -1,7 +1,10 @@
 package cligui
 
 import (
+	"errors"
+	"fmt"
 	"math"
+	"sort"
 	"strconv"
 	"strings"
 
@@ -198,6 +201,143 @@ func (r *remoteRadio) populateCliCmds() {
 
 	r.cliCmds = append(r.cliCmds, cliSetPollingInterval)
 
+	cliMemList := cliCmd{
+		Cmd:         memList,
+		Name:        "mem_list",
+		Shortcut:    "",
+		Description: "List all memory channels which are in use",
+	}
+
+	r.cliCmds = append(r.cliCmds, cliMemList)
+
+	cliMemRead := cliCmd{
+		Cmd:         memRead,
+		Name:        "mem_read",
+		Shortcut:    "",
+		Parameters:  "Channel",
+		Description: "Read a memory channel",
+		Example:     "mem_read 12",
+	}
+
+	r.cliCmds = append(r.cliCmds, cliMemRead)
+
+	cliMemWrite := cliCmd{
+		Cmd:         memWrite,
+		Name:        "mem_write",
+		Shortcut:    "",
+		Parameters:  "Channel, Frequency [kHz], Mode, [name=, tx=[kHz], txmode=, ctcss=[Hz], ctcss_sql=[Hz], dcs=, dcs_sql=]",
+		Description: "Write a memory channel",
+		Example:     "mem_write 12 145600 FM name=DB0XYZ tx=145000 ctcss=88.5",
+	}
+
+	r.cliCmds = append(r.cliCmds, cliMemWrite)
+
+	cliMemClear := cliCmd{
+		Cmd:         memClear,
+		Name:        "mem_clear",
+		Shortcut:    "",
+		Parameters:  "Channel",
+		Description: "Clear a memory channel",
+		Example:     "mem_clear 12",
+	}
+
+	r.cliCmds = append(r.cliCmds, cliMemClear)
+
+	cliMemRecall := cliCmd{
+		Cmd:         memRecall,
+		Name:        "mem_recall",
+		Shortcut:    "",
+		Parameters:  "Channel",
+		Description: "Load a memory channel into the current VFO",
+		Example:     "mem_recall 12",
+	}
+
+	r.cliCmds = append(r.cliCmds, cliMemRecall)
+
+	cliLock := cliCmd{
+		Cmd:         lock,
+		Name:        "lock",
+		Shortcut:    "",
+		Parameters:  "[takeover]",
+		Description: "Acquire exclusive control of the radio (admins can take over)",
+		Example:     "lock takeover",
+	}
+
+	r.cliCmds = append(r.cliCmds, cliLock)
+
+	cliUnlock := cliCmd{
+		Cmd:         unlock,
+		Name:        "unlock",
+		Shortcut:    "",
+		Description: "Release exclusive control of the radio",
+	}
+
+	r.cliCmds = append(r.cliCmds, cliUnlock)
+
+	cliCw := cliCmd{
+		Cmd:         cw,
+		Name:        "cw",
+		Shortcut:    "",
+		Parameters:  "Text",
+		Description: "Send a CW message through the keyer of the radio",
+		Example:     "cw TU 5NN 14",
+	}
+
+	r.cliCmds = append(r.cliCmds, cliCw)
+
+	cliCwMacro := cliCmd{
+		Cmd:         cwMacro,
+		Name:        "cw_macro",
+		Shortcut:    "",
+		Parameters:  "[Macro]",
+		Description: "Send a stored CW message (see [cw.macros]); lists the macros without parameter",
+		Example:     "cw_macro cq",
+	}
+
+	r.cliCmds = append(r.cliCmds, cliCwMacro)
+
+	cliCwStop := cliCmd{
+		Cmd:         cwStop,
+		Name:        "cw_stop",
+		Shortcut:    "",
+		Description: "Abort the CW message in progress",
+	}
+
+	r.cliCmds = append(r.cliCmds, cliCwStop)
+
+	cliSetCwSpeed := cliCmd{
+		Cmd:         setCwSpeed,
+		Name:        "set_cw_speed",
+		Shortcut:    "",
+		Parameters:  "WPM (0 = keep the radio's speed)",
+		Description: "Set the speed of the following CW messages",
+		Example:     "set_cw_speed 28",
+	}
+
+	r.cliCmds = append(r.cliCmds, cliSetCwSpeed)
+
+	cliRot := cliCmd{
+		Cmd:         rot,
+		Name:        "rot",
+		Shortcut:    "",
+		Parameters:  "Azimuth [Elevation] | stop | park",
+		Description: "Turn, stop or park the rotator",
+		Example:     "rot 270",
+	}
+
+	r.cliCmds = append(r.cliCmds, cliRot)
+
+	cliSetLogLevel := cliCmd{
+		Cmd:         setLogLevel,
+		Name:        "set_log_level",
+		Shortcut:    "",
+		Parameters:  "Level [0-5, NONE, BUG, ERR, WARN, VERBOSE, TRACE]",
+		Description: "Set the level of the rig's debug output forwarded by the server",
+		Example:     "set_log_level TRACE",
+	}
+
+	r.cliCmds = append(r.cliCmds, cliSetLogLevel)
+
 	cliSetPrintUpdates := cliCmd{
 		Cmd:         setPrintRigUpdates,
 		Name:        "set_print_rig_updates",
@@ -714,6 +854,286 @@ func setPollingInterval(r *remoteRadio, args []string) {
 	}
 }
 
+func memList(r *remoteRadio, args []string) {
+	if err := r.sendMemoryRequest("list", 0, nil); err != nil {
+		r.logger.Println("ERROR:", err)
+	}
+}
+
+func memRead(r *remoteRadio, args []string) {
+	if ok := r.checkArgs(args, 1); !ok {
+		return
+	}
+
+	ch, err := strconv.Atoi(args[0])
+	if err != nil {
+		r.logger.Println("ERROR: channel must be of type int")
+		return
+	}
+
+	if err := r.sendMemoryRequest("read", int32(ch), nil); err != nil {
+		r.logger.Println("ERROR:", err)
+	}
+}
+
+func memWrite(r *remoteRadio, args []string) {
+	if len(args) < 3 {
+		r.logger.Println("ERROR: wrong number of arguments")
+		return
+	}
+
+	ch, err := strconv.Atoi(args[0])
+	if err != nil {
+		r.logger.Println("ERROR: channel must be of type int")
+		return
+	}
+
+	data, err := parseMemChannel(args[1:])
+	if err != nil {
+		r.logger.Println("ERROR:", err)
+		return
+	}
+
+	if !utils.StringInSlice(data.Mode, r.caps.Modes) {
+		r.logger.Println("ERROR: unsupported mode")
+		return
+	}
+
+	if err := r.sendMemoryRequest("write", int32(ch), &data); err != nil {
+		r.logger.Println("ERROR:", err)
+	}
+}
+
+func memClear(r *remoteRadio, args []string) {
+	if ok := r.checkArgs(args, 1); !ok {
+		return
+	}
+
+	ch, err := strconv.Atoi(args[0])
+	if err != nil {
+		r.logger.Println("ERROR: channel must be of type int")
+		return
+	}
+
+	if err := r.sendMemoryRequest("clear", int32(ch), nil); err != nil {
+		r.logger.Println("ERROR:", err)
+	}
+}
+
+func memRecall(r *remoteRadio, args []string) {
+	if ok := r.checkArgs(args, 1); !ok {
+		return
+	}
+
+	ch, err := strconv.Atoi(args[0])
+	if err != nil {
+		r.logger.Println("ERROR: channel must be of type int")
+		return
+	}
+
+	if err := r.sendMemoryRequest("recall", int32(ch), nil); err != nil {
+		r.logger.Println("ERROR:", err)
+	}
+}
+
+// parseMemChannel parses the frequency [kHz], the mode and the optional
+// key=value settings of a memory channel.
+func parseMemChannel(args []string) (sbRadio.Channel, error) {
+
+	c := sbRadio.Channel{}
+	c.Split = &sbRadio.Split{}
+
+	freq, err := strconv.ParseFloat(args[0], 64)
+	if err != nil {
+		return c, errors.New("frequency [kHz] must be float")
+	}
+	c.Frequency = freq * 1000
+	c.Mode = strings.ToUpper(args[1])
+
+	for _, arg := range args[2:] {
+		kv := strings.SplitN(arg, "=", 2)
+		if len(kv) != 2 {
+			return c, fmt.Errorf("invalid setting '%s' (expected key=value)", arg)
+		}
+		key, value := kv[0], kv[1]
+
+		switch key {
+		case "name":
+			c.Name = value
+		case "tx":
+			txFreq, err := strconv.ParseFloat(value, 64)
+			if err != nil {
+				return c, errors.New("tx frequency [kHz] must be float")
+			}
+			c.Split.Enabled = true
+			c.Split.Frequency = txFreq * 1000
+			if c.Split.Mode == "" {
+				c.Split.Mode = c.Mode
+			}
+		case "txmode":
+			c.Split.Mode = strings.ToUpper(value)
+		case "ctcss", "ctcss_sql":
+			tone, err := strconv.ParseFloat(value, 64)
+			if err != nil {
+				return c, fmt.Errorf("%s [Hz] must be float", key)
+			}
+			if key == "ctcss" {
+				c.CtcssTone = uint32(tone*10 + 0.5)
+			} else {
+				c.CtcssSql = uint32(tone*10 + 0.5)
+			}
+		case "dcs", "dcs_sql":
+			code, err := strconv.ParseUint(value, 10, 32)
+			if err != nil {
+				return c, fmt.Errorf("%s must be of type int", key)
+			}
+			if key == "dcs" {
+				c.DcsCode = uint32(code)
+			} else {
+				c.DcsSql = uint32(code)
+			}
+		default:
+			return c, fmt.Errorf("unknown setting '%s'", key)
+		}
+	}
+
+	return c, nil
+}
+
+func lock(r *remoteRadio, args []string) {
+	takeover := false
+	if len(args) > 0 {
+		if args[0] != "takeover" {
+			r.logger.Println("ERROR: unknown parameter", args[0])
+			return
+		}
+		takeover = true
+	}
+
+	if err := r.sendLockRequest(true, takeover); err != nil {
+		r.logger.Println("ERROR:", err)
+	}
+}
+
+func unlock(r *remoteRadio, args []string) {
+	if err := r.sendLockRequest(false, false); err != nil {
+		r.logger.Println("ERROR:", err)
+	}
+}
+
+func cw(r *remoteRadio, args []string) {
+	if len(args) == 0 {
+		r.logger.Println("ERROR: CW text missing")
+		return
+	}
+
+	if err := r.sendMorse(strings.Join(args, " "), false); err != nil {
+		r.logger.Println("ERROR:", err)
+	}
+}
+
+func cwMacro(r *remoteRadio, args []string) {
+	if len(args) == 0 {
+		names := make([]string, 0, len(r.cwMacros))
+		for name := range r.cwMacros {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			r.logger.Println(name+":", r.cwMacros[name])
+		}
+		return
+	}
+
+	if !r.checkArgs(args, 1) {
+		return
+	}
+
+	text, ok := r.cwMacros[strings.ToLower(args[0])]
+	if !ok {
+		r.logger.Println("ERROR: unknown CW macro", args[0])
+		return
+	}
+
+	if err := r.sendMorse(text, false); err != nil {
+		r.logger.Println("ERROR:", err)
+	}
+}
+
+func cwStop(r *remoteRadio, args []string) {
+	if err := r.sendMorse("", true); err != nil {
+		r.logger.Println("ERROR:", err)
+	}
+}
+
+func setCwSpeed(r *remoteRadio, args []string) {
+	if !r.checkArgs(args, 1) {
+		return
+	}
+
+	speed, err := strconv.Atoi(args[0])
+	if err != nil || speed < 0 {
+		r.logger.Println("ERROR: speed must be a positive integer (WPM)")
+		return
+	}
+
+	r.cwSpeed = speed
+}
+
+func rot(r *remoteRadio, args []string) {
+	if len(args) < 1 || len(args) > 2 {
+		r.logger.Println("ERROR: wrong number of arguments")
+		return
+	}
+
+	var err error
+
+	switch args[0] {
+	case "stop", "park":
+		if len(args) != 1 {
+			r.logger.Println("ERROR: wrong number of arguments")
+			return
+		}
+		err = r.sendRotatorRequest(args[0], 0, 0)
+
+	default:
+		az, el := 0.0, 0.0
+		az, err = strconv.ParseFloat(args[0], 32)
+		if err != nil {
+			r.logger.Println("ERROR: azimuth must be a number (degrees)")
+			return
+		}
+		if len(args) == 2 {
+			el, err = strconv.ParseFloat(args[1], 32)
+			if err != nil {
+				r.logger.Println("ERROR: elevation must be a number (degrees)")
+				return
+			}
+		}
+		err = r.sendRotatorRequest("set_position", float32(az), float32(el))
+	}
+
+	if err != nil {
+		r.logger.Println("ERROR:", err)
+	}
+}
+
+func setLogLevel(r *remoteRadio, args []string) {
+	if !r.checkArgs(args, 1) {
+		return
+	}
+
+	level, err := utils.ParseLogLevel(args[0])
+	if err != nil {
+		r.logger.Println("ERROR:", err)
+		return
+	}
+
+	if err := r.sendLogLevelRequest(level); err != nil {
+		r.logger.Println("ERROR:", err)
+	}
+}
+
 func setPrintRigUpdates(r *remoteRadio, args []string) {
 	if !r.checkArgs(args, 1) {
 		return
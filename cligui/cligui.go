@@ -1,14 +1,20 @@
 package cligui
 
 import (
+	"errors"
+	"fmt"
 	"log"
 	"reflect"
+	"strings"
 	"sync"
+	"time"
 
 	"github.com/cskr/pubsub"
 	"github.com/dh1tw/remoteRadio/comms"
 	"github.com/dh1tw/remoteRadio/events"
 	sbRadio "github.com/dh1tw/remoteRadio/sb_radio"
+	sbRotator "github.com/dh1tw/remoteRadio/sb_rotator"
+	sbStatus "github.com/dh1tw/remoteRadio/sb_status"
 	"github.com/dh1tw/remoteRadio/utils"
 	ui "github.com/gizak/termui"
 	"github.com/spf13/viper"
@@ -16,8 +22,25 @@ import (
 
 type RemoteRadioSettings struct {
 	CatResponseCh   chan []byte
-	RadioStatusCh   chan []byte
+	StateDeltaCh    chan []byte
+	EchoCh          chan []byte
+	MetersCh        chan []byte
+	MorseProgressCh chan []byte
+	AlarmCh         chan []byte
+	LogCh           chan []byte
+	ErrorCh         chan []byte
+	AckCh           chan []byte
 	CatRequestTopic string
+	LockTopic       string
+	MemRequestTopic string
+	ResyncTopic     string
+	LogLevelTopic   string
+	MorseTopic      string
+	RotPositionCh   chan []byte // nil channels & empty topic without rotator
+	RotStatusCh     chan []byte
+	RotAckCh        chan []byte
+	RotRequestTopic string
+	MemResponseCh   chan []byte
 	PongCh          chan []int64
 	ToWireCh        chan comms.IOMsg
 	CapabilitiesCh  chan []byte
@@ -33,7 +56,11 @@ type remoteRadio struct {
 	cliCmds         []cliCmd
 	printRigUpdates bool
 	userID          string
-	radioOnline     bool
+	pending         map[string]time.Time
+	lastResync      time.Time
+	cwMacros        map[string]string // name -> CW text
+	cwSpeed         int               // WPM; 0 = keep the rig's speed
+	rotatorOnline   bool
 	logger          *log.Logger
 }
 
@@ -57,8 +84,10 @@ func HandleRemoteRadio(rs RemoteRadioSettings) {
 	r.state.Vfo.Levels = make(map[string]float32)
 	r.state.Vfo.Parameters = make(map[string]float32)
 	r.state.Vfo.Split = &sbRadio.Split{}
+	r.state.Channel = &sbRadio.Channel{}
 
 	r.settings = rs
+	r.pending = make(map[string]time.Time)
 
 	r.cliCmds = make([]cliCmd, 0, 30)
 	r.populateCliCmds()
@@ -69,10 +98,13 @@ func HandleRemoteRadio(rs RemoteRadioSettings) {
 		r.userID = "unknown_" + utils.RandStringRunes(5)
 	}
 
+	r.cwMacros = viper.GetStringMapString("cw.macros")
+	r.cwSpeed = viper.GetInt("cw.speed")
+
 	logger := utils.NewChLogger(rs.Events, events.AppLog, "")
 	r.logger = logger
 
-	loggingCh := rs.Events.Sub(events.AppLog)
+	loggingCh := rs.Events.Sub(events.AppLog, events.RadioLog)
 
 	// rs.Events.Pub(true, events.ForwardCat)
 
@@ -84,8 +116,9 @@ func HandleRemoteRadio(rs RemoteRadioSettings) {
 	cliInputCh := rs.Events.Sub(events.CliInput)
 	pongCh := rs.Events.Sub(events.Pong)
 	serverStatusCh := rs.Events.Sub(events.ServerOnline)
+	rigConnectedCh := rs.Events.Sub(events.RigConnected)
 
-	go guiLoop(r.caps, r.settings.Events)
+	go guiLoop(r.caps, r.settings.Events, rs.RotRequestTopic != "")
 
 	for {
 		select {
@@ -97,8 +130,52 @@ func HandleRemoteRadio(rs RemoteRadioSettings) {
 			r.deserializeCatResponse(msg)
 			ui.SendCustomEvt("/radio/state", r.state)
 
-		case msg := <-rs.RadioStatusCh:
-			r.deserializeRadioStatus(msg)
+		case msg := <-rs.StateDeltaCh:
+			r.deserializeStateDelta(msg)
+			ui.SendCustomEvt("/radio/state", r.state)
+
+		case msg := <-rs.EchoCh:
+			r.deserializeEcho(msg)
+			ui.SendCustomEvt("/radio/state", r.state)
+
+		case msg := <-rs.LogCh:
+			r.deserializeLogLine(msg)
+
+		case msg := <-rs.MorseProgressCh:
+			r.deserializeMorseProgress(msg)
+		case msg := <-rs.AlarmCh:
+			r.deserializeAlarm(msg)
+
+		case msg := <-rs.RotPositionCh:
+			pos := sbRotator.Position{}
+			if err := pos.Unmarshal(msg); err != nil {
+				r.logger.Println(err)
+				continue
+			}
+			ui.SendCustomEvt("/rotator/position", pos)
+
+		case msg := <-rs.RotStatusCh:
+			r.deserializeRotatorStatus(msg)
+
+		case msg := <-rs.RotAckCh:
+			r.deserializeRotatorAck(msg)
+
+		case msg := <-rs.MetersCh:
+			meters := sbRadio.Meters{}
+			if err := meters.Unmarshal(msg); err != nil {
+				r.logger.Println(err)
+				continue
+			}
+			ui.SendCustomEvt("/radio/meters", meters)
+
+		case msg := <-rs.ErrorCh:
+			r.deserializeError(msg)
+
+		case msg := <-rs.AckCh:
+			r.deserializeAck(msg)
+
+		case msg := <-rs.MemResponseCh:
+			r.deserializeMemResponse(msg)
 
 		case msg := <-cliInputCh:
 			r.parseCli(msg.([]string))
@@ -116,6 +193,9 @@ func HandleRemoteRadio(rs RemoteRadioSettings) {
 			}
 			ui.SendCustomEvt("/radio/status", msg.(bool))
 
+		case msg := <-rigConnectedCh:
+			ui.SendCustomEvt("/radio/rig", msg.(bool))
+
 		case msg := <-pongCh:
 			ui.SendCustomEvt("/network/latency", msg)
 
@@ -126,27 +206,87 @@ func HandleRemoteRadio(rs RemoteRadioSettings) {
 	}
 }
 
-func (r *remoteRadio) deserializeRadioStatus(data []byte) error {
+// deserializeError shows the operator why (a part of) a SetState
+// could not be applied by the server.
+func (r *remoteRadio) deserializeError(data []byte) error {
 
-	rStatus := sbRadio.Status{}
-	if err := rStatus.Unmarshal(data); err != nil {
+	rErr := sbRadio.Error{}
+	if err := rErr.Unmarshal(data); err != nil {
 		return err
 	}
 
-	if r.radioOnline != rStatus.GetOnline() {
-		r.radioOnline = rStatus.GetOnline()
-		r.logger.Println("Radio Online:", r.radioOnline)
+	if rErr.GetUserId() != r.userID {
+		r.logger.Printf("Error: unable to set %s (requested by %s): %s\n",
+			rErr.GetField(), rErr.GetUserId(), rErr.GetError())
+		return nil
+	}
+
+	r.logger.Printf("Error: unable to set %s: %s\n", rErr.GetField(), rErr.GetError())
+
+	return nil
+}
+
+// deserializeAck matches the acknowledgement from the server with
+// our pending requests.
+func (r *remoteRadio) deserializeAck(data []byte) error {
+
+	ack := sbRadio.Ack{}
+	if err := ack.Unmarshal(data); err != nil {
+		return err
+	}
+
+	sent, ok := r.pending[ack.GetRequestId()]
+	if !ok || ack.GetUserId() != r.userID {
+		// not our request
+		return nil
+	}
+	delete(r.pending, ack.GetRequestId())
+
+	status := "confirmed"
+	if len(ack.GetRejected()) > 0 {
+		rejected := make([]string, 0, len(ack.GetRejected()))
+		for _, e := range ack.GetRejected() {
+			rejected = append(rejected, e.GetField())
+		}
+		status = "rejected"
+		if len(ack.GetApplied()) > 0 {
+			status = "partially applied"
+		}
+		if ack.GetRolledBack() {
+			status = "rolled back"
+		}
+		r.logger.Printf("Request %s %s; rejected: %s\n", ack.GetRequestId(),
+			status, strings.Join(rejected, ", "))
+	}
+
+	if ack.GetRollbackError() != "" {
+		r.logger.Printf("Request %s: %s\n", ack.GetRequestId(), ack.GetRollbackError())
 	}
 
+	ui.SendCustomEvt("/radio/requests", r.requestStatus(
+		fmt.Sprintf("%s (%dms)", status, time.Since(sent)/time.Millisecond)))
+
 	return nil
 }
 
+// requestStatus returns a short summary of our SetState requests
+// for the GUI
+func (r *remoteRadio) requestStatus(last string) string {
+	if len(r.pending) > 0 {
+		return fmt.Sprintf("%d pending", len(r.pending))
+	}
+	return last
+}
+
 func (r *remoteRadio) sendCatRequest(req sbRadio.SetState) error {
 	data, err := req.Marshal()
 	if err != nil {
 		return err
 	}
 
+	r.pending[req.GetRequestId()] = time.Now()
+	ui.SendCustomEvt("/radio/requests", r.requestStatus(""))
+
 	msg := comms.IOMsg{}
 	msg.Data = data
 	msg.Topic = r.settings.CatRequestTopic
@@ -179,6 +319,84 @@ func (r *remoteRadio) deserializeCatResponse(msg []byte) error {
 		return err
 	}
 
+	return r.updateState(ns)
+}
+
+// deserializeStateDelta merges a StateDelta into the local copy of the
+// radio's state. If updates have been missed, a snapshot of the state
+// is requested from the server.
+func (r *remoteRadio) deserializeStateDelta(msg []byte) error {
+
+	delta := sbRadio.StateDelta{}
+	if err := delta.Unmarshal(msg); err != nil {
+		return err
+	}
+
+	// already contained in the last snapshot
+	if delta.GetVersion() <= r.state.Version {
+		return nil
+	}
+
+	ns, err := utils.MergeStateDelta(&r.state, &delta)
+	if err != nil {
+		return r.sendResyncRequest()
+	}
+
+	return r.updateState(ns)
+}
+
+// deserializeEcho shows the value which has been requested by a client
+// before the rig has applied it. Echoes which don't apply to the current
+// state are ignored, since the next StateDelta will contain the value.
+func (r *remoteRadio) deserializeEcho(msg []byte) error {
+
+	echo := sbRadio.StateDelta{}
+	if err := echo.Unmarshal(msg); err != nil {
+		return err
+	}
+
+	if echo.GetBaseVersion() != r.state.Version {
+		return nil
+	}
+
+	ns, err := utils.MergeStateDelta(&r.state, &echo)
+	if err != nil {
+		return err
+	}
+
+	return r.updateState(ns)
+}
+
+// sendResyncRequest asks the server for a snapshot of the state. While
+// the snapshot is on its way, further gaps don't trigger new requests.
+func (r *remoteRadio) sendResyncRequest() error {
+
+	if time.Since(r.lastResync) < time.Second {
+		return nil
+	}
+	r.lastResync = time.Now()
+
+	req := sbRadio.ResyncRequest{
+		UserId:  r.userID,
+		Version: r.state.Version,
+	}
+
+	data, err := req.Marshal()
+	if err != nil {
+		return err
+	}
+
+	msg := comms.IOMsg{}
+	msg.Data = data
+	msg.Topic = r.settings.ResyncTopic
+
+	r.settings.ToWireCh <- msg
+
+	return nil
+}
+
+func (r *remoteRadio) updateState(ns sbRadio.State) error {
+
 	if ns.CurrentVfo != r.state.CurrentVfo {
 		r.state.CurrentVfo = ns.CurrentVfo
 		if r.printRigUpdates {
@@ -285,6 +503,31 @@ func (r *remoteRadio) deserializeCatResponse(msg []byte) error {
 		}
 	}
 
+	if ns.GetPttOffReason() != r.state.PttOffReason {
+		r.state.PttOffReason = ns.GetPttOffReason()
+		if len(r.state.PttOffReason) > 0 {
+			r.logger.Println("PTT forced off by server:", r.state.PttOffReason)
+		}
+	}
+
+	if ns.GetChannel().GetChannel() != r.state.Channel.GetChannel() {
+		r.state.Channel = &sbRadio.Channel{Channel: ns.GetChannel().GetChannel()}
+		if r.printRigUpdates {
+			r.logger.Println("Updated Memory Channel:", r.state.Channel.Channel)
+		}
+	}
+
+	if ns.GetLockHolder() != r.state.LockHolder {
+		r.state.LockHolder = ns.GetLockHolder()
+		if len(r.state.LockHolder) > 0 {
+			r.logger.Println("Radio locked by", r.state.LockHolder)
+		} else {
+			r.logger.Println("Radio unlocked")
+		}
+	}
+	r.state.LockExpires = ns.GetLockExpires()
+	r.state.Version = ns.GetVersion()
+
 	if ns.GetPollingInterval() != r.state.PollingInterval {
 		r.state.PollingInterval = ns.GetPollingInterval()
 		if r.printRigUpdates {
@@ -422,6 +665,314 @@ func (r *remoteRadio) updateParams(newParams map[string]float32) error {
 	return nil
 }
 
+// deserializeLogLine forwards the debug output of the rig library
+// to the log window.
+func (r *remoteRadio) deserializeLogLine(msg []byte) error {
+	line := sbRadio.LogLine{}
+	if err := line.Unmarshal(msg); err != nil {
+		return err
+	}
+	text := fmt.Sprintf("rig %s: %s", utils.LogLevelName(int(line.GetLevel())),
+		strings.TrimRight(line.GetMsg(), "\n"))
+	r.settings.Events.Pub(text, events.RadioLog)
+	return nil
+}
+
+// deserializeRotatorStatus shows the rotator as offline if either the
+// server or its connection to the rotator is down.
+func (r *remoteRadio) deserializeRotatorStatus(data []byte) error {
+
+	status := sbStatus.Status{}
+	if err := status.Unmarshal(data); err != nil {
+		return err
+	}
+
+	online := status.GetOnline() && status.GetRigConnected()
+	if online != r.rotatorOnline {
+		r.rotatorOnline = online
+		if !online && status.GetRigError() != "" {
+			r.logger.Println("Rotator Offline:", status.GetRigError())
+		} else {
+			r.logger.Println("Rotator Online:", online)
+		}
+	}
+	ui.SendCustomEvt("/rotator/status", online)
+
+	return nil
+}
+
+func (r *remoteRadio) deserializeRotatorAck(data []byte) error {
+
+	ack := sbRotator.Ack{}
+	if err := ack.Unmarshal(data); err != nil {
+		return err
+	}
+
+	sent, ok := r.pending[ack.GetRequestId()]
+	if !ok || ack.GetUserId() != r.userID {
+		// not our request
+		return nil
+	}
+	delete(r.pending, ack.GetRequestId())
+
+	status := "confirmed"
+	if ack.GetError() != "" {
+		status = "rejected"
+		r.logger.Println("Rotator:", ack.GetError())
+	}
+
+	ui.SendCustomEvt("/radio/requests", r.requestStatus(
+		fmt.Sprintf("%s (%dms)", status, time.Since(sent)/time.Millisecond)))
+
+	return nil
+}
+
+// sendRotatorRequest asks the server to turn, stop or park the rotator.
+func (r *remoteRadio) sendRotatorRequest(op string, azimuth, elevation float32) error {
+
+	if r.settings.RotRequestTopic == "" {
+		return errors.New("no rotator configured (mqtt.rotator)")
+	}
+
+	req := sbRotator.Request{
+		UserId:    r.userID,
+		RequestId: utils.RandStringRunes(8),
+		Operation: op,
+		Azimuth:   azimuth,
+		Elevation: elevation,
+	}
+
+	data, err := req.Marshal()
+	if err != nil {
+		return err
+	}
+
+	r.pending[req.GetRequestId()] = time.Now()
+	ui.SendCustomEvt("/radio/requests", r.requestStatus(""))
+
+	msg := comms.IOMsg{}
+	msg.Data = data
+	msg.Topic = r.settings.RotRequestTopic
+
+	r.settings.ToWireCh <- msg
+
+	return nil
+}
+
+// deserializeMorseProgress reports the progress of our CW messages.
+func (r *remoteRadio) deserializeMorseProgress(msg []byte) error {
+	p := sbRadio.MorseProgress{}
+	if err := p.Unmarshal(msg); err != nil {
+		return err
+	}
+
+	if p.GetUserId() != r.userID {
+		return nil
+	}
+
+	if !p.GetDone() {
+		ui.SendCustomEvt("/radio/requests", r.requestStatus(
+			fmt.Sprintf("CW %d/%d", p.GetSent(), p.GetTotal())))
+		return nil
+	}
+
+	delete(r.pending, p.GetRequestId())
+
+	status := "sent"
+	switch {
+	case p.GetError() != "":
+		status = "failed: " + p.GetError()
+	case p.GetAborted():
+		status = "aborted"
+	}
+	ui.SendCustomEvt("/radio/requests", r.requestStatus("CW "+status))
+	r.logger.Printf("CW %s (%d/%d characters)\n", status, p.GetSent(), p.GetTotal())
+
+	return nil
+}
+
+// deserializeAlarm reports that the SWR / ALC protection of the server
+// has unkeyed the transmitter.
+func (r *remoteRadio) deserializeAlarm(msg []byte) error {
+	a := sbRadio.Alarm{}
+	if err := a.Unmarshal(msg); err != nil {
+		return err
+	}
+
+	band := ""
+	if a.GetBand() != "" {
+		band = " (" + a.GetBand() + ")"
+	}
+
+	r.logger.Printf("ALARM: %s protection unkeyed %s on %.3fkHz%s: %s %.2f above the limit of %.2f for %dms, RFPOWER %.2f\n",
+		a.GetMeter(), a.GetUserId(), a.GetFrequency()/1000, band,
+		a.GetMeter(), a.GetValue(), a.GetLimit(), a.GetDuration(), a.GetRfpower())
+
+	return nil
+}
+
+// sendMorse asks the server to send a CW message or to abort the
+// message in progress.
+func (r *remoteRadio) sendMorse(text string, abort bool) error {
+	req := sbRadio.SendMorse{
+		UserId:    r.userID,
+		RequestId: utils.RandStringRunes(8),
+		Text:      text,
+		Speed:     int32(r.cwSpeed),
+		Abort:     abort,
+	}
+
+	data, err := req.Marshal()
+	if err != nil {
+		return err
+	}
+
+	if !abort {
+		r.pending[req.GetRequestId()] = time.Now()
+		ui.SendCustomEvt("/radio/requests", r.requestStatus(""))
+	}
+
+	msg := comms.IOMsg{}
+	msg.Data = data
+	msg.Topic = r.settings.MorseTopic
+
+	r.settings.ToWireCh <- msg
+
+	return nil
+}
+
+// sendLogLevelRequest asks the server to change the level of the
+// forwarded debug output of the rig library.
+func (r *remoteRadio) sendLogLevelRequest(level int) error {
+	req := sbRadio.SetLogLevel{
+		UserId:    r.userID,
+		Level:     int32(level),
+		RequestId: utils.RandStringRunes(8),
+	}
+
+	data, err := req.Marshal()
+	if err != nil {
+		return err
+	}
+
+	r.pending[req.GetRequestId()] = time.Now()
+	ui.SendCustomEvt("/radio/requests", r.requestStatus(""))
+
+	msg := comms.IOMsg{}
+	msg.Data = data
+	msg.Topic = r.settings.LogLevelTopic
+
+	r.settings.ToWireCh <- msg
+
+	return nil
+}
+
+// sendLockRequest asks the server to acquire or release exclusive
+// control of the radio.
+func (r *remoteRadio) sendLockRequest(acquire, takeover bool) error {
+	req := sbRadio.Lock{
+		UserId:    r.userID,
+		Acquire:   acquire,
+		Takeover:  takeover,
+		RequestId: utils.RandStringRunes(8),
+	}
+
+	data, err := req.Marshal()
+	if err != nil {
+		return err
+	}
+
+	r.pending[req.GetRequestId()] = time.Now()
+	ui.SendCustomEvt("/radio/requests", r.requestStatus(""))
+
+	msg := comms.IOMsg{}
+	msg.Data = data
+	msg.Topic = r.settings.LockTopic
+
+	r.settings.ToWireCh <- msg
+
+	return nil
+}
+
+// sendMemoryRequest asks the server to list, read, write, clear or
+// recall a memory channel.
+func (r *remoteRadio) sendMemoryRequest(op string, ch int32, data *sbRadio.Channel) error {
+	req := sbRadio.MemoryRequest{
+		UserId:    r.userID,
+		RequestId: utils.RandStringRunes(8),
+		Operation: op,
+		Channel:   ch,
+		Data:      data,
+	}
+
+	msg, err := req.Marshal()
+	if err != nil {
+		return err
+	}
+
+	r.pending[req.GetRequestId()] = time.Now()
+	ui.SendCustomEvt("/radio/requests", r.requestStatus(""))
+
+	r.settings.ToWireCh <- comms.IOMsg{
+		Data:  msg,
+		Topic: r.settings.MemRequestTopic,
+	}
+
+	return nil
+}
+
+// deserializeMemResponse prints the memory channels which
+// we have requested.
+func (r *remoteRadio) deserializeMemResponse(data []byte) error {
+
+	resp := sbRadio.MemoryResponse{}
+	if err := resp.Unmarshal(data); err != nil {
+		return err
+	}
+
+	if resp.GetUserId() != r.userID {
+		return nil
+	}
+
+	if len(resp.GetChannels()) == 0 {
+		r.logger.Println("No memory channels in use")
+		return nil
+	}
+
+	for _, c := range resp.GetChannels() {
+		r.logger.Println(formatChannel(c))
+	}
+
+	return nil
+}
+
+func formatChannel(c *sbRadio.Channel) string {
+	if c.GetFrequency() == 0 {
+		return fmt.Sprintf("%3d: empty", c.GetChannel())
+	}
+
+	s := fmt.Sprintf("%3d: %-10s %.3fkHz %s %dHz", c.GetChannel(),
+		c.GetName(), c.GetFrequency()/1000, c.GetMode(), c.GetPbWidth())
+
+	if c.GetSplit().GetEnabled() {
+		s += fmt.Sprintf(" TX: %.3fkHz %s", c.Split.GetFrequency()/1000, c.Split.GetMode())
+	}
+	if c.GetCtcssTone() > 0 {
+		s += fmt.Sprintf(" CTCSS: %.1fHz", float32(c.GetCtcssTone())/10)
+	}
+	if c.GetCtcssSql() > 0 {
+		s += fmt.Sprintf(" CTCSS SQL: %.1fHz", float32(c.GetCtcssSql())/10)
+	}
+	if c.GetDcsCode() > 0 {
+		s += fmt.Sprintf(" DCS: %d", c.GetDcsCode())
+	}
+	if c.GetDcsSql() > 0 {
+		s += fmt.Sprintf(" DCS SQL: %d", c.GetDcsSql())
+	}
+
+	return s
+}
+
 func (r *remoteRadio) initSetState() sbRadio.SetState {
 	request := sbRadio.SetState{}
 
@@ -430,6 +981,7 @@ func (r *remoteRadio) initSetState() sbRadio.SetState {
 	request.Vfo.Split = &sbRadio.Split{}
 	request.Md = &sbRadio.MetaData{}
 	request.UserId = r.userID
+	request.RequestId = utils.RandStringRunes(8)
 
 	return request
 }
@@ -0,0 +1,101 @@
+package cligui
+
+import (
+	"fmt"
+	"math"
+	"strings"
+
+	sbRotator "github.com/dh1tw/remoteRadio/sb_rotator"
+	ui "github.com/gizak/termui"
+)
+
+// compassRose draws the azimuth of the rotator as a needle on a compass
+// rose of the given size in characters. The target is marked with a '+'
+// on the circle.
+func compassRose(width, height int, pos sbRotator.Position) []string {
+
+	if width < 5 || height < 3 {
+		return []string{}
+	}
+
+	grid := make([][]rune, height)
+	for i := range grid {
+		grid[i] = []rune(strings.Repeat(" ", width))
+	}
+
+	cx, cy := float64(width-1)/2, float64(height-1)/2
+
+	set := func(azimuth, radius float64, r rune) {
+		rad := azimuth * math.Pi / 180
+		x := int(math.Floor(cx + math.Sin(rad)*cx*radius + 0.5))
+		y := int(math.Floor(cy - math.Cos(rad)*cy*radius + 0.5))
+		if y >= 0 && y < height && x >= 0 && x < width {
+			grid[y][x] = r
+		}
+	}
+
+	for az := 0.0; az < 360; az += 15 {
+		set(az, 1, '.')
+	}
+	set(0, 1, 'N')
+	set(90, 1, 'E')
+	set(180, 1, 'S')
+	set(270, 1, 'W')
+
+	if pos.GetHasTarget() {
+		set(float64(pos.GetTargetAzimuth()), 1, '+')
+	}
+
+	for radius := 0.2; radius < 0.95; radius += 0.1 {
+		set(float64(pos.GetAzimuth()), radius, '*')
+	}
+	set(0, 0, 'o')
+
+	lines := make([]string, 0, height)
+	for _, row := range grid {
+		lines = append(lines, string(row))
+	}
+	return lines
+}
+
+// updateRotatorPosition redraws the compass with the position of the
+// rotator.
+func (rg *radioGui) updateRotatorPosition(ev ui.Event) {
+	rg.rotPosition = ev.Data.(sbRotator.Position)
+	rg.drawCompass()
+}
+
+// updateRotatorStatus handles the events in case the rotator goes
+// offline or becomes online.
+func (rg *radioGui) updateRotatorStatus(ev ui.Event) {
+	rg.rotOnline = ev.Data.(bool)
+	rg.drawCompass()
+}
+
+func (rg *radioGui) drawCompass() {
+	if rg.compass == nil {
+		return
+	}
+
+	pos := rg.rotPosition
+
+	// border and two lines of text
+	lines := compassRose(rg.compass.Width-2, rg.compass.Height-4, pos)
+
+	if !rg.rotOnline {
+		lines = append(lines, "OFFLINE", "")
+	} else {
+		lines = append(lines, fmt.Sprintf("AZ %.0f EL %.0f", pos.GetAzimuth(), pos.GetElevation()))
+		switch {
+		case pos.GetHasTarget():
+			lines = append(lines, fmt.Sprintf("-> %.0f/%.0f", pos.GetTargetAzimuth(), pos.GetTargetElevation()))
+		case pos.GetMoving():
+			lines = append(lines, "moving")
+		default:
+			lines = append(lines, "")
+		}
+	}
+
+	rg.compass.Text = strings.Join(lines, "\n")
+	ui.Render(rg.compass)
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/cskr/pubsub"
 	"github.com/dh1tw/remoteRadio/events"
 	sbRadio "github.com/dh1tw/remoteRadio/sb_radio"
+	sbRotator "github.com/dh1tw/remoteRadio/sb_rotator"
 	"github.com/dh1tw/remoteRadio/utils"
 	ui "github.com/gizak/termui"
 )
@@ -29,6 +30,7 @@ type radioGui struct {
 	sMeter               *ui.Gauge
 	powerMeter           *ui.Gauge
 	swrMeter             *ui.Gauge
+	txMeters             *ui.Par
 	vfo                  *ui.Par
 	mode                 *ui.Par
 	filter               *ui.Par
@@ -39,6 +41,8 @@ type radioGui struct {
 	txMode               *ui.Par
 	txFilter             *ui.Par
 	operations           *ui.List
+	requests             *ui.Par
+	lock                 *ui.Par
 	log                  *ui.List
 	cli                  *ui.Input
 	state                sbRadio.State
@@ -46,6 +50,14 @@ type radioGui struct {
 	internalFreq         float64
 	lastFreqChange       time.Time
 	radioOnline          bool
+	// not reset by init(); the server keeps reconnecting to the rig
+	// independently of the connection to the server
+	rigDisconnected bool
+	// the rotator is served independently of the radio
+	hasRotator  bool
+	compass     *ui.Par
+	rotPosition sbRotator.Position
+	rotOnline   bool
 }
 
 // initialize the gui components
@@ -124,6 +136,10 @@ func (rg *radioGui) init() {
 	rg.powerMeter.Percent = 0
 	rg.powerMeter.Label = ""
 
+	rg.txMeters = ui.NewPar("")
+	rg.txMeters.Height = 3
+	rg.txMeters.BorderLabel = "TX Meters"
+
 	rg.mode = ui.NewPar("")
 	rg.mode.Height = 3
 	rg.mode.BorderLabel = "Mode"
@@ -160,6 +176,14 @@ func (rg *radioGui) init() {
 	rg.txFilter.Height = 3
 	rg.txFilter.BorderLabel = "TX Filter"
 
+	rg.requests = ui.NewPar("")
+	rg.requests.Height = 3
+	rg.requests.BorderLabel = "Requests"
+
+	rg.lock = ui.NewPar("")
+	rg.lock.Height = 3
+	rg.lock.BorderLabel = "Locked by"
+
 	rg.operations = ui.NewList()
 	rg.operations.Items = []string{}
 	rg.operations.BorderLabel = "Operations"
@@ -168,6 +192,12 @@ func (rg *radioGui) init() {
 	rg.log = ui.NewList()
 	rg.log.Items = []string{}
 	rg.log.BorderLabel = "Logging"
+	if rg.hasRotator {
+		rg.compass = ui.NewPar("")
+		rg.compass.Height = 13
+		rg.compass.BorderLabel = "Rotator"
+	}
+
 	rg.log.Height = rg.calcLogWindowHeight()
 
 	if rg.cli != nil {
@@ -188,7 +218,7 @@ func (rg *radioGui) init() {
 		ui.NewRow(
 			ui.NewCol(2, 0, rg.info, rg.latency),
 			ui.NewCol(8, 0, rg.frequency),
-			ui.NewCol(2, 0, rg.powerMeter, rg.swrMeter, rg.sMeter)),
+			ui.NewCol(2, 0, rg.powerMeter, rg.swrMeter, rg.sMeter, rg.txMeters)),
 		ui.NewRow(
 			ui.NewCol(2, 0, rg.powerOn),
 			ui.NewCol(1, 0, rg.vfo),
@@ -196,17 +226,18 @@ func (rg *radioGui) init() {
 			ui.NewCol(2, 0, rg.filter),
 			ui.NewCol(2, 0, rg.rit),
 			ui.NewCol(2, 0, rg.xit),
-			ui.NewCol(2, 0, nil)),
+			ui.NewCol(2, 0, rg.requests)),
 		ui.NewRow(
 			ui.NewCol(2, 0, rg.ptt),
 			ui.NewCol(1, 0, rg.split),
 			ui.NewCol(2, 0, rg.txFrequency),
 			ui.NewCol(1, 0, rg.txMode),
-			ui.NewCol(2, 0, rg.txFilter)),
+			ui.NewCol(2, 0, rg.txFilter),
+			ui.NewCol(2, 0, rg.lock)),
 		ui.NewRow(
 			ui.NewCol(2, 0, rg.functions, rg.operations),
 			ui.NewCol(8, 0, rg.log),
-			ui.NewCol(2, 0, rg.levels, rg.parameters)),
+			ui.NewCol(2, 0, rg.rightColumn()...)),
 		ui.NewRow(
 			ui.NewCol(12, 0, rg.cli)),
 	)
@@ -215,6 +246,7 @@ func (rg *radioGui) init() {
 	ui.Body.Align()
 
 	ui.Render(ui.Body)
+	rg.drawCompass()
 
 }
 
@@ -224,6 +256,9 @@ func (rg *radioGui) calcLogWindowHeight() int {
 
 	leftColumn := rg.functions.Height + rg.operations.Height
 	rightColumn := rg.levels.Height + rg.parameters.Height
+	if rg.compass != nil {
+		rightColumn += rg.compass.Height
+	}
 	if leftColumn > rightColumn {
 		height = leftColumn
 	} else {
@@ -233,6 +268,15 @@ func (rg *radioGui) calcLogWindowHeight() int {
 	return height
 }
 
+// rightColumn returns the widgets next to the log window.
+func (rg *radioGui) rightColumn() []ui.GridBufferer {
+	widgets := []ui.GridBufferer{rg.levels, rg.parameters}
+	if rg.compass != nil {
+		widgets = append(widgets, rg.compass)
+	}
+	return widgets
+}
+
 func (rg *radioGui) updateCaps(ev ui.Event) {
 
 	// this event could still thrown by a retained
@@ -271,6 +315,7 @@ func (rg *radioGui) updateCaps(ev ui.Event) {
 	ui.Clear()
 	ui.Body.Align()
 	ui.Render(ui.Body)
+	rg.drawCompass()
 }
 
 func (rg *radioGui) addLogEntry(ev ui.Event) {
@@ -317,6 +362,10 @@ func (rg *radioGui) updateState(ev ui.Event) {
 	} else {
 		rg.ptt.Bg = ui.ColorDefault
 	}
+	// show why the server has unkeyed the transmitter
+	rg.ptt.Text = rg.state.PttOffReason
+
+	rg.lock.Text = rg.state.LockHolder
 	if rg.state.RadioOn {
 		rg.powerOn.Bg = ui.ColorGreen
 	} else {
@@ -333,32 +382,6 @@ func (rg *radioGui) updateState(ev ui.Event) {
 		rg.txMode.Text = ""
 		rg.txFilter.Text = ""
 	}
-	if rg.state.Ptt {
-		rg.sMeter.Percent = 0
-		rg.sMeter.Label = ""
-		if pValue, ok := rg.state.Vfo.Levels["METER"]; ok {
-			rg.powerMeter.Label = fmt.Sprintf("%vW", pValue)
-		}
-		if swrValue, ok := rg.state.Vfo.Levels["SWR"]; ok {
-			rg.swrMeter.Label = fmt.Sprintf("1:%.2f", swrValue)
-		}
-	} else {
-		rg.powerMeter.Percent = 0
-		rg.powerMeter.Label = ""
-		rg.powerMeter.Percent = 0
-		rg.swrMeter.Label = ""
-		if sValue, ok := rg.state.Vfo.Levels["STRENGTH"]; ok {
-			if sValue < 0 {
-				s := int((59 - sValue*-1) / 6)
-				rg.sMeter.Label = fmt.Sprintf("S%v", s)
-				rg.sMeter.Percent = int((59 - sValue*-1) * 100 / 114)
-			} else {
-				rg.sMeter.Label = fmt.Sprintf("S9+%vdB", int(sValue))
-				rg.sMeter.Percent = int((sValue + 59) * 100 / 114)
-			}
-		}
-	}
-
 	for i, el := range rg.levelsData {
 		for name, value := range rg.state.Vfo.Levels {
 			if el.Label == name {
@@ -416,9 +439,73 @@ func (rg *radioGui) updateRadioStatus(ev ui.Event) {
 	ui.Render(ui.Body)
 }
 
+// updateRigStatus handles the events in case the server lost or
+// regained the connection to the rig
+func (rg *radioGui) updateRigStatus(ev ui.Event) {
+	rg.rigDisconnected = !ev.Data.(bool)
+	rg.syncFrequency(ev)
+}
+
+// updateRequests shows if our SetState requests are still pending
+// or have been confirmed by the server
+func (rg *radioGui) updateRequests(ev ui.Event) {
+	rg.requests.Text = ev.Data.(string)
+	ui.Render(rg.requests)
+}
+
+func (rg *radioGui) updateMeters(ev ui.Event) {
+
+	meters := ev.Data.(sbRadio.Meters)
+
+	if meters.Ptt {
+		rg.sMeter.Percent = 0
+		rg.sMeter.Label = ""
+		if pValue, ok := meters.Values["RFPOWER_METER"]; ok {
+			rg.powerMeter.Percent = int(pValue * 100)
+			rg.powerMeter.Label = fmt.Sprintf("%v%%", int(pValue*100))
+		}
+		if swrValue, ok := meters.Values["SWR"]; ok {
+			// full scale at 1:3
+			rg.swrMeter.Percent = int(math.Min(100, float64(swrValue-1)*50))
+			rg.swrMeter.Label = fmt.Sprintf("1:%.2f", swrValue)
+		}
+	} else {
+		rg.powerMeter.Percent = 0
+		rg.powerMeter.Label = ""
+		rg.swrMeter.Percent = 0
+		rg.swrMeter.Label = ""
+		if sValue, ok := meters.Values["STRENGTH"]; ok {
+			if sValue < 0 {
+				s := int((59 - sValue*-1) / 6)
+				rg.sMeter.Label = fmt.Sprintf("S%v", s)
+				rg.sMeter.Percent = int((59 - sValue*-1) * 100 / 114)
+			} else {
+				rg.sMeter.Label = fmt.Sprintf("S9+%vdB", int(sValue))
+				rg.sMeter.Percent = int((sValue + 59) * 100 / 114)
+			}
+		}
+	}
+
+	txMeters := []string{}
+	if comp, ok := meters.Values["COMP_METER"]; ok {
+		txMeters = append(txMeters, fmt.Sprintf("COMP %.0fdB", comp))
+	}
+	if idd, ok := meters.Values["ID_METER"]; ok {
+		txMeters = append(txMeters, fmt.Sprintf("IDD %.1fA", idd))
+	}
+	if vdd, ok := meters.Values["VD_METER"]; ok {
+		txMeters = append(txMeters, fmt.Sprintf("VDD %.1fV", vdd))
+	}
+	rg.txMeters.Text = strings.Join(txMeters, " ")
+
+	ui.Render(rg.powerMeter, rg.swrMeter, rg.sMeter, rg.txMeters)
+}
+
 func (rg *radioGui) syncFrequency(ev ui.Event) {
 	if rg.radioOnline {
-		if rg.state.RadioOn {
+		if rg.rigDisconnected {
+			rg.frequency.Text = "RIG DISCONNECTED"
+		} else if rg.state.RadioOn {
 			if time.Since(rg.lastFreqChange) > time.Millisecond*300 {
 				rg.internalFreq = rg.state.Vfo.Frequency
 				rg.frequency.Text = utils.FormatFreq(rg.internalFreq)
@@ -432,16 +519,21 @@ func (rg *radioGui) syncFrequency(ev ui.Event) {
 	ui.Render(rg.frequency)
 }
 
-func guiLoop(caps sbRadio.Capabilities, evPS *pubsub.PubSub) {
+func guiLoop(caps sbRadio.Capabilities, evPS *pubsub.PubSub, hasRotator bool) {
 
-	rg := &radioGui{}
+	rg := &radioGui{hasRotator: hasRotator}
 	rg.init()
 
 	ui.Handle("/radio/caps", rg.updateCaps)
 	ui.Handle("/radio/state", rg.updateState)
+	ui.Handle("/radio/meters", rg.updateMeters)
 	ui.Handle("/log/msg", rg.addLogEntry)
 	ui.Handle("/network/latency", rg.updateLatency)
 	ui.Handle("/radio/status", rg.updateRadioStatus)
+	ui.Handle("/radio/rig", rg.updateRigStatus)
+	ui.Handle("/radio/requests", rg.updateRequests)
+	ui.Handle("/rotator/position", rg.updateRotatorPosition)
+	ui.Handle("/rotator/status", rg.updateRotatorStatus)
 	ui.Handle("/timer/1s", rg.syncFrequency)
 
 	// ui.Handle("/sys/kbd/<up>", func(ui.Event) {
@@ -30,7 +30,7 @@ import (
 	"github.com/dh1tw/remoteRadio/cliclient"
 	"github.com/dh1tw/remoteRadio/comms"
 	"github.com/dh1tw/remoteRadio/events"
-	"github.com/dh1tw/remoteRadio/utils"
+	"github.com/dh1tw/remoteRadio/ping"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 )
@@ -46,9 +46,10 @@ var clientMqttCmd = &cobra.Command{
 func init() {
 	clientCmd.AddCommand(clientMqttCmd)
 	clientMqttCmd.Flags().StringP("broker-url", "u", "localhost", "Broker URL")
-	clientMqttCmd.Flags().IntP("broker-port", "p", 1883, "Broker Port")
+	clientMqttCmd.Flags().IntP("broker-port", "p", 0, "Broker Port (default: 1883 tcp, 8883 ssl, 80 ws, 443 wss)")
 	clientMqttCmd.Flags().StringP("station", "X", "mystation", "Your station callsign")
 	clientMqttCmd.Flags().StringP("radio", "Y", "myradio", "Radio ID")
+	addMqttConnectionFlags(clientMqttCmd)
 }
 
 func mqttCliClient(cmd *cobra.Command, args []string) {
@@ -63,38 +64,69 @@ func mqttCliClient(cmd *cobra.Command, args []string) {
 	viper.BindPFlag("mqtt.broker_port", cmd.Flags().Lookup("broker-port"))
 	viper.BindPFlag("mqtt.station", cmd.Flags().Lookup("station"))
 	viper.BindPFlag("mqtt.radio", cmd.Flags().Lookup("radio"))
+	bindMqttConnectionFlags(cmd)
 
-	if viper.IsSet("general.user_id") {
-		viper.Set("general.user_id", utils.RandStringRunes(5))
-	} else {
-		viper.Set("general.user_id", "unknown_"+utils.RandStringRunes(5))
+	userID, err := clientUserID()
+	if err != nil {
+		fmt.Println(err)
+		os.Exit(1)
 	}
 
 	mqttBrokerURL := viper.GetString("mqtt.broker_url")
 	mqttBrokerPort := viper.GetInt("mqtt.broker_port")
-	mqttClientID := viper.GetString("general.user_id")
+	mqttClientID := newClientID(userID)
 
 	baseTopic := viper.GetString("mqtt.station") +
 		"/radios/" + viper.GetString("mqtt.radio") +
 		"/cat"
 
-	serverCatRequestTopic := baseTopic + "/setstate"
+	// the requests are published to a sub topic named after our user_id
+	serverCatRequestTopic := baseTopic + "/setstate/" + userID
 	serverStatusTopic := baseTopic + "/status"
-	//	serverPingTopic := baseTopic + "/ping"
-	// errorTopic := baseTopic + "/error"
+	serverLockTopic := baseTopic + "/lock/" + userID
+	serverMemRequestTopic := baseTopic + "/mem/request/" + userID
+	serverResyncTopic := baseTopic + "/resync"
+	serverLogLevelTopic := baseTopic + "/loglevel"
+	serverMorseTopic := baseTopic + "/morse/send/" + userID
+	serverPingTopic := baseTopic + "/ping"
 
 	// tx topics
 	serverCatResponseTopic := baseTopic + "/state"
+	serverDeltaTopic := baseTopic + "/delta"
+	serverEchoTopic := baseTopic + "/echo"
+	serverMetersTopic := baseTopic + "/meters"
+	serverLogTopic := baseTopic + "/log"
+	serverMorseProgressTopic := baseTopic + "/morse/progress"
+	serverAlarmTopic := baseTopic + "/alarm"
+
+	// last will of the server processes
+	serversStatusTopic := viper.GetString("mqtt.station") + "/servers/+/status"
 	serverCapsTopic := baseTopic + "/caps"
 	serverPongTopic := baseTopic + "/pong"
+	serverErrorTopic := baseTopic + "/error"
+	serverAckTopic := baseTopic + "/ack"
+	serverMemChannelsTopic := baseTopic + "/mem/channels"
 
-	mqttRxTopics := []string{serverCatResponseTopic, serverCapsTopic, serverPongTopic, serverStatusTopic}
+	mqttRxTopics := []string{serverCatResponseTopic, serverCapsTopic,
+		serverPongTopic, serverStatusTopic, serverErrorTopic, serverAckTopic,
+		serverMemChannelsTopic, serverDeltaTopic, serverMetersTopic,
+		serversStatusTopic, serverLogTopic, serverMorseProgressTopic,
+		serverAlarmTopic, serverEchoTopic}
 
 	toWireCh := make(chan comms.IOMsg, 20)
 	toDeserializeCatResponseCh := make(chan []byte, 10)
+	toDeserializeStateDeltaCh := make(chan []byte, 10)
+	toDeserializeEchoCh := make(chan []byte, 10)
+	toDeserializeMetersCh := make(chan []byte, 10)
+	toDeserializeLogCh := make(chan []byte, 50)
+	toDeserializeMorseProgressCh := make(chan []byte, 10)
+	toDeserializeAlarmCh := make(chan []byte, 10)
 	toDeserializePingResponseCh := make(chan []byte, 10)
 	toDeserializeCapsCh := make(chan []byte, 5)
 	toDeserializeStatusCh := make(chan []byte, 5)
+	toDeserializeErrorCh := make(chan []byte, 10)
+	toDeserializeAckCh := make(chan []byte, 10)
+	toDeserializeMemResponseCh := make(chan []byte, 10)
 
 	// Event PubSub
 	evPS := pubsub.New(1)
@@ -102,39 +134,77 @@ func mqttCliClient(cmd *cobra.Command, args []string) {
 	// WaitGroup to coordinate a graceful shutdown
 	var wg sync.WaitGroup
 
+	// the server unkeys the transmitter if our pings stop
+	pingSettings := ping.Settings{
+		ToWireCh:  toWireCh,
+		PingTopic: serverPingTopic,
+		PongCh:    toDeserializePingResponseCh,
+		UserID:    userID,
+		WaitGroup: &wg,
+		Events:    evPS,
+	}
+
 	mqttSettings := comms.MqttSettings{
-		WaitGroup:  &wg,
-		Transport:  "tcp",
-		BrokerURL:  mqttBrokerURL,
-		BrokerPort: mqttBrokerPort,
-		ClientID:   mqttClientID,
-		Topics:     mqttRxTopics,
-		ToDeserializeCatResponseCh:  toDeserializeCatResponseCh,
-		ToDeserializeCatRequestCh:   toDeserializePingResponseCh,
-		ToDeserializeCapabilitiesCh: toDeserializeCapsCh,
-		ToDeserializeStatusCh:       toDeserializeStatusCh,
-		ToWire:                      toWireCh,
-		Events:                      evPS,
-		LastWill:                    nil,
+		WaitGroup:                    &wg,
+		BrokerURL:                    mqttBrokerURL,
+		BrokerPort:                   mqttBrokerPort,
+		ClientID:                     mqttClientID,
+		Topics:                       mqttRxTopics,
+		ToDeserializeCatResponseCh:   toDeserializeCatResponseCh,
+		ToDeserializeStateDeltaCh:    toDeserializeStateDeltaCh,
+		ToDeserializeEchoCh:          toDeserializeEchoCh,
+		ToDeserializeMetersCh:        toDeserializeMetersCh,
+		ToDeserializeLogCh:           toDeserializeLogCh,
+		ToDeserializeMorseProgressCh: toDeserializeMorseProgressCh,
+		ToDeserializeAlarmCh:         toDeserializeAlarmCh,
+		ToDeserializeCapabilitiesCh:  toDeserializeCapsCh,
+		ToDeserializeStatusCh:        toDeserializeStatusCh,
+		ToDeserializePingResponseCh:  toDeserializePingResponseCh,
+		ToDeserializeErrorCh:         toDeserializeErrorCh,
+		ToDeserializeAckCh:           toDeserializeAckCh,
+		ToDeserializeMemResponseCh:   toDeserializeMemResponseCh,
+		ToWire:                       toWireCh,
+		Events:                       evPS,
+		LastWill:                     nil,
+	}
+
+	if err := applyMqttConnection(&mqttSettings); err != nil {
+		fmt.Println("mqtt:", err)
+		os.Exit(1)
 	}
 
 	remoteRadioSettings := cliClient.RemoteRadioSettings{
 		CatResponseCh:   toDeserializeCatResponseCh,
+		StateDeltaCh:    toDeserializeStateDeltaCh,
+		EchoCh:          toDeserializeEchoCh,
+		MetersCh:        toDeserializeMetersCh,
+		LogCh:           toDeserializeLogCh,
+		MorseProgressCh: toDeserializeMorseProgressCh,
+		AlarmCh:         toDeserializeAlarmCh,
 		RadioStatusCh:   toDeserializeStatusCh,
+		ErrorCh:         toDeserializeErrorCh,
+		AckCh:           toDeserializeAckCh,
 		CapabilitiesCh:  toDeserializeCapsCh,
 		ToWireCh:        toWireCh,
 		CatRequestTopic: serverCatRequestTopic,
+		LockTopic:       serverLockTopic,
+		MemRequestTopic: serverMemRequestTopic,
+		ResyncTopic:     serverResyncTopic,
+		LogLevelTopic:   serverLogLevelTopic,
+		MorseTopic:      serverMorseTopic,
+		MemResponseCh:   toDeserializeMemResponseCh,
 		Events:          evPS,
 		WaitGroup:       &wg,
 	}
 
-	wg.Add(3) //MQTT + RemoteRadio + SysEvents
+	wg.Add(4) //MQTT + RemoteRadio + SysEvents + Ping
 
 	connectionStatusCh := evPS.Sub(events.MqttConnStatus)
 	osExitCh := evPS.Sub(events.OsExit)
 	shutdownCh := evPS.Sub(events.Shutdown)
 
 	go events.WatchSystemEvents(evPS, &wg)
+	go ping.CheckLatency(pingSettings)
 	go cliClient.HandleRemoteRadio(remoteRadioSettings)
 	time.Sleep(200 * time.Millisecond)
 	go comms.MqttClient(mqttSettings)
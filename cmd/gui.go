@@ -48,9 +48,11 @@ var guiMqttCmd = &cobra.Command{
 func init() {
 	RootCmd.AddCommand(guiMqttCmd)
 	guiMqttCmd.Flags().StringP("broker-url", "u", "localhost", "Broker URL")
-	guiMqttCmd.Flags().IntP("broker-port", "p", 1883, "Broker Port")
+	guiMqttCmd.Flags().IntP("broker-port", "p", 0, "Broker Port (default: 1883 tcp, 8883 ssl, 80 ws, 443 wss)")
 	guiMqttCmd.Flags().StringP("station", "X", "mystation", "Your station callsign")
 	guiMqttCmd.Flags().StringP("radio", "Y", "myradio", "Radio ID")
+	guiMqttCmd.Flags().StringP("rotator", "", "", "Rotator ID (optional)")
+	addMqttConnectionFlags(guiMqttCmd)
 }
 
 func guiCliClient(cmd *cobra.Command, args []string) {
@@ -65,40 +67,70 @@ func guiCliClient(cmd *cobra.Command, args []string) {
 	viper.BindPFlag("mqtt.broker_port", cmd.Flags().Lookup("broker-port"))
 	viper.BindPFlag("mqtt.station", cmd.Flags().Lookup("station"))
 	viper.BindPFlag("mqtt.radio", cmd.Flags().Lookup("radio"))
+	viper.BindPFlag("mqtt.rotator", cmd.Flags().Lookup("rotator"))
+	bindMqttConnectionFlags(cmd)
 
-	if viper.IsSet("general.user_id") {
-		viper.Set("general.user_id", utils.RandStringRunes(5))
-	} else {
-		viper.Set("general.user_id", "unknown_"+utils.RandStringRunes(5))
+	userID, err := clientUserID()
+	if err != nil {
+		fmt.Println(err)
+		os.Exit(1)
 	}
 
-	userID := viper.GetString("general.user_id")
-
 	mqttBrokerURL := viper.GetString("mqtt.broker_url")
 	mqttBrokerPort := viper.GetInt("mqtt.broker_port")
-	mqttClientID := viper.GetString("general.user_id")
+	mqttClientID := newClientID(userID)
 
 	baseTopic := viper.GetString("mqtt.station") +
 		"/radios/" + viper.GetString("mqtt.radio") +
 		"/cat"
 
-	serverCatRequestTopic := baseTopic + "/setstate"
+	// the requests are published to a sub topic named after our user_id
+	serverCatRequestTopic := baseTopic + "/setstate/" + userID
 	serverStatusTopic := baseTopic + "/status"
+	serverLockTopic := baseTopic + "/lock/" + userID
+	serverMemRequestTopic := baseTopic + "/mem/request/" + userID
+	serverResyncTopic := baseTopic + "/resync"
+	serverLogLevelTopic := baseTopic + "/loglevel"
+	serverMorseTopic := baseTopic + "/morse/send/" + userID
 	serverPingTopic := baseTopic + "/ping"
-	// errorTopic := baseTopic + "/error"
 
 	// tx topics
 	serverCatResponseTopic := baseTopic + "/state"
+	serverDeltaTopic := baseTopic + "/delta"
+	serverEchoTopic := baseTopic + "/echo"
+	serverMetersTopic := baseTopic + "/meters"
+	serverLogTopic := baseTopic + "/log"
+	serverMorseProgressTopic := baseTopic + "/morse/progress"
+	serverAlarmTopic := baseTopic + "/alarm"
+
+	// last will of the server processes
+	serversStatusTopic := viper.GetString("mqtt.station") + "/servers/+/status"
 	serverCapsTopic := baseTopic + "/caps"
 	serverPongTopic := baseTopic + "/pong"
+	serverErrorTopic := baseTopic + "/error"
+	serverAckTopic := baseTopic + "/ack"
+	serverMemChannelsTopic := baseTopic + "/mem/channels"
 
-	mqttRxTopics := []string{serverCatResponseTopic, serverCapsTopic, serverPongTopic, serverStatusTopic}
+	mqttRxTopics := []string{serverCatResponseTopic, serverCapsTopic,
+		serverPongTopic, serverStatusTopic, serverErrorTopic, serverAckTopic,
+		serverMemChannelsTopic, serverDeltaTopic, serverMetersTopic,
+		serversStatusTopic, serverLogTopic, serverMorseProgressTopic,
+		serverAlarmTopic, serverEchoTopic}
 
 	toWireCh := make(chan comms.IOMsg, 20)
 	toDeserializeCatResponseCh := make(chan []byte, 10)
+	toDeserializeStateDeltaCh := make(chan []byte, 10)
+	toDeserializeEchoCh := make(chan []byte, 10)
+	toDeserializeMetersCh := make(chan []byte, 10)
+	toDeserializeLogCh := make(chan []byte, 50)
+	toDeserializeMorseProgressCh := make(chan []byte, 10)
+	toDeserializeAlarmCh := make(chan []byte, 10)
 	toDeserializePingResponseCh := make(chan []byte, 10)
 	toDeserializeCapsCh := make(chan []byte, 5)
 	toDeserializeStatusCh := make(chan []byte, 5)
+	toDeserializeErrorCh := make(chan []byte, 10)
+	toDeserializeAckCh := make(chan []byte, 10)
+	toDeserializeMemResponseCh := make(chan []byte, 10)
 
 	// Event PubSub
 	evPS := pubsub.New(10)
@@ -118,33 +150,85 @@ func guiCliClient(cmd *cobra.Command, args []string) {
 	appLogger := utils.NewChLogger(evPS, events.AppLog, "")
 
 	mqttSettings := comms.MqttSettings{
-		WaitGroup:  &wg,
-		Transport:  "tcp",
-		BrokerURL:  mqttBrokerURL,
-		BrokerPort: mqttBrokerPort,
-		ClientID:   mqttClientID,
-		Topics:     mqttRxTopics,
-		ToDeserializeCatResponseCh:  toDeserializeCatResponseCh,
-		ToDeserializeCatRequestCh:   toDeserializePingResponseCh,
-		ToDeserializeCapabilitiesCh: toDeserializeCapsCh,
-		ToDeserializeStatusCh:       toDeserializeStatusCh,
-		ToDeserializePingResponseCh: toDeserializePingResponseCh,
-		ToWire:   toWireCh,
-		Events:   evPS,
-		LastWill: nil,
-		Logger:   appLogger,
+		WaitGroup:                    &wg,
+		BrokerURL:                    mqttBrokerURL,
+		BrokerPort:                   mqttBrokerPort,
+		ClientID:                     mqttClientID,
+		Topics:                       mqttRxTopics,
+		ToDeserializeCatResponseCh:   toDeserializeCatResponseCh,
+		ToDeserializeStateDeltaCh:    toDeserializeStateDeltaCh,
+		ToDeserializeEchoCh:          toDeserializeEchoCh,
+		ToDeserializeMetersCh:        toDeserializeMetersCh,
+		ToDeserializeLogCh:           toDeserializeLogCh,
+		ToDeserializeMorseProgressCh: toDeserializeMorseProgressCh,
+		ToDeserializeAlarmCh:         toDeserializeAlarmCh,
+		ToDeserializeCapabilitiesCh:  toDeserializeCapsCh,
+		ToDeserializeStatusCh:        toDeserializeStatusCh,
+		ToDeserializeErrorCh:         toDeserializeErrorCh,
+		ToDeserializeAckCh:           toDeserializeAckCh,
+		ToDeserializeMemResponseCh:   toDeserializeMemResponseCh,
+		ToDeserializePingResponseCh:  toDeserializePingResponseCh,
+		ToWire:                       toWireCh,
+		Events:                       evPS,
+		LastWill:                     nil,
+		Logger:                       appLogger,
+	}
+
+	if err := applyMqttConnection(&mqttSettings); err != nil {
+		fmt.Println("mqtt:", err)
+		os.Exit(1)
+	}
+
+	// the rotator (optional) is shown as a compass
+	var toDeserializeRotPositionCh, toDeserializeRotStatusCh, toDeserializeRotAckCh chan []byte
+	rotRequestTopic := ""
+
+	if rotatorID := viper.GetString("mqtt.rotator"); rotatorID != "" {
+		rotBaseTopic := viper.GetString("mqtt.station") +
+			"/rotators/" + rotatorID +
+			"/rot"
+
+		rotRequestTopic = rotBaseTopic + "/request"
+		mqttSettings.Topics = append(mqttSettings.Topics,
+			rotBaseTopic+"/position", rotBaseTopic+"/status", rotBaseTopic+"/ack")
+
+		toDeserializeRotPositionCh = make(chan []byte, 10)
+		toDeserializeRotStatusCh = make(chan []byte, 5)
+		toDeserializeRotAckCh = make(chan []byte, 10)
+		mqttSettings.ToDeserializeRotPositionCh = toDeserializeRotPositionCh
+		mqttSettings.ToDeserializeRotStatusCh = toDeserializeRotStatusCh
+		mqttSettings.ToDeserializeRotAckCh = toDeserializeRotAckCh
 	}
 
 	remoteRadioSettings := cligui.RemoteRadioSettings{
 		CatResponseCh:   toDeserializeCatResponseCh,
-		RadioStatusCh:   toDeserializeStatusCh,
+		StateDeltaCh:    toDeserializeStateDeltaCh,
+		EchoCh:          toDeserializeEchoCh,
+		MetersCh:        toDeserializeMetersCh,
+		LogCh:           toDeserializeLogCh,
+		MorseProgressCh: toDeserializeMorseProgressCh,
+		AlarmCh:         toDeserializeAlarmCh,
+		ErrorCh:         toDeserializeErrorCh,
+		AckCh:           toDeserializeAckCh,
 		CapabilitiesCh:  toDeserializeCapsCh,
 		ToWireCh:        toWireCh,
 		CatRequestTopic: serverCatRequestTopic,
+		LockTopic:       serverLockTopic,
+		MemRequestTopic: serverMemRequestTopic,
+		ResyncTopic:     serverResyncTopic,
+		LogLevelTopic:   serverLogLevelTopic,
+		MorseTopic:      serverMorseTopic,
+		RotPositionCh:   toDeserializeRotPositionCh,
+		RotStatusCh:     toDeserializeRotStatusCh,
+		RotAckCh:        toDeserializeRotAckCh,
+		RotRequestTopic: rotRequestTopic,
+		MemResponseCh:   toDeserializeMemResponseCh,
 		Events:          evPS,
 		WaitGroup:       &wg,
 	}
 
+	// the radio & server status is evaluated by MonitorServerStatus
+	// which informs cligui through the ServerOnline event
 	serverStatusSettings := serverstatus.Settings{
 		Waitgroup:      &wg,
 		ServerStatusCh: toDeserializeStatusCh,
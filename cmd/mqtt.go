@@ -0,0 +1,199 @@
+// Copyright © 2017 Tobias Wellnitz, DH1TW <Tobias.Wellnitz@gmail.com>
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cmd
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/dh1tw/remoteRadio/comms"
+	"github.com/dh1tw/remoteRadio/utils"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+// mqttPasswordEnv is the environment variable which holds the password for
+// the broker, so that it doesn't have to be stored in the config file.
+const mqttPasswordEnv = "REMOTERADIO_MQTT_PASSWORD"
+
+// addMqttConnectionFlags adds the flags for the transport, TLS and the
+// authentication with the broker. The password can only be set in the
+// config file or through the environment, since the command line is
+// visible to other users.
+func addMqttConnectionFlags(cmd *cobra.Command) {
+	cmd.Flags().StringP("transport", "", "tcp", "MQTT transport (tcp, ssl, ws, wss)")
+	cmd.Flags().StringP("broker-path", "", "/mqtt", "Path of the broker's WebSocket endpoint (ws/wss only)")
+	cmd.Flags().StringP("ca-file", "", "", "CA certificate of the broker (ssl/wss only)")
+	cmd.Flags().StringP("cert-file", "", "", "Client certificate (ssl/wss only)")
+	cmd.Flags().StringP("key-file", "", "", "Private key of the client certificate (ssl/wss only)")
+	cmd.Flags().BoolP("insecure-skip-verify", "", false, "Don't verify the broker's certificate (ssl/wss only)")
+	cmd.Flags().StringP("username", "", "", "Username for the broker (password: mqtt.password or $"+mqttPasswordEnv+")")
+}
+
+// bindMqttConnectionFlags binds the flags added by addMqttConnectionFlags
+// to the viper settings.
+func bindMqttConnectionFlags(cmd *cobra.Command) {
+	viper.BindPFlag("mqtt.transport", cmd.Flags().Lookup("transport"))
+	viper.BindPFlag("mqtt.broker_path", cmd.Flags().Lookup("broker-path"))
+	viper.BindPFlag("mqtt.ca_file", cmd.Flags().Lookup("ca-file"))
+	viper.BindPFlag("mqtt.cert_file", cmd.Flags().Lookup("cert-file"))
+	viper.BindPFlag("mqtt.key_file", cmd.Flags().Lookup("key-file"))
+	viper.BindPFlag("mqtt.insecure_skip_verify", cmd.Flags().Lookup("insecure-skip-verify"))
+	viper.BindPFlag("mqtt.username", cmd.Flags().Lookup("username"))
+	viper.BindEnv("mqtt.password", mqttPasswordEnv)
+}
+
+// clientUserID returns the user_id of a client. The user_id is the last
+// level of the topics to which the client publishes its requests, so that
+// the broker's ACL can tie it to the username with which the client
+// authenticates (e.g. mosquitto: pattern write +/radios/+/cat/setstate/%u).
+// It defaults to the username and has to match it when both are set.
+// Without either, a random user_id is generated.
+func clientUserID() (string, error) {
+
+	userID := viper.GetString("general.user_id")
+	username := viper.GetString("mqtt.username")
+
+	switch {
+	case userID == "" && username == "":
+		return "unknown_" + utils.RandStringRunes(5), nil
+	case userID == "":
+		userID = username
+	case username != "" && userID != username:
+		return "", fmt.Errorf("user_id '%s' doesn't match the MQTT username '%s'",
+			userID, username)
+	}
+
+	if strings.ContainsAny(userID, "/+#") {
+		return "", fmt.Errorf("user_id '%s' must not contain '/', '+' or '#'", userID)
+	}
+
+	return userID, nil
+}
+
+// newClientID returns a client id for the connection to the broker.
+// Since the broker disconnects a client when another one connects with the
+// same id, a random suffix keeps several sessions of a user apart.
+func newClientID(userID string) string {
+	return userID + "_" + utils.RandStringRunes(5)
+}
+
+// applyMqttConnection sets the transport, the TLS configuration and the
+// credentials of the MqttSettings from the viper settings. The broker URL
+// may also be given as a complete URL (e.g. wss://example.com:443/mqtt),
+// which takes precedence over the transport, port and path settings.
+// Without a port, the standard port of the transport is used.
+func applyMqttConnection(s *comms.MqttSettings) error {
+
+	s.Transport = viper.GetString("mqtt.transport")
+	s.Path = viper.GetString("mqtt.broker_path")
+	s.Username = viper.GetString("mqtt.username")
+	s.Password = viper.GetString("mqtt.password")
+
+	if err := parseBrokerURL(s); err != nil {
+		return err
+	}
+
+	secure := false
+
+	switch s.Transport {
+	case "tcp", "ws":
+		if viper.GetString("mqtt.ca_file") != "" || viper.GetString("mqtt.cert_file") != "" {
+			fmt.Println("WARN: certificates are only used with the ssl and wss transports")
+		}
+	case "ssl", "wss":
+		secure = true
+		tlsConfig, err := comms.NewTLSConfig(
+			viper.GetString("mqtt.ca_file"),
+			viper.GetString("mqtt.cert_file"),
+			viper.GetString("mqtt.key_file"),
+			viper.GetBool("mqtt.insecure_skip_verify"))
+		if err != nil {
+			return err
+		}
+		s.TLSConfig = tlsConfig
+		if tlsConfig.InsecureSkipVerify {
+			fmt.Println("WARN: the broker's certificate is not verified")
+		}
+	default:
+		return fmt.Errorf("unknown MQTT transport '%s'", s.Transport)
+	}
+
+	if s.Password != "" && !secure {
+		fmt.Println("WARN: the password is sent in clear text; consider using ssl or wss")
+	}
+
+	return nil
+}
+
+// defaultBrokerPorts are the standard ports of the MQTT transports.
+var defaultBrokerPorts = map[string]int{
+	"tcp": 1883,
+	"ssl": 8883,
+	"ws":  80,
+	"wss": 443,
+}
+
+// parseBrokerURL splits a broker URL like wss://example.com:443/mqtt into
+// the transport, host, port and path of the MqttSettings. The port
+// defaults to the standard port of the transport. A plain host name keeps
+// the transport and the port of the settings; a port of 0 is replaced by
+// the standard port of the transport.
+func parseBrokerURL(s *comms.MqttSettings) error {
+
+	if !strings.Contains(s.BrokerURL, "://") {
+		port, ok := defaultBrokerPorts[s.Transport]
+		if !ok {
+			return fmt.Errorf("unknown MQTT transport '%s'", s.Transport)
+		}
+		if s.BrokerPort == 0 {
+			s.BrokerPort = port
+		}
+		return nil
+	}
+
+	u, err := url.Parse(s.BrokerURL)
+	if err != nil {
+		return fmt.Errorf("invalid broker url: %v", err)
+	}
+
+	port, ok := defaultBrokerPorts[u.Scheme]
+	if !ok {
+		return fmt.Errorf("unknown MQTT transport '%s'", u.Scheme)
+	}
+	if u.Port() != "" {
+		port, err = strconv.Atoi(u.Port())
+		if err != nil {
+			return fmt.Errorf("invalid broker port '%s'", u.Port())
+		}
+	}
+
+	s.Transport = u.Scheme
+	s.BrokerURL = u.Hostname()
+	s.BrokerPort = port
+	if u.Path != "" {
+		s.Path = u.Path
+	}
+
+	return nil
+}
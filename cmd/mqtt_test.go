@@ -0,0 +1,77 @@
+// Copyright © 2017 Tobias Wellnitz, DH1TW <Tobias.Wellnitz@gmail.com>
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cmd
+
+import (
+	"testing"
+
+	"github.com/dh1tw/remoteRadio/comms"
+)
+
+func TestParseBrokerURL(t *testing.T) {
+
+	tests := []struct {
+		name          string
+		url           string
+		transport     string
+		port          int
+		wantTransport string
+		wantHost      string
+		wantPort      int
+		wantPath      string
+		wantErr       bool
+	}{
+		{"tcp default port", "tcp://localhost", "", 0, "tcp", "localhost", 1883, "/mqtt", false},
+		{"ssl default port", "ssl://example.com", "", 0, "ssl", "example.com", 8883, "/mqtt", false},
+		{"ws default port", "ws://example.com/ws", "", 0, "ws", "example.com", 80, "/ws", false},
+		{"wss with port and path", "wss://example.com:8443/broker", "", 0, "wss", "example.com", 8443, "/broker", false},
+		{"explicit port", "tcp://10.0.0.1:1884", "", 0, "tcp", "10.0.0.1", 1884, "/mqtt", false},
+		{"unknown transport", "http://example.com", "", 0, "", "", 0, "", true},
+		{"invalid port", "tcp://example.com:abc", "", 0, "", "", 0, "", true},
+		{"plain host tcp", "localhost", "tcp", 0, "tcp", "localhost", 1883, "/mqtt", false},
+		{"plain host ssl", "example.com", "ssl", 0, "ssl", "example.com", 8883, "/mqtt", false},
+		{"plain host ws", "example.com", "ws", 0, "ws", "example.com", 80, "/mqtt", false},
+		{"plain host wss", "example.com", "wss", 0, "wss", "example.com", 443, "/mqtt", false},
+		{"plain host explicit port", "example.com", "wss", 8443, "wss", "example.com", 8443, "/mqtt", false},
+		{"plain host unknown transport", "example.com", "http", 0, "", "", 0, "", true},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			s := comms.MqttSettings{BrokerURL: tc.url, Transport: tc.transport,
+				BrokerPort: tc.port, Path: "/mqtt"}
+
+			err := parseBrokerURL(&s)
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("parseBrokerURL() error = %v, wantErr %v", err, tc.wantErr)
+			}
+			if tc.wantErr {
+				return
+			}
+			if s.Transport != tc.wantTransport || s.BrokerURL != tc.wantHost ||
+				s.BrokerPort != tc.wantPort || s.Path != tc.wantPath {
+				t.Errorf("got %s://%s:%d%s, want %s://%s:%d%s",
+					s.Transport, s.BrokerURL, s.BrokerPort, s.Path,
+					tc.wantTransport, tc.wantHost, tc.wantPort, tc.wantPath)
+			}
+		})
+	}
+}
@@ -0,0 +1,351 @@
+// Copyright © 2017 Tobias Wellnitz, DH1TW <Tobias.Wellnitz@gmail.com>
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cmd
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/cskr/pubsub"
+	"github.com/dh1tw/remoteRadio/comms"
+	"github.com/dh1tw/remoteRadio/ping"
+	"github.com/dh1tw/remoteRadio/radio"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	hl "github.com/dh1tw/goHamlib"
+)
+
+// radioConfigKeys are the settings which can be specified per radio in
+// the [radios.<id>] sections of the config file. Settings which are not
+// specified for a radio are taken from the [radio] section or the
+// command line flags.
+var radioConfigKeys = []string{"backend", "rig-model", "hl-debug-level",
+	"port_type", "portname", "baudrate", "databits", "stopbits", "parity",
+	"handshake", "polling_interval", "resync_interval", "snapshot_interval",
+	"tx_timeout", "ping_timeout", "lock_lease", "admins", "bandplan",
+	"reject_out_of_range"}
+
+// serverRadio contains everything needed to serve one radio over the
+// shared MQTT connection.
+type serverRadio struct {
+	id        string
+	baseTopic string
+	rxTopics  []string
+	route     comms.MqttSettings
+	pong      ping.Settings
+	settings  radio.RadioSettings
+	status    serverStatus
+}
+
+// configuredRadios returns the ids of the radios to be served together
+// with the viper key prefix of their settings. Without a [radios]
+// section in the config file, a single radio is configured through the
+// [radio] section and the command line flags.
+func configuredRadios() map[string]string {
+
+	radios := make(map[string]string)
+
+	for id := range viper.GetStringMap("radios") {
+		prefix := "radios." + id
+		for _, key := range radioConfigKeys {
+			viper.SetDefault(prefix+"."+key, viper.Get("radio."+key))
+		}
+		radios[id] = prefix
+	}
+
+	if len(radios) == 0 {
+		radios[viper.GetString("mqtt.radio")] = "radio"
+	}
+
+	return radios
+}
+
+// sortedRadioIDs returns the radio ids in alphabetical order.
+func sortedRadioIDs(radios map[string]string) []string {
+	ids := make([]string, 0, len(radios))
+	for id := range radios {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	return ids
+}
+
+func newServerRadio(cmd *cobra.Command, id, prefix, serverName string,
+	toWireCh chan comms.IOMsg, evPS *pubsub.PubSub, wg *sync.WaitGroup) (*serverRadio, error) {
+
+	sr := &serverRadio{id: id}
+
+	// the topic of a radio defaults to its id
+	radioTopic := id
+	if prefix != "radio" && viper.IsSet(prefix+".topic") {
+		radioTopic = viper.GetString(prefix + ".topic")
+	}
+
+	sr.baseTopic = viper.GetString("mqtt.station") +
+		"/radios/" + radioTopic +
+		"/cat"
+
+	// rx topics; the requests are published to a sub topic named after
+	// the user_id of the client
+	serverCatRequestTopic := sr.baseTopic + "/setstate/+"
+	serverPingTopic := sr.baseTopic + "/ping"
+	serverLockTopic := sr.baseTopic + "/lock/+"
+	serverMemRequestTopic := sr.baseTopic + "/mem/request/+"
+	serverResyncTopic := sr.baseTopic + "/resync"
+	serverLogLevelTopic := sr.baseTopic + "/loglevel"
+	serverMorseTopic := sr.baseTopic + "/morse/send/+"
+
+	// tx topics
+	serverStatusTopic := sr.baseTopic + "/status"
+	serverCatResponseTopic := sr.baseTopic + "/state"
+	serverDeltaTopic := sr.baseTopic + "/delta"
+	serverEchoTopic := sr.baseTopic + "/echo"
+	serverCapsTopic := sr.baseTopic + "/caps"
+	serverPongTopic := sr.baseTopic + "/pong"
+	serverErrorTopic := sr.baseTopic + "/error"
+	serverAckTopic := sr.baseTopic + "/ack"
+	serverMemChannelsTopic := sr.baseTopic + "/mem/channels"
+	serverMetersTopic := sr.baseTopic + "/meters"
+	serverLogTopic := sr.baseTopic + "/log"
+	serverMorseProgressTopic := sr.baseTopic + "/morse/progress"
+	serverAlarmTopic := sr.baseTopic + "/alarm"
+
+	sr.rxTopics = []string{serverCatRequestTopic, serverPingTopic,
+		serverLockTopic, serverMemRequestTopic, serverResyncTopic,
+		serverLogLevelTopic, serverMorseTopic}
+
+	toDeserializeCatRequestCh := make(chan comms.IOMsg, 10)
+	toDeserializePingRequestCh := make(chan []byte, 10)
+	toDeserializeLockRequestCh := make(chan comms.IOMsg, 10)
+	toDeserializeMemRequestCh := make(chan comms.IOMsg, 10)
+	toDeserializeResyncCh := make(chan []byte, 10)
+	toDeserializeLogLevelCh := make(chan []byte, 10)
+	toDeserializeMorseCh := make(chan comms.IOMsg, 10)
+
+	sr.route = comms.MqttSettings{
+		ToDeserializeCatRequestCh:  toDeserializeCatRequestCh,
+		ToDeserializePingRequestCh: toDeserializePingRequestCh,
+		ToDeserializeLockRequestCh: toDeserializeLockRequestCh,
+		ToDeserializeMemRequestCh:  toDeserializeMemRequestCh,
+		ToDeserializeResyncCh:      toDeserializeResyncCh,
+		ToDeserializeLogLevelCh:    toDeserializeLogLevelCh,
+		ToDeserializeMorseCh:       toDeserializeMorseCh,
+	}
+
+	sr.pong = ping.Settings{
+		PongCh:    toDeserializePingRequestCh,
+		ToWireCh:  toWireCh,
+		PongTopic: serverPongTopic,
+		RadioID:   sr.id,
+		WaitGroup: wg,
+		Events:    evPS,
+	}
+
+	port := hl.Port{}
+	if viper.GetString(prefix+".backend") != radio.SimulatorBackend {
+		var err error
+		port, err = rigPortFromConfig(prefix)
+		if err != nil {
+			return nil, fmt.Errorf("invalid rig port settings: %v", err)
+		}
+		// serial settings provided on the command line would silently be
+		// ignored for any other port type
+		if prefix == "radio" && port.RigPortType != hl.RIG_PORT_SERIAL {
+			for _, f := range serialPortFlags {
+				if cmd.Flags().Changed(f) {
+					return nil, fmt.Errorf("--%s only applies to serial ports", f)
+				}
+			}
+		}
+		fmt.Printf("Rig port (%s): %s\n", id, describeRigPort(port))
+	}
+
+	var bandPlan *radio.BandPlan
+	if bpFile := viper.GetString(prefix + ".bandplan"); bpFile != "" {
+		var err error
+		bandPlan, err = loadBandPlan(bpFile)
+		if err != nil {
+			return nil, fmt.Errorf("unable to load band plan: %v", err)
+		}
+		fmt.Printf("Using band plan (%s): %s\n", id, bpFile)
+	}
+
+	meterSettings, err := meterSettingsFromConfig(prefix)
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse meter settings: %v", err)
+	}
+
+	protection, err := protectionSettingsFromConfig(prefix)
+	if err != nil {
+		return nil, fmt.Errorf("invalid protection settings: %v", err)
+	}
+
+	pollingInterval := viper.GetDuration(prefix + ".polling_interval")
+	if pollingInterval <= 0 {
+		return nil, fmt.Errorf("invalid polling_interval %v", pollingInterval)
+	}
+
+	simSettings, err := simulatorSettingsFromConfig(prefix)
+	if err != nil {
+		return nil, fmt.Errorf("invalid simulator settings: %v", err)
+	}
+
+	sr.settings = radio.RadioSettings{
+		ID:                 id,
+		Backend:            viper.GetString(prefix + ".backend"),
+		RigModel:           viper.GetInt(prefix + ".rig-model"),
+		Hamlib:             radio.HamlibSettings{Port: port},
+		HlDebugLevel:       viper.GetInt(prefix + ".hl-debug-level"),
+		LogLevelCh:         toDeserializeLogLevelCh,
+		LogTopic:           serverLogTopic,
+		CatRequestCh:       toDeserializeCatRequestCh,
+		ToWireCh:           toWireCh,
+		CatResponseTopic:   serverCatResponseTopic,
+		DeltaTopic:         serverDeltaTopic,
+		EchoTopic:          serverEchoTopic,
+		ResyncRequestCh:    toDeserializeResyncCh,
+		SnapshotInterval:   viper.GetDuration(prefix + ".snapshot_interval"),
+		CapsTopic:          serverCapsTopic,
+		ErrorTopic:         serverErrorTopic,
+		AckTopic:           serverAckTopic,
+		WaitGroup:          wg,
+		Events:             evPS,
+		PollingInterval:    pollingInterval,
+		ResyncInterval:     viper.GetDuration(prefix + ".resync_interval"),
+		MaxTxTime:          viper.GetDuration(prefix + ".tx_timeout"),
+		PingTimeout:        viper.GetDuration(prefix + ".ping_timeout"),
+		BandPlan:           bandPlan,
+		RejectOutOfRange:   viper.GetBool(prefix + ".reject_out_of_range"),
+		LockRequestCh:      toDeserializeLockRequestCh,
+		LockLease:          viper.GetDuration(prefix + ".lock_lease"),
+		Admins:             viper.GetStringSlice(prefix + ".admins"),
+		MemoryRequestCh:    toDeserializeMemRequestCh,
+		MemoryTopic:        serverMemChannelsTopic,
+		MetersTopic:        serverMetersTopic,
+		MorseCh:            toDeserializeMorseCh,
+		MorseProgressTopic: serverMorseProgressTopic,
+		Meters:             meterSettings,
+		Protection:         protection,
+		AlarmTopic:         serverAlarmTopic,
+		Simulator:          simSettings,
+	}
+
+	sr.status = serverStatus{
+		server:   serverName,
+		radio:    id,
+		topic:    serverStatusTopic,
+		toWireCh: toWireCh,
+	}
+
+	return sr, nil
+}
+
+// meterSettingsFromConfig reads the meters of a radio from the
+// [radios.<id>.meters] section and falls back to the [meters] section
+// and finally to the default meters.
+func meterSettingsFromConfig(prefix string) (radio.MeterSettings, error) {
+
+	key := prefix + ".meters"
+	if !viper.IsSet(key) {
+		key = "meters"
+	}
+	if !viper.IsSet(key) {
+		return radio.DefaultMeterSettings, nil
+	}
+
+	ms := radio.MeterSettings{}
+	if err := viper.UnmarshalKey(key, &ms); err != nil {
+		return ms, err
+	}
+
+	// viper lowercases the keys; hamlib modes are uppercase
+	modes := make(map[string]radio.MeterSet)
+	for mode, set := range ms.Modes {
+		modes[strings.ToUpper(mode)] = set
+	}
+	ms.Modes = modes
+
+	return ms, nil
+}
+
+// simulatorSettingsFromConfig reads the simulator settings of a radio
+// from the [radios.<id>.simulator] section. Settings which are not
+// specified there are taken from the [simulator] section and the
+// --sim-* flags.
+func simulatorSettingsFromConfig(prefix string) (radio.SimulatorSettings, error) {
+
+	key := func(name string) string {
+		if k := prefix + ".simulator." + name; viper.IsSet(k) {
+			return k
+		}
+		return "simulator." + name
+	}
+
+	ss := radio.SimulatorSettings{
+		Vfos:        viper.GetStringSlice(key("vfos")),
+		Modes:       viper.GetStringSlice(key("modes")),
+		MaxRit:      viper.GetInt(key("max_rit")),
+		MaxXit:      viper.GetInt(key("max_xit")),
+		Latency:     viper.GetDuration(key("latency")),
+		Timeout:     viper.GetDuration(key("timeout")),
+		TimeoutRate: viper.GetFloat64(key("timeout_rate")),
+		ErrorRate:   viper.GetFloat64(key("error_rate")),
+	}
+
+	if k := key("filters"); viper.IsSet(k) {
+		if err := viper.UnmarshalKey(k, &ss.Filters); err != nil {
+			return ss, fmt.Errorf("unable to parse the filters: %v", err)
+		}
+		// viper lowercases the keys; hamlib modes are uppercase
+		filters := make(map[string][]int)
+		for mode, widths := range ss.Filters {
+			filters[strings.ToUpper(mode)] = widths
+		}
+		ss.Filters = filters
+	}
+
+	return ss, nil
+}
+
+// protectionSettingsFromConfig reads the SWR / ALC protection of a radio
+// from the [radios.<id>.protection] section and falls back to the
+// [protection] section. Without either, the protection is disabled.
+func protectionSettingsFromConfig(prefix string) (radio.ProtectionSettings, error) {
+
+	ps := radio.ProtectionSettings{}
+
+	key := prefix + ".protection"
+	if !viper.IsSet(key) {
+		key = "protection"
+	}
+	if !viper.IsSet(key) {
+		return ps, nil
+	}
+
+	if err := viper.UnmarshalKey(key, &ps); err != nil {
+		return ps, err
+	}
+
+	return ps, ps.Validate()
+}
@@ -0,0 +1,154 @@
+// Copyright © 2017 Tobias Wellnitz, DH1TW <Tobias.Wellnitz@gmail.com>
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cmd
+
+import (
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/cskr/pubsub"
+	"github.com/dh1tw/remoteRadio/comms"
+	"github.com/dh1tw/remoteRadio/radio"
+	"github.com/spf13/viper"
+)
+
+// TestClientAdmin verifies that the user_id of a client, as set up from
+// its config, matches the admins configured for the server.
+func TestClientAdmin(t *testing.T) {
+
+	tests := []struct {
+		name      string
+		userID    string
+		username  string
+		wantAdmin bool
+		wantErr   bool
+	}{
+		{"configured user_id", "dh1tw", "", true, false},
+		{"mqtt username", "", "dh1tw", true, false},
+		{"user_id matches username", "dh1tw", "dh1tw", true, false},
+		{"other user", "dl0abc", "", false, false},
+		{"neither set", "", "", false, false},
+		{"user_id doesn't match username", "dh1tw", "dl0abc", false, true},
+		{"wildcard in user_id", "dh1tw/#", "", false, true},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			viper.Reset()
+			defer viper.Reset()
+
+			// server
+			viper.Set("radio.backend", radio.SimulatorBackend)
+			viper.Set("radio.polling_interval", "1s")
+			viper.Set("radio.admins", []string{"dh1tw"})
+			sr, err := newServerRadio(nil, "test", "radio", "server",
+				make(chan comms.IOMsg), pubsub.New(1), &sync.WaitGroup{})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			// client
+			viper.Set("general.user_id", tc.userID)
+			viper.Set("mqtt.username", tc.username)
+			userID, err := clientUserID()
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("clientUserID() error = %v, wantErr %v", err, tc.wantErr)
+			}
+			if tc.wantErr {
+				return
+			}
+
+			admin := false
+			for _, a := range sr.settings.Admins {
+				admin = admin || a == userID
+			}
+			if admin != tc.wantAdmin {
+				t.Errorf("user_id %q admin = %v, want %v", userID, admin, tc.wantAdmin)
+			}
+		})
+	}
+}
+
+func TestSimulatorSettingsFromConfig(t *testing.T) {
+
+	tests := []struct {
+		name        string
+		config      map[string]interface{}
+		wantLatency time.Duration
+		wantFilters map[string][]int
+		wantErr     bool
+	}{
+		{
+			name:        "global section",
+			config:      map[string]interface{}{"simulator.latency": "10ms"},
+			wantLatency: 10 * time.Millisecond,
+		},
+		{
+			name: "radio section",
+			config: map[string]interface{}{
+				"simulator.latency":              "10ms",
+				"radios.ft950.simulator.latency": "50ms",
+			},
+			wantLatency: 50 * time.Millisecond,
+		},
+		{
+			name: "filters",
+			config: map[string]interface{}{
+				"radios.ft950.simulator.filters": map[string]interface{}{"usb": []int{2400, 1800}},
+			},
+			wantFilters: map[string][]int{"USB": {2400, 1800}},
+		},
+		{
+			name: "invalid filters",
+			config: map[string]interface{}{
+				"radios.ft950.simulator.filters": "wide",
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			viper.Reset()
+			defer viper.Reset()
+
+			for k, v := range tc.config {
+				viper.Set(k, v)
+			}
+
+			ss, err := simulatorSettingsFromConfig("radios.ft950")
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("simulatorSettingsFromConfig() error = %v, wantErr %v", err, tc.wantErr)
+			}
+			if tc.wantErr {
+				return
+			}
+			if ss.Latency != tc.wantLatency {
+				t.Errorf("Latency = %v, want %v", ss.Latency, tc.wantLatency)
+			}
+			if !reflect.DeepEqual(ss.Filters, tc.wantFilters) {
+				t.Errorf("Filters = %v, want %v", ss.Filters, tc.wantFilters)
+			}
+		})
+	}
+}
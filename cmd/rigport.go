@@ -0,0 +1,148 @@
+// Copyright © 2017 Tobias Wellnitz, DH1TW <Tobias.Wellnitz@gmail.com>
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cmd
+
+import (
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+
+	hl "github.com/dh1tw/goHamlib"
+	"github.com/spf13/viper"
+)
+
+// rigPortTypes maps the port types which can be selected in the config
+// file or through the --port-type flag to their hamlib counterparts.
+var rigPortTypes = map[string]int{
+	"serial":  hl.RIG_PORT_SERIAL,
+	"network": hl.RIG_PORT_NETWORK,
+	"udp":     hl.RIG_PORT_UDP_NETWORK,
+	"usb":     hl.RIG_PORT_USB,
+	"device":  hl.RIG_PORT_DEVICE,
+	"none":    hl.RIG_PORT_NONE,
+}
+
+// serialPortFlags are the flags which only apply to serial ports.
+var serialPortFlags = []string{"baudrate", "databits", "stopbits", "parity", "handshake"}
+
+// rigPortFromConfig reads the rig port settings found under the given
+// viper key prefix (e.g. "radio") and validates them against the selected
+// port type. Serial settings are only evaluated for serial ports.
+func rigPortFromConfig(prefix string) (hl.Port, error) {
+
+	port := hl.Port{}
+
+	portType := strings.ToLower(viper.GetString(prefix + ".port_type"))
+	if portType == "" {
+		portType = "serial"
+	}
+
+	rigPortType, ok := rigPortTypes[portType]
+	if !ok {
+		return port, fmt.Errorf("unknown port type '%s' (valid: serial, network, udp, usb, device, none)", portType)
+	}
+	port.RigPortType = rigPortType
+	port.Portname = viper.GetString(prefix + ".portname")
+
+	switch portType {
+	case "serial":
+		if port.Portname == "" {
+			return port, fmt.Errorf("serial port requires a portname (e.g. /dev/ttyUSB0 or COM1)")
+		}
+		if err := serialSettingsFromConfig(prefix, &port); err != nil {
+			return port, err
+		}
+	case "network", "udp":
+		// rigctld & network enabled radios expect host:port
+		host, p, err := net.SplitHostPort(port.Portname)
+		if err != nil || host == "" {
+			return port, fmt.Errorf("%s port requires host:port as portname (e.g. localhost:4532), got '%s'", portType, port.Portname)
+		}
+		if n, err := strconv.Atoi(p); err != nil || n < 1 || n > 65535 {
+			return port, fmt.Errorf("invalid network port '%s'", p)
+		}
+	case "usb", "device":
+		if port.Portname == "" {
+			return port, fmt.Errorf("%s port requires a portname", portType)
+		}
+	}
+
+	return port, nil
+}
+
+func serialSettingsFromConfig(prefix string, port *hl.Port) error {
+
+	port.Baudrate = viper.GetInt(prefix + ".baudrate")
+	if port.Baudrate <= 0 {
+		return fmt.Errorf("invalid baudrate %d", port.Baudrate)
+	}
+
+	port.Databits = viper.GetInt(prefix + ".databits")
+	if port.Databits < 5 || port.Databits > 8 {
+		return fmt.Errorf("invalid databits %d (valid: 5-8)", port.Databits)
+	}
+
+	port.Stopbits = viper.GetInt(prefix + ".stopbits")
+	if port.Stopbits < 1 || port.Stopbits > 2 {
+		return fmt.Errorf("invalid stopbits %d (valid: 1, 2)", port.Stopbits)
+	}
+
+	switch viper.GetString(prefix + ".parity") {
+	case "none", "":
+		port.Parity = hl.N
+	case "even":
+		port.Parity = hl.E
+	case "odd":
+		port.Parity = hl.O
+	default:
+		return fmt.Errorf("invalid parity '%s' (valid: none, even, odd)",
+			viper.GetString(prefix+".parity"))
+	}
+
+	switch viper.GetString(prefix + ".handshake") {
+	case "none", "":
+		port.Handshake = hl.NO_HANDSHAKE
+	case "RTSCTS":
+		port.Handshake = hl.RTSCTS_HANDSHAKE
+	default:
+		return fmt.Errorf("invalid handshake '%s' (valid: none, RTSCTS)",
+			viper.GetString(prefix+".handshake"))
+	}
+
+	return nil
+}
+
+// describeRigPort returns a human readable summary of the port which
+// contains only the settings applicable to its type.
+func describeRigPort(port hl.Port) string {
+	for name, t := range rigPortTypes {
+		if t != port.RigPortType {
+			continue
+		}
+		if port.RigPortType == hl.RIG_PORT_SERIAL {
+			return fmt.Sprintf("%s %s (%d baud, %d databits, %d stopbits)",
+				name, port.Portname, port.Baudrate, port.Databits, port.Stopbits)
+		}
+		return fmt.Sprintf("%s %s", name, port.Portname)
+	}
+	return port.Portname
+}
@@ -61,22 +61,7 @@ func init() {
 	// Cobra supports Persistent Flags, which, if defined here,
 	// will be global for your application.
 
-	RootCmd.PersistentFlags().IntP("rig-model", "m", 0, "Hamlib Rig Model ID")
-	RootCmd.PersistentFlags().IntP("baudrate", "b", 38400, "Baudrate")
-	RootCmd.PersistentFlags().StringP("portname", "o", "/dev/mhux/cat", "Portname (e.g. COM1)")
-	RootCmd.PersistentFlags().IntP("databits", "d", 8, "Databits")
-	RootCmd.PersistentFlags().IntP("stopbits", "s", 1, "Stopbits")
-	RootCmd.PersistentFlags().StringP("parity", "r", "none", "Parity")
-	RootCmd.PersistentFlags().StringP("handshake", "a", "none", "Handshake")
 	RootCmd.PersistentFlags().StringVar(&cfgFile, "config", "", "config file (default is $HOME/.remoteRadio.[yaml|toml|json])")
-
-	viper.BindPFlag("radio.rig-model", RootCmd.PersistentFlags().Lookup("rig-model"))
-	viper.BindPFlag("radio.baudrate", RootCmd.PersistentFlags().Lookup("baudrate"))
-	viper.BindPFlag("radio.portname", RootCmd.PersistentFlags().Lookup("portname"))
-	viper.BindPFlag("radio.databits", RootCmd.PersistentFlags().Lookup("databits"))
-	viper.BindPFlag("radio.stopbits", RootCmd.PersistentFlags().Lookup("stopbits"))
-	viper.BindPFlag("radio.parity", RootCmd.PersistentFlags().Lookup("parity"))
-	viper.BindPFlag("radio.handshake", RootCmd.PersistentFlags().Lookup("handshake"))
 }
 
 // initConfig reads in config file and ENV variables if set.
@@ -0,0 +1,148 @@
+// Copyright © 2017 Tobias Wellnitz, DH1TW <Tobias.Wellnitz@gmail.com>
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cmd
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/cskr/pubsub"
+	"github.com/dh1tw/remoteRadio/comms"
+	"github.com/dh1tw/remoteRadio/rotator"
+	"github.com/spf13/viper"
+
+	hl "github.com/dh1tw/goHamlib"
+)
+
+// rotatorDefaults are applied to the [rotators.<id>] sections of the
+// config file.
+var rotatorDefaults = map[string]interface{}{
+	"backend":          rotator.HamlibBackend,
+	"port_type":        "serial",
+	"baudrate":         9600,
+	"databits":         8,
+	"stopbits":         1,
+	"parity":           "none",
+	"handshake":        "none",
+	"polling_interval": time.Second,
+}
+
+// serverRotator contains everything needed to serve one rotator over
+// the shared MQTT connection.
+type serverRotator struct {
+	id        string
+	baseTopic string
+	rxTopics  []string
+	route     comms.MqttSettings
+	settings  rotator.Settings
+	status    serverStatus
+}
+
+// configuredRotators returns the ids of the rotators configured in the
+// [rotators.<id>] sections of the config file together with the viper
+// key prefix of their settings.
+func configuredRotators() map[string]string {
+
+	rotators := make(map[string]string)
+
+	for id := range viper.GetStringMap("rotators") {
+		prefix := "rotators." + id
+		for key, value := range rotatorDefaults {
+			viper.SetDefault(prefix+"."+key, value)
+		}
+		rotators[id] = prefix
+	}
+
+	return rotators
+}
+
+func newServerRotator(id, prefix, serverName string, toWireCh chan comms.IOMsg,
+	evPS *pubsub.PubSub, wg *sync.WaitGroup) (*serverRotator, error) {
+
+	sr := &serverRotator{id: id}
+
+	// the topic of a rotator defaults to its id
+	rotatorTopic := id
+	if viper.IsSet(prefix + ".topic") {
+		rotatorTopic = viper.GetString(prefix + ".topic")
+	}
+
+	sr.baseTopic = viper.GetString("mqtt.station") +
+		"/rotators/" + rotatorTopic +
+		"/rot"
+
+	// rx topics
+	requestTopic := sr.baseTopic + "/request"
+
+	// tx topics
+	statusTopic := sr.baseTopic + "/status"
+	capsTopic := sr.baseTopic + "/caps"
+	positionTopic := sr.baseTopic + "/position"
+	ackTopic := sr.baseTopic + "/ack"
+
+	sr.rxTopics = []string{requestTopic}
+
+	toDeserializeRequestCh := make(chan []byte, 10)
+
+	sr.route = comms.MqttSettings{
+		ToDeserializeRotRequestCh: toDeserializeRequestCh,
+	}
+
+	port := hl.Port{}
+	if viper.GetString(prefix+".backend") != rotator.SimulatorBackend {
+		var err error
+		port, err = rigPortFromConfig(prefix)
+		if err != nil {
+			return nil, fmt.Errorf("invalid rotator port settings: %v", err)
+		}
+		fmt.Printf("Rotator port (%s): %s\n", id, describeRigPort(port))
+	}
+
+	pollingInterval := viper.GetDuration(prefix + ".polling_interval")
+	if pollingInterval <= 0 {
+		return nil, fmt.Errorf("invalid polling_interval %v", pollingInterval)
+	}
+
+	sr.settings = rotator.Settings{
+		ID:              id,
+		Backend:         viper.GetString(prefix + ".backend"),
+		RotModel:        viper.GetInt(prefix + ".rot-model"),
+		Port:            port,
+		PollingInterval: pollingInterval,
+		RequestCh:       toDeserializeRequestCh,
+		ToWireCh:        toWireCh,
+		CapsTopic:       capsTopic,
+		PositionTopic:   positionTopic,
+		AckTopic:        ackTopic,
+		WaitGroup:       wg,
+		Events:          evPS,
+	}
+
+	sr.status = serverStatus{
+		server:   serverName,
+		radio:    id,
+		topic:    statusTopic,
+		toWireCh: toWireCh,
+	}
+
+	return sr, nil
+}
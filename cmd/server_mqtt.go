@@ -30,15 +30,15 @@ import (
 
 	"github.com/cskr/pubsub"
 	"github.com/dh1tw/remoteRadio/comms"
+	"github.com/dh1tw/remoteRadio/connection"
 	"github.com/dh1tw/remoteRadio/events"
 	"github.com/dh1tw/remoteRadio/ping"
 	"github.com/dh1tw/remoteRadio/radio"
+	"github.com/dh1tw/remoteRadio/rotator"
 	"github.com/dh1tw/remoteRadio/utils"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 
-	hl "github.com/dh1tw/goHamlib"
-	sbRadio "github.com/dh1tw/remoteRadio/sb_radio"
 	sbStatus "github.com/dh1tw/remoteRadio/sb_status"
 )
 
@@ -53,10 +53,33 @@ var serverMqttCmd = &cobra.Command{
 func init() {
 	serverCmd.AddCommand(serverMqttCmd)
 	serverMqttCmd.Flags().StringP("broker-url", "u", "localhost", "Broker URL")
-	serverMqttCmd.Flags().IntP("broker-port", "p", 1883, "Broker Port")
+	serverMqttCmd.Flags().IntP("broker-port", "p", 0, "Broker Port (default: 1883 tcp, 8883 ssl, 80 ws, 443 wss)")
 	serverMqttCmd.Flags().StringP("station", "X", "mystation", "Your station callsign")
 	serverMqttCmd.Flags().StringP("radio", "Y", "myradio", "Radio ID")
+	addMqttConnectionFlags(serverMqttCmd)
+	serverMqttCmd.Flags().StringP("server-name", "", "", "Name of this server process (default: hostname)")
 	serverMqttCmd.Flags().DurationP("polling_interval", "t", time.Duration(time.Millisecond*100), "Timer for polling the rig")
+	serverMqttCmd.Flags().DurationP("resync-interval", "", time.Duration(time.Second*2), "Timer for re-reading the rig's state (0 = disabled)")
+	serverMqttCmd.Flags().DurationP("snapshot-interval", "", time.Duration(time.Second*10), "Timer for publishing the full state (0 = only on request)")
+	serverMqttCmd.Flags().DurationP("tx-timeout", "", 0, "Maximum continuous transmit time (0 = unlimited)")
+	serverMqttCmd.Flags().DurationP("ping-timeout", "", 0, "Unkey the transmitter if the client which keyed it stops pinging (0 = disabled)")
+	serverMqttCmd.Flags().DurationP("lock-lease", "", time.Minute*5, "Operator lock expires after this time of inactivity (0 = never)")
+	serverMqttCmd.Flags().StringSliceP("admins", "", []string{}, "user_ids which may take over the operator lock")
+	serverMqttCmd.Flags().StringP("bandplan", "", "", "Band plan file with the transmit privileges per licence class")
+	serverMqttCmd.Flags().BoolP("reject-out-of-range", "", false, "Reject levels & parameters out of the rig's range instead of clamping them")
+	serverMqttCmd.Flags().StringP("backend", "", "hamlib", "Rig backend (hamlib, simulator)")
+	serverMqttCmd.Flags().IntP("rig-model", "m", 0, "Hamlib Rig Model ID")
+	serverMqttCmd.Flags().IntP("hl-debug-level", "", 0, "Hamlib debug level (0 = none ... 5 = trace); adjustable from the clients")
+	serverMqttCmd.Flags().StringP("port-type", "", "serial", "Rig port type (serial, network, udp, usb, device, none)")
+	serverMqttCmd.Flags().StringP("portname", "o", "/dev/mhux/cat", "Portname (e.g. COM1, /dev/ttyUSB0 or localhost:4532 for rigctld)")
+	serverMqttCmd.Flags().IntP("baudrate", "b", 38400, "Baudrate (serial only)")
+	serverMqttCmd.Flags().IntP("databits", "d", 8, "Databits (serial only)")
+	serverMqttCmd.Flags().IntP("stopbits", "s", 1, "Stopbits (serial only)")
+	serverMqttCmd.Flags().StringP("parity", "r", "none", "Parity (serial only)")
+	serverMqttCmd.Flags().StringP("handshake", "a", "none", "Handshake (serial only)")
+	serverMqttCmd.Flags().DurationP("sim-latency", "", 0, "Simulator: latency added to each rig call")
+	serverMqttCmd.Flags().Float64P("sim-timeout-rate", "", 0, "Simulator: probability [0..1] of a rig call timing out")
+	serverMqttCmd.Flags().Float64P("sim-error-rate", "", 0, "Simulator: probability [0..1] of a rig call failing")
 }
 
 func mqttRadioServer(cmd *cobra.Command, args []string) {
@@ -71,11 +94,32 @@ func mqttRadioServer(cmd *cobra.Command, args []string) {
 	viper.BindPFlag("mqtt.broker_port", cmd.Flags().Lookup("broker-port"))
 	viper.BindPFlag("mqtt.station", cmd.Flags().Lookup("station"))
 	viper.BindPFlag("mqtt.radio", cmd.Flags().Lookup("radio"))
+	bindMqttConnectionFlags(cmd)
+	viper.BindPFlag("server.name", cmd.Flags().Lookup("server-name"))
 	viper.BindPFlag("radio.polling_interval", cmd.Flags().Lookup("polling_interval"))
-
-	if viper.IsSet("general.user_id") {
-		viper.Set("general.user_id", utils.RandStringRunes(5))
-	} else {
+	viper.BindPFlag("radio.resync_interval", cmd.Flags().Lookup("resync-interval"))
+	viper.BindPFlag("radio.snapshot_interval", cmd.Flags().Lookup("snapshot-interval"))
+	viper.BindPFlag("radio.tx_timeout", cmd.Flags().Lookup("tx-timeout"))
+	viper.BindPFlag("radio.ping_timeout", cmd.Flags().Lookup("ping-timeout"))
+	viper.BindPFlag("radio.lock_lease", cmd.Flags().Lookup("lock-lease"))
+	viper.BindPFlag("radio.admins", cmd.Flags().Lookup("admins"))
+	viper.BindPFlag("radio.bandplan", cmd.Flags().Lookup("bandplan"))
+	viper.BindPFlag("radio.reject_out_of_range", cmd.Flags().Lookup("reject-out-of-range"))
+	viper.BindPFlag("radio.backend", cmd.Flags().Lookup("backend"))
+	viper.BindPFlag("radio.rig-model", cmd.Flags().Lookup("rig-model"))
+	viper.BindPFlag("radio.hl-debug-level", cmd.Flags().Lookup("hl-debug-level"))
+	viper.BindPFlag("radio.port_type", cmd.Flags().Lookup("port-type"))
+	viper.BindPFlag("radio.portname", cmd.Flags().Lookup("portname"))
+	viper.BindPFlag("radio.baudrate", cmd.Flags().Lookup("baudrate"))
+	viper.BindPFlag("radio.databits", cmd.Flags().Lookup("databits"))
+	viper.BindPFlag("radio.stopbits", cmd.Flags().Lookup("stopbits"))
+	viper.BindPFlag("radio.parity", cmd.Flags().Lookup("parity"))
+	viper.BindPFlag("radio.handshake", cmd.Flags().Lookup("handshake"))
+	viper.BindPFlag("simulator.latency", cmd.Flags().Lookup("sim-latency"))
+	viper.BindPFlag("simulator.timeout_rate", cmd.Flags().Lookup("sim-timeout-rate"))
+	viper.BindPFlag("simulator.error_rate", cmd.Flags().Lookup("sim-error-rate"))
+
+	if !viper.IsSet("general.user_id") {
 		viper.Set("general.user_id", "unknown_"+utils.RandStringRunes(5))
 	}
 
@@ -90,30 +134,20 @@ func mqttRadioServer(cmd *cobra.Command, args []string) {
 
 	mqttBrokerURL := viper.GetString("mqtt.broker_url")
 	mqttBrokerPort := viper.GetInt("mqtt.broker_port")
-	mqttClientID := viper.GetString("general.user_id")
-
-	hlDebugLevel := viper.GetInt("radio.hl-debug-level")
-
-	baseTopic := viper.GetString("mqtt.station") +
-		"/radios/" + viper.GetString("mqtt.radio") +
-		"/cat"
+	mqttClientID := newClientID(viper.GetString("general.user_id"))
 
-	serverCatRequestTopic := baseTopic + "/setstate"
-	serverStatusTopic := baseTopic + "/status"
-	serverPingTopic := baseTopic + "/ping"
-	// errorTopic := baseTopic + "/error"
-
-	// tx topics
-	serverCatResponseTopic := baseTopic + "/state"
-	serverCapsTopic := baseTopic + "/caps"
-	serverPongTopic := baseTopic + "/pong"
+	serverName := viper.GetString("server.name")
+	if serverName == "" {
+		serverName, _ = os.Hostname()
+	}
 
-	mqttRxTopics := []string{serverCatRequestTopic, serverPingTopic}
+	// MQTT only supports one last will per connection. Therefore the
+	// last will is published on the status topic of the server and
+	// the status of each radio names the server which serves it.
+	serverStatusTopic := viper.GetString("mqtt.station") +
+		"/servers/" + serverName + "/status"
 
 	toWireCh := make(chan comms.IOMsg, 20)
-	// toSerializeCatDataCh := make(chan comms.IOMsg, 20)
-	toDeserializeCatRequestCh := make(chan []byte, 10)
-	toDeserializePingRequestCh := make(chan []byte, 10)
 
 	// Event PubSub
 	evPS := pubsub.New(10)
@@ -121,8 +155,38 @@ func mqttRadioServer(cmd *cobra.Command, args []string) {
 	// WaitGroup to coordinate a graceful shutdown
 	var wg sync.WaitGroup
 
+	radios := configuredRadios()
+	serverRadios := []*serverRadio{}
+	mqttRxTopics := []string{}
+	routes := make(map[string]*comms.MqttSettings)
+
+	for _, id := range sortedRadioIDs(radios) {
+		sr, err := newServerRadio(cmd, id, radios[id], serverName, toWireCh, evPS, &wg)
+		if err != nil {
+			fmt.Printf("radio %s: %v\n", id, err)
+			os.Exit(1)
+		}
+		serverRadios = append(serverRadios, sr)
+		mqttRxTopics = append(mqttRxTopics, sr.rxTopics...)
+		routes[sr.baseTopic+"/"] = &sr.route
+	}
+
+	rotators := configuredRotators()
+	serverRotators := []*serverRotator{}
+
+	for _, id := range sortedRadioIDs(rotators) {
+		sr, err := newServerRotator(id, rotators[id], serverName, toWireCh, evPS, &wg)
+		if err != nil {
+			fmt.Printf("rotator %s: %v\n", id, err)
+			os.Exit(1)
+		}
+		serverRotators = append(serverRotators, sr)
+		mqttRxTopics = append(mqttRxTopics, sr.rxTopics...)
+		routes[sr.baseTopic+"/"] = &sr.route
+	}
+
 	// mqtt Last Will Message
-	binaryWillMsg, err := createLastWillMsg()
+	binaryWillMsg, err := createLastWillMsg(serverName)
 	if err != nil {
 		fmt.Println(err)
 	}
@@ -138,95 +202,69 @@ func mqttRadioServer(cmd *cobra.Command, args []string) {
 
 	mqttSettings := comms.MqttSettings{
 		WaitGroup:  &wg,
-		Transport:  "tcp",
 		BrokerURL:  mqttBrokerURL,
 		BrokerPort: mqttBrokerPort,
 		ClientID:   mqttClientID,
 		Topics:     mqttRxTopics,
-		ToDeserializeCatRequestCh:  toDeserializeCatRequestCh,
-		ToDeserializePingRequestCh: toDeserializePingRequestCh,
-		ToWire:   toWireCh,
-		Events:   evPS,
-		LastWill: &lastWill,
-		Logger:   appLogger,
-	}
-
-	pongSettings := ping.Settings{
-		PongCh:    toDeserializePingRequestCh,
-		ToWireCh:  toWireCh,
-		PongTopic: serverPongTopic,
-		WaitGroup: &wg,
-		Events:    evPS,
-	}
-
-	rigModel := viper.GetInt("radio.rig-model")
-
-	port := hl.Port{}
-	port.Baudrate = viper.GetInt("radio.baudrate")
-	port.Databits = viper.GetInt("radio.databits")
-	port.Stopbits = viper.GetInt("radio.stopbits")
-	port.Portname = viper.GetString("radio.portname")
-	port.RigPortType = hl.RIG_PORT_SERIAL
-	switch viper.GetString("radio.parity") {
-	case "none":
-		port.Parity = hl.N
-	case "even":
-		port.Parity = hl.E
-	case "odd":
-		port.Parity = hl.O
-	default:
-		port.Parity = hl.N
-	}
-
-	switch viper.GetString("radio.handshake") {
-	case "none":
-		port.Handshake = hl.NO_HANDSHAKE
-	case "RTSCTS":
-		port.Handshake = hl.RTSCTS_HANDSHAKE
-	default:
-		port.Handshake = hl.NO_HANDSHAKE
+		Routes:     routes,
+		ToWire:     toWireCh,
+		Events:     evPS,
+		LastWill:   &lastWill,
+		Logger:     appLogger,
 	}
 
-	pollingInterval := viper.GetDuration("radio.polling_interval")
-
-	radioSettings := radio.RadioSettings{
-		RigModel:         rigModel,
-		Port:             port,
-		HlDebugLevel:     hlDebugLevel,
-		CatRequestCh:     toDeserializeCatRequestCh,
-		ToWireCh:         toWireCh,
-		CatResponseTopic: serverCatResponseTopic,
-		CapsTopic:        serverCapsTopic,
-		WaitGroup:        &wg,
-		Events:           evPS,
-		PollingInterval:  pollingInterval,
+	if err := applyMqttConnection(&mqttSettings); err != nil {
+		fmt.Println("mqtt:", err)
+		os.Exit(1)
 	}
 
-	wg.Add(4) //MQTT + Ping + Radio + Events
+	//MQTT + Events + Ping & Radio per radio + Rotators
+	wg.Add(2 + 2*len(serverRadios) + len(serverRotators))
 
 	connectionStatusCh := evPS.Sub(events.MqttConnStatus)
 	shutdownCh := evPS.Sub(events.Shutdown)
 	prepareShutdownCh := evPS.Sub(events.PrepareShutdown)
+	rigStatusCh := evPS.Sub(events.RigStatus)
+	rotatorStatusCh := evPS.Sub(events.RotatorStatus)
 
 	go events.WatchSystemEvents(evPS, &wg)
 	go comms.MqttClient(mqttSettings)
-	go ping.EchoPing(pongSettings)
+	for _, sr := range serverRadios {
+		go ping.EchoPing(sr.pong)
+	}
 
 	time.Sleep(time.Millisecond * 1300)
-	go radio.HandleRadio(radioSettings)
+	for _, sr := range serverRadios {
+		go radio.HandleRadio(sr.settings)
+	}
+	for _, sr := range serverRotators {
+		go rotator.HandleRotator(sr.settings)
+	}
 
 	status := serverStatus{}
+	status.server = serverName
 	status.topic = serverStatusTopic
 	status.toWireCh = toWireCh
 
+	// the status of the server process and of all radios
+	allStatus := []*serverStatus{&status}
+	for _, sr := range serverRadios {
+		allStatus = append(allStatus, &sr.status)
+	}
+	for _, sr := range serverRotators {
+		allStatus = append(allStatus, &sr.status)
+	}
+
 	for {
 		select {
 		case <-prepareShutdownCh:
 
 			// publish that the server is going offline
-			status.online = false
-			if err := status.sendUpdate(); err != nil {
-				fmt.Println(err)
+			for _, st := range allStatus {
+				st.online = false
+				if err := st.sendUpdate(); err != nil {
+					fmt.Println(err)
+				}
 			}
 			time.Sleep(time.Millisecond * 500)
 			// inform the other goroutines to shut down
@@ -248,28 +286,65 @@ func mqttRadioServer(cmd *cobra.Command, args []string) {
 		case ev := <-connectionStatusCh:
 			connStatus := ev.(int)
 			fmt.Println("connstatus:", connStatus)
-			if connStatus == comms.CONNECTED {
-				status.online = true
-				if err := status.sendUpdate(); err != nil {
+			for _, st := range allStatus {
+				if connStatus == comms.CONNECTED {
+					st.online = true
+					if err := st.sendUpdate(); err != nil {
+						fmt.Println(err)
+					}
+				} else {
+					st.online = false
+				}
+			}
+
+		case ev := <-rigStatusCh:
+			rs := ev.(connection.Status)
+			for _, sr := range serverRadios {
+				if sr.id != rs.ID {
+					continue
+				}
+				sr.status.rigConnected = rs.Connected
+				sr.status.rigError = rs.Error
+				if err := sr.status.sendUpdate(); err != nil {
+					fmt.Println(err)
+				}
+			}
+
+		case ev := <-rotatorStatusCh:
+			rs := ev.(connection.Status)
+			for _, sr := range serverRotators {
+				if sr.id != rs.ID {
+					continue
+				}
+				sr.status.rigConnected = rs.Connected
+				sr.status.rigError = rs.Error
+				if err := sr.status.sendUpdate(); err != nil {
 					fmt.Println(err)
 				}
-			} else {
-				status.online = false
 			}
 		}
 	}
 }
 
 type serverStatus struct {
-	online   bool
-	topic    string
-	toWireCh chan comms.IOMsg
+	online bool
+	server string
+	radio  string // empty for the status of the server process
+	// connection between the server and the rig
+	rigConnected bool
+	rigError     string
+	topic        string
+	toWireCh     chan comms.IOMsg
 }
 
 func (s *serverStatus) sendUpdate() error {
 
 	msg := sbStatus.Status{}
 	msg.Online = s.online
+	msg.Server = s.server
+	msg.Radio = s.radio
+	msg.RigConnected = s.rigConnected
+	msg.RigError = s.rigError
 	data, err := msg.Marshal()
 	if err != nil {
 		return err
@@ -285,10 +360,11 @@ func (s *serverStatus) sendUpdate() error {
 	return nil
 }
 
-func createLastWillMsg() ([]byte, error) {
+func createLastWillMsg(server string) ([]byte, error) {
 
-	willMsg := sbRadio.Status{}
+	willMsg := sbStatus.Status{}
 	willMsg.Online = false
+	willMsg.Server = server
 	data, err := willMsg.Marshal()
 
 	return data, err
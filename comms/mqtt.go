@@ -1,7 +1,9 @@
 package comms
 
 import (
+	"crypto/tls"
 	"log"
+	"net"
 	"strconv"
 	"strings"
 	"sync"
@@ -12,23 +14,55 @@ import (
 	mqtt "github.com/eclipse/paho.mqtt.golang"
 )
 
+// MqttSettings configure the connection to the broker and the channels
+// to which the received messages are routed. The request channels
+// (CatRequest, LockRequest, Morse, MemRequest) receive the topic as well,
+// since its last level is the user_id of the sender.
 type MqttSettings struct {
-	WaitGroup                   *sync.WaitGroup
-	Transport                   string
-	BrokerURL                   string
-	BrokerPort                  int
-	ClientID                    string
-	Topics                      []string
-	ToDeserializeCatRequestCh   chan []byte
-	ToDeserializeCatResponseCh  chan []byte
-	ToDeserializeCapabilitiesCh chan []byte
-	ToDeserializeStatusCh       chan []byte
-	ToDeserializePingRequestCh  chan []byte
-	ToDeserializePingResponseCh chan []byte
-	ToWire                      chan IOMsg
-	Events                      *pubsub.PubSub
-	LastWill                    *LastWill
-	Logger                      *log.Logger
+	WaitGroup                    *sync.WaitGroup
+	Transport                    string
+	BrokerURL                    string
+	BrokerPort                   int
+	Path                         string
+	ClientID                     string
+	Username                     string
+	Password                     string
+	TLSConfig                    *tls.Config
+	Topics                       []string
+	ToDeserializeCatRequestCh    chan IOMsg
+	ToDeserializeCatResponseCh   chan []byte
+	ToDeserializeStateDeltaCh    chan []byte
+	ToDeserializeEchoCh          chan []byte
+	ToDeserializeMetersCh        chan []byte
+	ToDeserializeResyncCh        chan []byte
+	ToDeserializeCapabilitiesCh  chan []byte
+	ToDeserializeStatusCh        chan []byte
+	ToDeserializeErrorCh         chan []byte
+	ToDeserializeAckCh           chan []byte
+	ToDeserializeLockRequestCh   chan IOMsg
+	ToDeserializeLogLevelCh      chan []byte
+	ToDeserializeLogCh           chan []byte
+	ToDeserializeMorseCh         chan IOMsg
+	ToDeserializeMorseProgressCh chan []byte
+	ToDeserializeAlarmCh         chan []byte
+	ToDeserializeRotRequestCh    chan []byte
+	ToDeserializeRotPositionCh   chan []byte
+	ToDeserializeRotCapsCh       chan []byte
+	ToDeserializeRotAckCh        chan []byte
+	ToDeserializeRotStatusCh     chan []byte
+	ToDeserializeMemRequestCh    chan IOMsg
+	ToDeserializeMemResponseCh   chan []byte
+	ToDeserializePingRequestCh   chan []byte
+	ToDeserializePingResponseCh  chan []byte
+	ToWire                       chan IOMsg
+	Events                       *pubsub.PubSub
+	LastWill                     *LastWill
+	Logger                       *log.Logger
+	// Routes forward the messages received on topics starting with
+	// the given prefix to the ToDeserialize channels of the route instead
+	// of the ones above. This allows several radios to share one
+	// connection.
+	Routes map[string]*MqttSettings
 }
 
 // LastWill defines the LastWill for MQTT. The LastWill will be
@@ -58,6 +92,23 @@ const (
 	CONNECTED    = 1
 )
 
+// brokerURL returns the URL of the broker. The transport is one of tcp,
+// ssl, ws and wss; the path is only used by the WebSocket transports
+// (ws, wss) and defaults to "/mqtt".
+func brokerURL(s MqttSettings) string {
+	url := s.Transport + "://" + net.JoinHostPort(s.BrokerURL, strconv.Itoa(s.BrokerPort))
+	if s.Transport != "ws" && s.Transport != "wss" {
+		return url
+	}
+	if s.Path == "" {
+		return url + "/mqtt"
+	}
+	if !strings.HasPrefix(s.Path, "/") {
+		return url + "/" + s.Path
+	}
+	return url + s.Path
+}
+
 func MqttClient(s MqttSettings) {
 
 	defer s.WaitGroup.Done()
@@ -74,33 +125,119 @@ func MqttClient(s MqttSettings) {
 
 	var msgHandler mqtt.MessageHandler = func(client mqtt.Client, msg mqtt.Message) {
 
+		// forward the message to the channels of the radio it belongs to
+		r := &s
+		for prefix, route := range s.Routes {
+			if strings.HasPrefix(msg.Topic(), prefix) {
+				r = route
+				break
+			}
+		}
+
 		if strings.Contains(msg.Topic(), "cat/setstate") {
 
 			// if forwardCat {
-			s.ToDeserializeCatRequestCh <- msg.Payload()[:len(msg.Payload())]
+			r.ToDeserializeCatRequestCh <- IOMsg{Topic: msg.Topic(), Data: msg.Payload()}
 			// }
 
 		} else if strings.Contains(msg.Topic(), "cat/state") {
 
 			// if forwardCat {
-			s.ToDeserializeCatResponseCh <- msg.Payload()[:len(msg.Payload())]
+			r.ToDeserializeCatResponseCh <- msg.Payload()[:len(msg.Payload())]
 			// }
 
+		} else if strings.Contains(msg.Topic(), "cat/delta") {
+
+			r.ToDeserializeStateDeltaCh <- msg.Payload()[:len(msg.Payload())]
+
+		} else if strings.Contains(msg.Topic(), "cat/echo") {
+
+			r.ToDeserializeEchoCh <- msg.Payload()[:len(msg.Payload())]
+
+		} else if strings.Contains(msg.Topic(), "cat/meters") {
+
+			r.ToDeserializeMetersCh <- msg.Payload()[:len(msg.Payload())]
+
+		} else if strings.Contains(msg.Topic(), "cat/resync") {
+
+			r.ToDeserializeResyncCh <- msg.Payload()[:len(msg.Payload())]
+
 		} else if strings.Contains(msg.Topic(), "cat/caps") {
 
-			s.ToDeserializeCapabilitiesCh <- msg.Payload()[:len(msg.Payload())]
+			r.ToDeserializeCapabilitiesCh <- msg.Payload()[:len(msg.Payload())]
+
+		} else if strings.Contains(msg.Topic(), "cat/status") ||
+			strings.Contains(msg.Topic(), "/servers/") {
+
+			r.ToDeserializeStatusCh <- msg.Payload()[:len(msg.Payload())]
+
+		} else if strings.Contains(msg.Topic(), "cat/error") {
+
+			r.ToDeserializeErrorCh <- msg.Payload()[:len(msg.Payload())]
+
+		} else if strings.Contains(msg.Topic(), "cat/ack") {
+
+			r.ToDeserializeAckCh <- msg.Payload()[:len(msg.Payload())]
+
+		} else if strings.Contains(msg.Topic(), "rot/request") {
+
+			r.ToDeserializeRotRequestCh <- msg.Payload()[:len(msg.Payload())]
+
+		} else if strings.Contains(msg.Topic(), "rot/position") {
 
-		} else if strings.Contains(msg.Topic(), "cat/status") {
+			r.ToDeserializeRotPositionCh <- msg.Payload()[:len(msg.Payload())]
 
-			s.ToDeserializeStatusCh <- msg.Payload()[:len(msg.Payload())]
+		} else if strings.Contains(msg.Topic(), "rot/caps") {
+
+			r.ToDeserializeRotCapsCh <- msg.Payload()[:len(msg.Payload())]
+
+		} else if strings.Contains(msg.Topic(), "rot/ack") {
+
+			r.ToDeserializeRotAckCh <- msg.Payload()[:len(msg.Payload())]
+
+		} else if strings.Contains(msg.Topic(), "rot/status") {
+
+			r.ToDeserializeRotStatusCh <- msg.Payload()[:len(msg.Payload())]
+
+		} else if strings.Contains(msg.Topic(), "cat/morse/progress") {
+
+			r.ToDeserializeMorseProgressCh <- msg.Payload()[:len(msg.Payload())]
+
+		} else if strings.Contains(msg.Topic(), "cat/morse") {
+
+			r.ToDeserializeMorseCh <- IOMsg{Topic: msg.Topic(), Data: msg.Payload()}
+
+		} else if strings.Contains(msg.Topic(), "cat/alarm") {
+
+			r.ToDeserializeAlarmCh <- msg.Payload()[:len(msg.Payload())]
+
+		} else if strings.Contains(msg.Topic(), "cat/loglevel") {
+
+			r.ToDeserializeLogLevelCh <- msg.Payload()[:len(msg.Payload())]
+
+		} else if strings.Contains(msg.Topic(), "cat/log") {
+
+			r.ToDeserializeLogCh <- msg.Payload()[:len(msg.Payload())]
+
+		} else if strings.Contains(msg.Topic(), "cat/lock") {
+
+			r.ToDeserializeLockRequestCh <- IOMsg{Topic: msg.Topic(), Data: msg.Payload()}
+
+		} else if strings.Contains(msg.Topic(), "cat/mem/request") {
+
+			r.ToDeserializeMemRequestCh <- IOMsg{Topic: msg.Topic(), Data: msg.Payload()}
+
+		} else if strings.Contains(msg.Topic(), "cat/mem/channels") {
+
+			r.ToDeserializeMemResponseCh <- msg.Payload()[:len(msg.Payload())]
 
 		} else if strings.Contains(msg.Topic(), "cat/ping") {
 
-			s.ToDeserializePingRequestCh <- msg.Payload()[:len(msg.Payload())]
+			r.ToDeserializePingRequestCh <- msg.Payload()[:len(msg.Payload())]
 
 		} else if strings.Contains(msg.Topic(), "cat/pong") {
 
-			s.ToDeserializePingResponseCh <- msg.Payload()[:len(msg.Payload())]
+			r.ToDeserializePingResponseCh <- msg.Payload()[:len(msg.Payload())]
 		}
 
 	}
@@ -125,7 +262,7 @@ func MqttClient(s MqttSettings) {
 		s.Events.Pub(CONNECTED, events.MqttConnStatus)
 	}
 
-	opts := mqtt.NewClientOptions().AddBroker(s.Transport + "://" + s.BrokerURL + ":" + strconv.Itoa(s.BrokerPort))
+	opts := mqtt.NewClientOptions().AddBroker(brokerURL(s))
 	opts.SetClientID(s.ClientID)
 	opts.SetDefaultPublishHandler(msgHandler)
 	opts.SetKeepAlive(time.Second * 5)
@@ -135,6 +272,15 @@ func MqttClient(s MqttSettings) {
 	opts.SetConnectionLostHandler(connectionLostHandler)
 	opts.SetAutoReconnect(true)
 
+	if s.Username != "" {
+		opts.SetUsername(s.Username)
+		opts.SetPassword(s.Password)
+	}
+
+	if s.TLSConfig != nil {
+		opts.SetTLSConfig(s.TLSConfig)
+	}
+
 	if s.LastWill != nil {
 		opts.SetBinaryWill(s.LastWill.Topic, s.LastWill.Data, s.LastWill.Qos, s.LastWill.Retain)
 	}
@@ -0,0 +1,129 @@
+package connection
+
+import (
+	"log"
+	"time"
+
+	"github.com/cskr/pubsub"
+)
+
+const (
+	// a device is considered lost after this number of consecutive
+	// errors
+	MaxErrors  = 3
+	MinBackoff = time.Second
+	MaxBackoff = time.Second * 30
+)
+
+// Status is published through an event (e.g. events.RigStatus) whenever
+// the connection to a device has been established or lost.
+type Status struct {
+	ID        string
+	Connected bool
+	Error     string // reason why the device is disconnected
+}
+
+// Monitor keeps track of the connection to a device (rig, rotator). It
+// counts the consecutive errors, schedules the reconnects with an
+// exponential backoff and publishes the Status. A Monitor must only be
+// used from the goroutine which accesses the device.
+type Monitor struct {
+	kind      string // e.g. "rig"; used in the logs
+	id        string
+	events    *pubsub.PubSub
+	event     string
+	connected bool
+	errors    int
+	lastError error
+	backoff   time.Duration
+	reconnect <-chan time.Time
+}
+
+// NewMonitor returns a Monitor which publishes the Status of the device
+// on the given event.
+func NewMonitor(kind, id string, events *pubsub.PubSub, event string) *Monitor {
+	return &Monitor{
+		kind:   kind,
+		id:     id,
+		events: events,
+		event:  event,
+	}
+}
+
+// Connected returns true if the device is connected.
+func (m *Monitor) Connected() bool {
+	return m.connected
+}
+
+// SetConnected resets the error count, stops the reconnects and
+// publishes the Status.
+func (m *Monitor) SetConnected() {
+	m.connected = true
+	m.errors = 0
+	m.lastError = nil
+	m.backoff = 0
+	m.reconnect = nil
+
+	log.Printf("%s %s connected\n", m.kind, m.id)
+	m.publish()
+}
+
+// SetDisconnected publishes the Status and schedules the next connection
+// attempt. The backoff starts at MinBackoff after the device has been
+// lost and doubles with every failed attempt up to MaxBackoff.
+func (m *Monitor) SetDisconnected(err error) {
+	switch {
+	case m.connected || m.backoff == 0:
+		m.backoff = MinBackoff
+	default:
+		m.backoff *= 2
+		if m.backoff > MaxBackoff {
+			m.backoff = MaxBackoff
+		}
+	}
+	m.connected = false
+	m.lastError = err
+	m.reconnect = time.After(m.backoff)
+
+	log.Printf("%s %s disconnected: %v\n", m.kind, m.id, err)
+	m.publish()
+}
+
+// Reconnect returns a channel which fires when the next connection
+// attempt is due. While connected, the channel is nil and blocks
+// forever.
+func (m *Monitor) Reconnect() <-chan time.Time {
+	return m.reconnect
+}
+
+// Check counts the consecutive errors. A nil error resets the count.
+func (m *Monitor) Check(err error) {
+	if err == nil {
+		m.errors = 0
+		return
+	}
+	m.errors++
+	m.lastError = err
+}
+
+// Lost returns true if the device is still connected but the last
+// MaxErrors accesses have failed.
+func (m *Monitor) Lost() bool {
+	return m.connected && m.errors >= MaxErrors
+}
+
+// LastError returns the error which caused the last failed access.
+func (m *Monitor) LastError() error {
+	return m.lastError
+}
+
+func (m *Monitor) publish() {
+	status := Status{
+		ID:        m.id,
+		Connected: m.connected,
+	}
+	if !m.connected && m.lastError != nil {
+		status.Error = m.lastError.Error()
+	}
+	m.events.Pub(status, m.event)
+}
@@ -0,0 +1,74 @@
+package connection
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/cskr/pubsub"
+)
+
+func TestMonitorBackoff(t *testing.T) {
+	m := NewMonitor("rig", "test", pubsub.New(10), "status")
+	errLost := errors.New("lost")
+
+	steps := []struct {
+		name    string
+		op      func()
+		backoff time.Duration
+	}{
+		{"initial attempt failed", func() { m.SetDisconnected(errLost) }, MinBackoff},
+		{"reconnect failed", func() { m.SetDisconnected(errLost) }, 2 * MinBackoff},
+		{"reconnect failed again", func() { m.SetDisconnected(errLost) }, 4 * MinBackoff},
+		{"connected", func() { m.SetConnected() }, 0},
+		{"lost", func() { m.SetDisconnected(errLost) }, MinBackoff},
+	}
+
+	for _, s := range steps {
+		s.op()
+		if m.backoff != s.backoff {
+			t.Errorf("%s: backoff = %v, want %v", s.name, m.backoff, s.backoff)
+		}
+		if (m.Reconnect() == nil) != m.Connected() {
+			t.Errorf("%s: reconnect armed = %v while connected = %v",
+				s.name, m.Reconnect() != nil, m.Connected())
+		}
+	}
+
+	for i := 0; i < 10; i++ {
+		m.SetDisconnected(errLost)
+	}
+	if m.backoff != MaxBackoff {
+		t.Errorf("backoff = %v, want %v", m.backoff, MaxBackoff)
+	}
+}
+
+func TestMonitorLost(t *testing.T) {
+
+	tests := []struct {
+		name string
+		errs []error
+		want bool
+	}{
+		{"no errors", []error{nil, nil, nil}, false},
+		{"consecutive errors", []error{errors.New("a"), errors.New("b"), errors.New("c")}, true},
+		{"interrupted by success", []error{errors.New("a"), errors.New("b"), nil, errors.New("c")}, false},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			m := NewMonitor("rig", "test", pubsub.New(10), "status")
+			m.SetConnected()
+
+			for _, err := range tc.errs {
+				m.Check(err)
+			}
+			if got := m.Lost(); got != tc.want {
+				t.Errorf("Lost() = %v, want %v", got, tc.want)
+			}
+			if tc.want && m.LastError().Error() != "c" {
+				t.Errorf("LastError() = %v, want c", m.LastError())
+			}
+		})
+	}
+}
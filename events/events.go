@@ -23,8 +23,19 @@ const (
 	RadioLog        = "radiolog"       // string
 	ServerOnline    = "serverOnline"   //bool
 	Pong            = "pong"           // int64
+	Ping            = "ping"           // string (user_id), see RadioPing
+	RigStatus       = "rigStatus"      // connection.Status
+	RigConnected    = "rigConnected"   // bool
+	RotatorStatus   = "rotatorStatus"  // connection.Status
 )
 
+// RadioPing returns the name of the Ping event for the radio with the
+// given id, so that the pings of one radio's clients don't keep the
+// transmitters of other radios keyed.
+func RadioPing(id string) string {
+	return Ping + "/" + id
+}
+
 func WatchSystemEvents(evPS *pubsub.PubSub, wg *sync.WaitGroup) {
 
 	defer wg.Done()
@@ -19,6 +19,7 @@ type Settings struct {
 	PingTopic string
 	PongTopic string
 	UserID    string
+	RadioID   string // server only; pings are published as events.RadioPing
 	WaitGroup *sync.WaitGroup
 	Events    *pubsub.PubSub
 }
@@ -78,6 +79,12 @@ func EchoPing(ps Settings) {
 			pong.Data = msg
 			pong.Topic = ps.PongTopic
 			ps.ToWireCh <- pong
+
+			// let the other goroutines know which clients are alive
+			req := sbPing.Ping{}
+			if err := req.Unmarshal(msg); err == nil {
+				ps.Events.Pub(req.UserId, events.RadioPing(ps.RadioID))
+			}
 		}
 	}
 }
@@ -0,0 +1,205 @@
+package radio
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	sbRadio "github.com/dh1tw/remoteRadio/sb_radio"
+	"github.com/dh1tw/remoteRadio/utils"
+)
+
+// An atomic SetState is split into steps of one field each, which are
+// applied in the usual order. If a step fails, the steps which have
+// already been applied (including the failed step's partial changes) are
+// undone in reverse order with the state recorded before each step.
+// Memory channels written by VFO operations (e.g. FROM_VFO) can not be
+// restored.
+
+// atomicStep is one field of an atomic request together with the state
+// before it has been applied.
+type atomicStep struct {
+	req     *sbRadio.SetState
+	pre     sbRadio.State
+	applied []string
+}
+
+// applyAtomic applies all requested fields or none of them. The outcome
+// is reported as one result: either all fields have been applied, or
+// the rejections of the failed field are reported and RolledBack is set.
+func (r *radio) applyAtomic(ns *sbRadio.SetState, ack *sbRadio.Ack) {
+
+	steps := r.atomicSteps(ns)
+	applied := []string{}
+
+	for i, step := range steps {
+		pre, err := utils.CopyState(&r.state)
+		if err != nil {
+			r.addResult(ack, "atomic", err)
+			r.rollback(steps[:i], ack)
+			return
+		}
+		step.pre = pre
+
+		stepAck := sbRadio.Ack{
+			UserId:    ack.GetUserId(),
+			RequestId: ack.GetRequestId(),
+		}
+		r.applySetState(step.req, &stepAck)
+		step.applied = stepAck.Applied
+
+		if len(stepAck.Rejected) > 0 {
+			log.Printf("atomic request of %s failed; rolling back %s\n",
+				ack.GetUserId(), strings.Join(append(applied, step.applied...), ", "))
+			ack.Rejected = stepAck.Rejected
+			r.rollback(steps[:i+1], ack)
+			return
+		}
+
+		applied = append(applied, step.applied...)
+	}
+
+	ack.Applied = applied
+}
+
+// atomicSteps splits a SetState into requests of a single field each.
+func (r *radio) atomicSteps(ns *sbRadio.SetState) []*atomicStep {
+
+	steps := []*atomicStep{}
+
+	newStep := func() *sbRadio.SetState {
+		return &sbRadio.SetState{
+			CurrentVfo:      ns.GetCurrentVfo(),
+			Vfo:             ns.Vfo,
+			RadioOn:         ns.GetRadioOn(),
+			Ptt:             ns.GetPtt(),
+			PollingInterval: ns.GetPollingInterval(),
+			UserId:          ns.GetUserId(),
+			RequestId:       ns.GetRequestId(),
+			Md:              &sbRadio.MetaData{},
+		}
+	}
+
+	fields := requestedFields(ns)
+
+	// the VFO is switched after turning on the radio and before
+	// anything else
+	vfoStep := 0
+	if len(fields) > 0 && fields[0] == "radio_on" {
+		vfoStep = 1
+	}
+
+	for i, field := range fields {
+		if i == vfoStep && ns.GetCurrentVfo() != r.state.CurrentVfo {
+			steps = append(steps, &atomicStep{req: newStep()})
+		}
+		req := newStep()
+		if field == "vfo_operations" {
+			req.VfoOperations = ns.GetVfoOperations()
+		}
+		if flag, ok := metaDataFlags(req.Md)[field]; ok {
+			*flag = true
+		}
+		steps = append(steps, &atomicStep{req: req})
+	}
+
+	if len(fields) <= vfoStep && ns.GetCurrentVfo() != r.state.CurrentVfo {
+		steps = append(steps, &atomicStep{req: newStep()})
+	}
+
+	return steps
+}
+
+// rollback undoes the applied steps in reverse order. Failures are
+// reported in the Ack's RollbackError.
+func (r *radio) rollback(steps []*atomicStep, ack *sbRadio.Ack) {
+
+	ack.RolledBack = true
+	failures := []string{}
+
+	for i := len(steps) - 1; i >= 0; i-- {
+		step := steps[i]
+		if len(step.applied) == 0 {
+			continue
+		}
+
+		undoAck := sbRadio.Ack{
+			UserId:    ack.GetUserId(),
+			RequestId: ack.GetRequestId(),
+		}
+		r.applySetState(undoRequest(&step.pre, step.applied, ack.GetUserId()), &undoAck)
+
+		for _, e := range undoAck.Rejected {
+			failures = append(failures, fmt.Sprintf("%s: %s", e.GetField(), e.GetError()))
+		}
+	}
+
+	if len(failures) > 0 {
+		ack.RollbackError = "unable to restore " + strings.Join(failures, "; ")
+		log.Println(ack.RollbackError)
+	}
+}
+
+// undoRequest returns a SetState which restores the given fields to the
+// values of the state pre. VFO operations are undone by restoring all
+// VFO related fields.
+func undoRequest(pre *sbRadio.State, fields []string, userID string) *sbRadio.SetState {
+
+	ss := &sbRadio.SetState{
+		CurrentVfo:      pre.GetCurrentVfo(),
+		Vfo:             pre.GetVfo(),
+		RadioOn:         pre.GetRadioOn(),
+		Ptt:             pre.GetPtt(),
+		PollingInterval: pre.GetPollingInterval(),
+		UserId:          userID,
+		Md:              &sbRadio.MetaData{},
+	}
+	if ss.Vfo == nil {
+		ss.Vfo = &sbRadio.Vfo{}
+	}
+
+	flags := metaDataFlags(ss.Md)
+
+	for _, field := range fields {
+		if field == "vfo_operations" {
+			for name, flag := range flags {
+				if name != "radio_on" && name != "ptt" && name != "polling_interval" {
+					*flag = true
+				}
+			}
+			continue
+		}
+		if flag, ok := flags[field]; ok {
+			*flag = true
+		}
+	}
+
+	return ss
+}
+
+// metaDataFlags maps the field names to the corresponding flags of
+// the MetaData.
+func metaDataFlags(md *sbRadio.MetaData) map[string]*bool {
+	return map[string]*bool{
+		"radio_on":         &md.HasRadioOn,
+		"frequency":        &md.HasFrequency,
+		"mode":             &md.HasMode,
+		"pb_width":         &md.HasPbWidth,
+		"ant":              &md.HasAnt,
+		"rit":              &md.HasRit,
+		"xit":              &md.HasXit,
+		"split":            &md.HasSplit,
+		"tuning_step":      &md.HasTuningStep,
+		"ctcss_tone":       &md.HasCtcssTone,
+		"ctcss_sql":        &md.HasCtcssSql,
+		"dcs_code":         &md.HasDcsCode,
+		"dcs_sql":          &md.HasDcsSql,
+		"rptr_shift":       &md.HasRptrShift,
+		"rptr_offset":      &md.HasRptrOffset,
+		"functions":        &md.HasFunctions,
+		"levels":           &md.HasLevels,
+		"parameters":       &md.HasParameters,
+		"ptt":              &md.HasPtt,
+		"polling_interval": &md.HasPollingInterval,
+	}
+}
@@ -0,0 +1,104 @@
+package radio
+
+import (
+	"testing"
+
+	sbRadio "github.com/dh1tw/remoteRadio/sb_radio"
+)
+
+// TestAtomic verifies that an atomic request is either applied
+// completely or that the rig is restored to its previous state. The
+// simulator rejects a RIT beyond its MaxRit.
+func TestAtomic(t *testing.T) {
+
+	tests := []struct {
+		name       string
+		req        sbRadio.SetState
+		rejected   string // field which must be rejected ("" = none)
+		wantVfo    string
+		wantFreq   float64
+		wantMode   string
+		rolledBack bool
+	}{
+		{
+			name: "all applied",
+			req: sbRadio.SetState{Md: &sbRadio.MetaData{HasFrequency: true, HasMode: true},
+				Vfo: &sbRadio.Vfo{Frequency: 7050000, Mode: "LSB"}},
+			wantVfo:  "VFOA",
+			wantFreq: 7050000,
+			wantMode: "LSB",
+		},
+		{
+			name: "frequency rolled back",
+			req: sbRadio.SetState{Md: &sbRadio.MetaData{HasFrequency: true, HasRit: true},
+				Vfo: &sbRadio.Vfo{Frequency: 7050000, Rit: 999999}},
+			rejected:   "rit",
+			wantVfo:    "VFOA",
+			wantFreq:   14250000,
+			wantMode:   "USB",
+			rolledBack: true,
+		},
+		{
+			name: "frequency and mode rolled back",
+			req: sbRadio.SetState{Md: &sbRadio.MetaData{HasFrequency: true, HasMode: true, HasRit: true},
+				Vfo: &sbRadio.Vfo{Frequency: 7050000, Mode: "LSB", Rit: 999999}},
+			rejected:   "rit",
+			wantVfo:    "VFOA",
+			wantFreq:   14250000,
+			wantMode:   "USB",
+			rolledBack: true,
+		},
+		{
+			name: "vfo switch rolled back",
+			req: sbRadio.SetState{CurrentVfo: "VFOB", Md: &sbRadio.MetaData{HasFrequency: true, HasRit: true},
+				Vfo: &sbRadio.Vfo{Frequency: 7050000, Rit: 999999}},
+			rejected:   "rit",
+			wantVfo:    "VFOA",
+			wantFreq:   14250000,
+			wantMode:   "USB",
+			rolledBack: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			r := newTestRadio(t, RadioSettings{})
+			vfoB, err := r.rig.GetFreq("VFOB")
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			tc.req.UserId = "dh1tw"
+			tc.req.Atomic = true
+			ack := setState(t, r, tc.req)
+
+			if tc.rejected != "" && !rejected(ack, tc.rejected) {
+				t.Errorf("%s not rejected: %v", tc.rejected, ack)
+			}
+			if tc.rejected == "" && len(ack.Rejected) > 0 {
+				t.Errorf("unexpected rejection: %v", ack.Rejected)
+			}
+			if ack.RolledBack != tc.rolledBack || ack.RollbackError != "" {
+				t.Errorf("rolled back = %v (%q), want %v", ack.RolledBack, ack.RollbackError, tc.rolledBack)
+			}
+			if tc.rolledBack && len(ack.Applied) > 0 {
+				t.Errorf("applied fields reported after the rollback: %v", ack.Applied)
+			}
+
+			vfo, _ := r.rig.GetVfo()
+			freq, _ := r.rig.GetFreq(vfo)
+			mode, _, _ := r.rig.GetMode(vfo)
+			if vfo != tc.wantVfo || freq != tc.wantFreq || mode != tc.wantMode {
+				t.Errorf("rig = %s %.0f %s, want %s %.0f %s",
+					vfo, freq, mode, tc.wantVfo, tc.wantFreq, tc.wantMode)
+			}
+			if r.state.CurrentVfo != vfo || r.state.Vfo.Frequency != freq || r.state.Vfo.Mode != mode {
+				t.Errorf("state = %s %.0f %s doesn't match the rig",
+					r.state.CurrentVfo, r.state.Vfo.Frequency, r.state.Vfo.Mode)
+			}
+			if f, _ := r.rig.GetFreq("VFOB"); f != vfoB {
+				t.Errorf("VFOB = %.0f, want %.0f", f, vfoB)
+			}
+		})
+	}
+}
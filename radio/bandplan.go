@@ -0,0 +1,209 @@
+package radio
+
+import (
+	"fmt"
+	"strings"
+
+	sbRadio "github.com/dh1tw/remoteRadio/sb_radio"
+)
+
+// BandPlan contains the transmit privileges of each licence class.
+// Operators are mapped through their user_id to a licence class. Operators
+// without an explicit mapping get the DefaultClass. If no DefaultClass is
+// set, unknown operators are not allowed to transmit at all.
+type BandPlan struct {
+	Classes      map[string]LicenceClass `mapstructure:"classes"`
+	Users        map[string]string       `mapstructure:"users"`
+	DefaultClass string                  `mapstructure:"default_class"`
+}
+
+// LicenceClass contains the band segments in which an operator of
+// this class may transmit and the maximum power (RFPOWER level 0..1).
+type LicenceClass struct {
+	MaxPower float32       `mapstructure:"max_power"`
+	Segments []BandSegment `mapstructure:"segments"`
+}
+
+// BandSegment is a frequency range [Hz] with the modes which are
+// allowed in it. An empty list of modes allows all modes. MaxPower
+// overrides the class' maximum power if it is > 0.
+type BandSegment struct {
+	Name     string   `mapstructure:"name"`
+	From     float64  `mapstructure:"from"`
+	To       float64  `mapstructure:"to"`
+	Modes    []string `mapstructure:"modes"`
+	MaxPower float32  `mapstructure:"max_power"`
+}
+
+// Validate checks the band plan for inconsistencies.
+func (bp *BandPlan) Validate() error {
+	for name, class := range bp.Classes {
+		if class.MaxPower < 0 || class.MaxPower > 1 {
+			return fmt.Errorf("class %s: max_power must be within 0..1", name)
+		}
+		for _, seg := range class.Segments {
+			if seg.From <= 0 || seg.To <= seg.From {
+				return fmt.Errorf("class %s: invalid segment %s (%.0f-%.0fHz)",
+					name, seg.Name, seg.From, seg.To)
+			}
+		}
+	}
+
+	for user, class := range bp.Users {
+		if _, ok := bp.Classes[strings.ToLower(class)]; !ok {
+			return fmt.Errorf("user %s: unknown licence class %s", user, class)
+		}
+	}
+
+	if bp.DefaultClass != "" {
+		if _, ok := bp.Classes[strings.ToLower(bp.DefaultClass)]; !ok {
+			return fmt.Errorf("unknown default licence class %s", bp.DefaultClass)
+		}
+	}
+
+	return nil
+}
+
+// class returns the licence class of an operator. Keys are compared
+// case insensitive since the config parser lower cases them.
+func (bp *BandPlan) class(userID string) (string, LicenceClass, error) {
+	name, ok := bp.Users[strings.ToLower(userID)]
+	if !ok {
+		name = bp.DefaultClass
+	}
+	if name == "" {
+		return "", LicenceClass{}, fmt.Errorf("%s has no transmit privileges", userID)
+	}
+	name = strings.ToLower(name)
+	class, ok := bp.Classes[name]
+	if !ok {
+		return "", LicenceClass{}, fmt.Errorf("unknown licence class %s", name)
+	}
+	return name, class, nil
+}
+
+// maxPower returns the maximum RFPOWER level an operator may use on
+// the given frequency and mode. An error is returned if the operator
+// is not allowed to transmit there at all.
+func (bp *BandPlan) maxPower(userID string, freq float64, mode string) (float32, error) {
+
+	name, class, err := bp.class(userID)
+	if err != nil {
+		return 0, err
+	}
+
+	for _, seg := range class.Segments {
+		if freq < seg.From || freq > seg.To {
+			continue
+		}
+		if len(seg.Modes) > 0 && !modeInList(mode, seg.Modes) {
+			continue
+		}
+		if seg.MaxPower > 0 {
+			return seg.MaxPower, nil
+		}
+		return class.MaxPower, nil
+	}
+
+	return 0, fmt.Errorf("%s (class %s) is not permitted to transmit %s on %.3fkHz",
+		userID, name, mode, freq/1000)
+}
+
+func modeInList(mode string, modes []string) bool {
+	for _, m := range modes {
+		if strings.EqualFold(m, mode) {
+			return true
+		}
+	}
+	return false
+}
+
+// txFrequency returns the frequency and mode on which the rig
+// transmits.
+func (r *radio) txFrequency() (float64, string) {
+	if r.state.Vfo.Split != nil && r.state.Vfo.Split.Enabled {
+		return r.state.Vfo.Split.Frequency, r.state.Vfo.Split.Mode
+	}
+	return r.state.Vfo.Frequency + float64(r.state.Vfo.Xit), r.state.Vfo.Mode
+}
+
+// checkTx verifies that an operator is permitted to transmit with the
+// given parameters. Without a band plan everything is permitted.
+func (r *radio) checkTx(userID string, freq float64, mode string, power float32) error {
+
+	bp := r.settings.BandPlan
+	if bp == nil {
+		return nil
+	}
+
+	maxPower, err := bp.maxPower(userID, freq, mode)
+	if err != nil {
+		return err
+	}
+
+	if power > maxPower {
+		return fmt.Errorf("RFPOWER %.2f exceeds the limit of %.2f for %s on %.3fkHz",
+			power, maxPower, userID, freq/1000)
+	}
+
+	return nil
+}
+
+// checkTxState verifies the current state of the rig against the
+// operator's privileges.
+func (r *radio) checkTxState(userID string) error {
+	freq, mode := r.txFrequency()
+	return r.checkTx(userID, freq, mode, r.state.Vfo.Levels["RFPOWER"])
+}
+
+// splitTxFrequency returns the frequency and mode on which the rig will
+// transmit once the split settings have been applied. The frequency is
+// 0 if it can't be determined before the split has been applied.
+func (r *radio) splitTxFrequency(split *sbRadio.Split) (float64, string) {
+	if !split.GetEnabled() {
+		return r.state.Vfo.Frequency + float64(r.state.Vfo.Xit), r.state.Vfo.Mode
+	}
+	freq, mode := r.state.Vfo.Split.GetFrequency(), r.state.Vfo.Split.GetMode()
+	if split.GetFrequency() > 0 {
+		freq = split.GetFrequency()
+	}
+	if len(split.GetMode()) > 0 {
+		mode = split.GetMode()
+	}
+	return freq, mode
+}
+
+// enforceTxState checks the operator's privileges after operations
+// whose outcome can't be predicted (e.g. vfo operations or recalling a
+// memory channel). If the transmitter is keyed and the new state is
+// not permitted, the transmitter is unkeyed.
+func (r *radio) enforceTxState(userID string) error {
+
+	if !r.state.Ptt && r.morse == nil {
+		return nil
+	}
+
+	freq, mode := r.txFrequency()
+	if r.morse != nil {
+		mode = "CW"
+	}
+
+	err := r.checkTx(userID, freq, mode, r.state.Vfo.Levels["RFPOWER"])
+	if err == nil {
+		return nil
+	}
+
+	if r.morse != nil {
+		r.abortMorse("band plan")
+	}
+
+	if r.state.Ptt {
+		if perr := r.updatePtt(false); perr != nil {
+			return fmt.Errorf("%v; unable to unkey the transmitter: %v", err, perr)
+		}
+		r.state.PttOffReason = err.Error()
+		r.pttOwner = ""
+	}
+
+	return err
+}
@@ -0,0 +1,271 @@
+package radio
+
+import (
+	"testing"
+
+	sbRadio "github.com/dh1tw/remoteRadio/sb_radio"
+)
+
+func testBandPlan() BandPlan {
+	return BandPlan{
+		Classes: map[string]LicenceClass{
+			"a": {
+				MaxPower: 1,
+				Segments: []BandSegment{
+					{Name: "40m CW", From: 7000000, To: 7040000, Modes: []string{"CW", "CWR"}},
+					{Name: "40m SSB", From: 7040000, To: 7200000, Modes: []string{"LSB", "USB"}, MaxPower: 0.5},
+					{Name: "20m", From: 14000000, To: 14350000},
+				},
+			},
+			"e": {
+				MaxPower: 0.1,
+				Segments: []BandSegment{
+					{Name: "80m", From: 3500000, To: 3800000},
+				},
+			},
+		},
+		Users: map[string]string{
+			"dh1tw":  "A",
+			"novice": "e",
+		},
+	}
+}
+
+func TestBandPlanMaxPower(t *testing.T) {
+
+	tests := []struct {
+		name         string
+		defaultClass string
+		userID       string
+		freq         float64
+		mode         string
+		want         float32
+		wantErr      bool
+	}{
+		{"mode permitted", "", "dh1tw", 7020000, "CW", 1, false},
+		{"mode not permitted", "", "dh1tw", 7020000, "USB", 0, true},
+		{"segment power limit", "", "dh1tw", 7100000, "usb", 0.5, false},
+		{"all modes permitted", "", "dh1tw", 14200000, "FM", 1, false},
+		{"user_id case insensitive", "", "DH1TW", 14200000, "USB", 1, false},
+		{"segment edge", "", "dh1tw", 14350000, "USB", 1, false},
+		{"out of band", "", "dh1tw", 14350001, "USB", 0, true},
+		{"other class", "", "novice", 3600000, "LSB", 0.1, false},
+		{"not in class", "", "novice", 14200000, "USB", 0, true},
+		{"unknown user", "", "stranger", 3600000, "LSB", 0, true},
+		{"default class", "E", "stranger", 3600000, "LSB", 0.1, false},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			bp := testBandPlan()
+			bp.DefaultClass = tc.defaultClass
+
+			got, err := bp.maxPower(tc.userID, tc.freq, tc.mode)
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("maxPower() error = %v, wantErr %v", err, tc.wantErr)
+			}
+			if got != tc.want {
+				t.Errorf("maxPower() = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestBandPlanValidate(t *testing.T) {
+
+	tests := []struct {
+		name    string
+		modify  func(bp *BandPlan)
+		wantErr bool
+	}{
+		{"valid", func(bp *BandPlan) {}, false},
+		{"max_power out of range", func(bp *BandPlan) {
+			bp.Classes["e"] = LicenceClass{MaxPower: 1.5}
+		}, true},
+		{"invalid segment", func(bp *BandPlan) {
+			bp.Classes["e"] = LicenceClass{MaxPower: 0.1,
+				Segments: []BandSegment{{Name: "80m", From: 3800000, To: 3500000}}}
+		}, true},
+		{"unknown class of user", func(bp *BandPlan) {
+			bp.Users["foo"] = "x"
+		}, true},
+		{"unknown default class", func(bp *BandPlan) {
+			bp.DefaultClass = "x"
+		}, true},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			bp := testBandPlan()
+			tc.modify(&bp)
+			if err := bp.Validate(); (err != nil) != tc.wantErr {
+				t.Errorf("Validate() error = %v, wantErr %v", err, tc.wantErr)
+			}
+		})
+	}
+}
+
+// TestTxPrivileges verifies that the tx frequency can't be moved out of
+// the permitted segments while the transmitter is keyed. The simulator
+// starts on 14.250MHz USB with full power.
+func TestTxPrivileges(t *testing.T) {
+
+	tests := []struct {
+		name    string
+		setup   func(r *radio)
+		req     sbRadio.SetState
+		field   string // field which must be rejected ("" = none)
+		wantPtt bool
+	}{
+		{
+			name:    "frequency within segment",
+			req:     sbRadio.SetState{Md: &sbRadio.MetaData{HasFrequency: true}, Vfo: &sbRadio.Vfo{Frequency: 14100000}},
+			wantPtt: true,
+		},
+		{
+			name:    "frequency out of band",
+			req:     sbRadio.SetState{Md: &sbRadio.MetaData{HasFrequency: true}, Vfo: &sbRadio.Vfo{Frequency: 10120000}},
+			field:   "frequency",
+			wantPtt: true,
+		},
+		{
+			name: "xit out of band",
+			req: sbRadio.SetState{Md: &sbRadio.MetaData{HasFrequency: true, HasXit: true},
+				Vfo: &sbRadio.Vfo{Frequency: 14345000, Xit: 9000}},
+			field:   "xit",
+			wantPtt: true,
+		},
+		{
+			name: "split out of band",
+			req: sbRadio.SetState{Md: &sbRadio.MetaData{HasSplit: true},
+				Vfo: &sbRadio.Vfo{Split: &sbRadio.Split{Enabled: true, Vfo: "VFOB", Frequency: 10120000, Mode: "CW"}}},
+			field:   "split",
+			wantPtt: true,
+		},
+		{
+			name: "split within segment",
+			req: sbRadio.SetState{Md: &sbRadio.MetaData{HasSplit: true},
+				Vfo: &sbRadio.Vfo{Split: &sbRadio.Split{Enabled: true, Vfo: "VFOB", Frequency: 14200000, Mode: "USB"}}},
+			wantPtt: true,
+		},
+		{
+			name:    "vfo operation out of band",
+			req:     sbRadio.SetState{VfoOperations: []string{"BAND_UP"}},
+			field:   "vfo_operations",
+			wantPtt: false,
+		},
+		{
+			name:    "vfo out of band",
+			setup:   func(r *radio) { r.rig.SetFreq("VFOB", 10120000) },
+			req:     sbRadio.SetState{CurrentVfo: "VFOB"},
+			field:   "current_vfo",
+			wantPtt: false,
+		},
+		{
+			name:    "vfo within segment",
+			req:     sbRadio.SetState{CurrentVfo: "VFOB"},
+			wantPtt: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			bp := testBandPlan()
+			r := newTestRadio(t, RadioSettings{BandPlan: &bp})
+
+			ack := setState(t, r, sbRadio.SetState{UserId: "dh1tw",
+				Md: &sbRadio.MetaData{HasPtt: true}, Ptt: true})
+			if len(ack.Rejected) > 0 {
+				t.Fatalf("unable to key the transmitter: %v", ack.Rejected)
+			}
+
+			if tc.setup != nil {
+				tc.setup(r)
+			}
+
+			tc.req.UserId = "dh1tw"
+			if tc.req.Vfo == nil {
+				tc.req.Vfo = &sbRadio.Vfo{}
+			}
+			if tc.req.Md == nil {
+				tc.req.Md = &sbRadio.MetaData{}
+			}
+			ack = setState(t, r, tc.req)
+
+			if tc.field != "" && !rejected(ack, tc.field) {
+				t.Errorf("%s not rejected: %v", tc.field, ack)
+			}
+			if tc.field == "" && len(ack.Rejected) > 0 {
+				t.Errorf("unexpected rejection: %v", ack.Rejected)
+			}
+			if r.state.Ptt != tc.wantPtt {
+				t.Errorf("ptt = %v, want %v", r.state.Ptt, tc.wantPtt)
+			}
+			if ptt, _ := r.rig.GetPtt(r.state.CurrentVfo); ptt != r.state.Ptt {
+				t.Errorf("rig ptt = %v, state ptt = %v", ptt, r.state.Ptt)
+			}
+		})
+	}
+}
+
+func TestTxPrivilegesKeying(t *testing.T) {
+	bp := testBandPlan()
+	r := newTestRadio(t, RadioSettings{BandPlan: &bp})
+
+	ack := setState(t, r, sbRadio.SetState{UserId: "novice",
+		Md: &sbRadio.MetaData{HasPtt: true}, Ptt: true})
+	if !rejected(ack, "ptt") || r.state.Ptt {
+		t.Errorf("novice keyed the transmitter on 20m: %v", ack)
+	}
+}
+
+func TestTxPrivilegesMemoryRecall(t *testing.T) {
+	bp := testBandPlan()
+	r := newTestRadio(t, RadioSettings{BandPlan: &bp})
+
+	err := r.rig.SetChannel("VFOA", sbRadio.Channel{Channel: 1, Frequency: 10120000, Mode: "CW"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	setState(t, r, sbRadio.SetState{UserId: "dh1tw", Md: &sbRadio.MetaData{HasPtt: true}, Ptt: true})
+	if !r.state.Ptt {
+		t.Fatal("unable to key the transmitter")
+	}
+
+	_, err = r.execMemoryRequest(sbRadio.MemoryRequest{UserId: "dh1tw", Operation: "recall", Channel: 1})
+	if err == nil {
+		t.Error("recalling a channel out of band must fail while transmitting")
+	}
+	if r.state.Ptt {
+		t.Error("transmitter still keyed")
+	}
+}
+
+func TestTxPrivilegesMorse(t *testing.T) {
+
+	tests := []struct {
+		name    string
+		freq    float64
+		mode    string
+		wantErr bool
+	}{
+		{"cw segment", 7020000, "USB", false},
+		{"ssb segment", 7100000, "USB", true},
+		{"out of band", 10120000, "CW", true},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			bp := testBandPlan()
+			r := newTestRadio(t, RadioSettings{BandPlan: &bp})
+			r.state.Vfo.Frequency = tc.freq
+			r.state.Vfo.Mode = tc.mode
+
+			err := r.checkMorse(&sbRadio.SendMorse{UserId: "dh1tw", Text: "CQ"})
+			if (err != nil) != tc.wantErr {
+				t.Errorf("checkMorse() error = %v, wantErr %v", err, tc.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,279 @@
+package radio
+
+import (
+	"errors"
+	"log"
+
+	"github.com/dh1tw/remoteRadio/comms"
+	sbRadio "github.com/dh1tw/remoteRadio/sb_radio"
+	"github.com/dh1tw/remoteRadio/utils"
+)
+
+// coalescableFields are typically changed continuously by turning a knob
+// on the client. Pending requests which change only one of these fields
+// are replaced by newer requests of the same user for the same field.
+var coalescableFields = []string{"frequency", "rit", "xit"}
+
+// errCancelled is reported for pending requests which would key the
+// transmitter or turn the radio on again after a safety request.
+var errCancelled = errors.New("cancelled by a request to unkey the transmitter or turn the radio off")
+
+// queueCatRequest is called by the intake goroutine and puts a SetState
+// request on the scheduler.
+func (r *radio) queueCatRequest(msg []byte) {
+
+	ns := sbRadio.SetState{}
+	if err := ns.Unmarshal(msg); err != nil {
+		log.Println(err)
+		return
+	}
+
+	if isSafetyRequest(&ns) {
+		r.queueSafetyRequest(&ns)
+		return
+	}
+
+	// the value of a coalescable request is echoed to the clients
+	var echo *sbRadio.SetState
+	key := coalesceKey(&ns)
+	if key != "" {
+		echo = &ns
+	}
+
+	j := job{
+		name:      "setstate",
+		prio:      prioUser,
+		key:       key,
+		requestID: ns.GetRequestId(),
+		keying:    isKeyingRequest(&ns),
+		run:       func(j job) { r.handleCatRequest(&ns, j.coalesced, echo) },
+		cancel:    func(j job) { r.rejectCatRequest(&ns, sbRadio.Ack{}, j.coalesced) },
+	}
+
+	if echo != nil {
+		// echo the requested value before the rig is busy with it.
+		// The echo doesn't access the rig and is therefore executed
+		// ahead of the queued requests.
+		r.sched.push(job{
+			name: "echo",
+			prio: prioSafety,
+			run:  func(job) { r.echoRequest(echo) },
+		})
+	}
+
+	r.sched.push(j)
+}
+
+// queueSafetyRequest puts the fields of a SetState which unkey the
+// transmitter or turn the radio off ahead of all other jobs. Queued
+// requests which would key the transmitter or turn the radio on again
+// are cancelled. The remaining fields of the request keep their place
+// behind the requests which have been queued before. The request is
+// acknowledged once all of its fields have been handled.
+func (r *radio) queueSafetyRequest(ns *sbRadio.SetState) {
+
+	for _, c := range r.sched.cancelKeying() {
+		if c.cancel != nil {
+			c := c
+			r.sched.push(job{
+				name: "cancel",
+				prio: prioSafety,
+				run:  func(job) { c.cancel(c) },
+			})
+		}
+	}
+
+	rest := remainingRequest(ns)
+
+	// results of the safety fields, merged into the Ack of the rest
+	var safeAck sbRadio.Ack
+
+	r.sched.push(job{
+		name:      "setstate",
+		prio:      prioSafety,
+		requestID: ns.GetRequestId(),
+		run: func(job) {
+			safeAck = r.execCatRequest(safetyRequest(ns, r.state.CurrentVfo))
+			if err := r.sendState(); err != nil {
+				log.Println(err)
+			}
+			if rest == nil {
+				if err := r.sendAck(safeAck); err != nil {
+					log.Println(err)
+				}
+			}
+		},
+	})
+
+	if rest == nil {
+		return
+	}
+
+	r.sched.push(job{
+		name:      "setstate",
+		prio:      prioUser,
+		requestID: ns.GetRequestId(),
+		keying:    isKeyingRequest(rest),
+		run: func(job) {
+			ack := r.execCatRequest(rest)
+			ack.Applied = append(safeAck.Applied, ack.Applied...)
+			ack.Rejected = append(safeAck.Rejected, ack.Rejected...)
+			if err := r.sendState(); err != nil {
+				log.Println(err)
+			}
+			if err := r.sendAck(ack); err != nil {
+				log.Println(err)
+			}
+		},
+		cancel: func(j job) { r.rejectCatRequest(rest, safeAck, nil) },
+	})
+}
+
+// rejectCatRequest rejects all fields of a cancelled SetState request and
+// adds them to the results in ack.
+func (r *radio) rejectCatRequest(ns *sbRadio.SetState, ack sbRadio.Ack, coalesced []string) {
+
+	ack.UserId = ns.GetUserId()
+	ack.RequestId = ns.GetRequestId()
+	for _, field := range requestedFields(ns) {
+		r.addResult(&ack, field, errCancelled)
+	}
+	if err := r.sendAck(ack); err != nil {
+		log.Println(err)
+	}
+	for _, requestID := range coalesced {
+		ack.RequestId = requestID
+		if err := r.sendAck(ack); err != nil {
+			log.Println(err)
+		}
+	}
+}
+
+// coalesceKey returns the key under which a pending request can be
+// replaced by a newer one. An empty key is returned if the request
+// can not be coalesced.
+func coalesceKey(ns *sbRadio.SetState) string {
+	if ns.Md == nil || ns.Vfo == nil {
+		return ""
+	}
+	fields := requestedFields(ns)
+	if len(fields) != 1 || !utils.StringInSlice(fields[0], coalescableFields) {
+		return ""
+	}
+	return ns.GetUserId() + "/" + fields[0]
+}
+
+// handleCatRequest executes a SetState request. The requests which have
+// been coalesced into it receive the same Ack. If the requested value has
+// been echoed, but the rig didn't accept it, the clients are corrected.
+func (r *radio) handleCatRequest(ns *sbRadio.SetState, coalesced []string, echo *sbRadio.SetState) {
+	ack := r.execCatRequest(ns)
+	if err := r.sendState(); err != nil {
+		log.Println(err)
+	}
+	if echo != nil {
+		r.correctEcho(echo)
+	}
+	if err := r.sendAck(ack); err != nil {
+		log.Println(err)
+	}
+	for _, requestID := range coalesced {
+		ack.RequestId = requestID
+		if err := r.sendAck(ack); err != nil {
+			log.Println(err)
+		}
+	}
+}
+
+// echoRequest publishes the requested value before the request has been
+// executed on the rig, so that the displays of the clients follow the
+// tuning knob smoothly. Requests which will be rejected are not echoed.
+func (r *radio) echoRequest(ns *sbRadio.SetState) {
+
+	if !r.conn.Connected() || !r.state.RadioOn {
+		return
+	}
+
+	if err := r.lockedBy(ns.GetUserId()); err != nil {
+		return
+	}
+
+	field := requestedFields(ns)[0]
+
+	// don't show a tx frequency which isn't permitted
+	if r.state.Ptt && !r.state.Vfo.Split.GetEnabled() {
+		freq := r.state.Vfo.Frequency + float64(r.state.Vfo.Xit)
+		switch field {
+		case "frequency":
+			freq = ns.Vfo.GetFrequency() + float64(r.state.Vfo.Xit)
+		case "xit":
+			freq = r.state.Vfo.Frequency + float64(ns.Vfo.GetXit())
+		}
+		if err := r.checkTx(ns.GetUserId(), freq, r.state.Vfo.Mode,
+			r.state.Vfo.Levels["RFPOWER"]); err != nil {
+			return
+		}
+	}
+
+	r.sendEcho(field, ns.Vfo)
+}
+
+// correctEcho publishes the actual value of an echoed field if it
+// differs from the requested value, e.g. because the request has been
+// rejected or the rig has rounded the value.
+func (r *radio) correctEcho(ns *sbRadio.SetState) {
+
+	field := requestedFields(ns)[0]
+	requested, actual := ns.GetVfo(), r.state.GetVfo()
+
+	switch field {
+	case "frequency":
+		if requested.GetFrequency() == actual.GetFrequency() {
+			return
+		}
+	case "rit":
+		if requested.GetRit() == actual.GetRit() {
+			return
+		}
+	case "xit":
+		if requested.GetXit() == actual.GetXit() {
+			return
+		}
+	}
+
+	if !r.conn.Connected() {
+		return
+	}
+
+	r.sendEcho(field, actual)
+}
+
+// sendEcho publishes a coalescable field of the VFO as a StateDelta on
+// the (non retained) echo topic. The echo doesn't increment the state
+// version; clients only apply it on top of the current version.
+func (r *radio) sendEcho(field string, v *sbRadio.Vfo) {
+
+	delta := sbRadio.StateDelta{
+		Version:     r.state.Version,
+		BaseVersion: r.state.Version,
+		Changed:     []string{field},
+		State: &sbRadio.State{
+			Vfo: &sbRadio.Vfo{
+				Frequency: v.GetFrequency(),
+				Rit:       v.GetRit(),
+				Xit:       v.GetXit(),
+			},
+		},
+	}
+
+	data, err := delta.Marshal()
+	if err != nil {
+		log.Println(err)
+		return
+	}
+
+	r.settings.ToWireCh <- comms.IOMsg{
+		Topic: r.settings.EchoTopic,
+		Data:  data,
+	}
+}
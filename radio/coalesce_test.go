@@ -0,0 +1,226 @@
+package radio
+
+import (
+	"reflect"
+	"testing"
+
+	sbRadio "github.com/dh1tw/remoteRadio/sb_radio"
+)
+
+func TestCoalesceKey(t *testing.T) {
+
+	tests := []struct {
+		name string
+		ns   sbRadio.SetState
+		want string
+	}{
+		{"frequency", sbRadio.SetState{UserId: "a", Md: &sbRadio.MetaData{HasFrequency: true}, Vfo: &sbRadio.Vfo{}}, "a/frequency"},
+		{"rit", sbRadio.SetState{UserId: "a", Md: &sbRadio.MetaData{HasRit: true}, Vfo: &sbRadio.Vfo{}}, "a/rit"},
+		{"xit", sbRadio.SetState{UserId: "b", Md: &sbRadio.MetaData{HasXit: true}, Vfo: &sbRadio.Vfo{}}, "b/xit"},
+		{"several fields", sbRadio.SetState{UserId: "a", Md: &sbRadio.MetaData{HasFrequency: true, HasMode: true}, Vfo: &sbRadio.Vfo{}}, ""},
+		{"not coalescable", sbRadio.SetState{UserId: "a", Md: &sbRadio.MetaData{HasMode: true}, Vfo: &sbRadio.Vfo{}}, ""},
+		{"ptt", sbRadio.SetState{UserId: "a", Md: &sbRadio.MetaData{HasPtt: true}, Vfo: &sbRadio.Vfo{}}, ""},
+		{"no metadata", sbRadio.SetState{UserId: "a", Vfo: &sbRadio.Vfo{}}, ""},
+		{"no vfo", sbRadio.SetState{UserId: "a", Md: &sbRadio.MetaData{HasFrequency: true}}, ""},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := coalesceKey(&tc.ns); got != tc.want {
+				t.Errorf("coalesceKey() = %q, want %q", got, tc.want)
+			}
+		})
+	}
+}
+
+func queueFrequency(t *testing.T, r *radio, userID, requestID string, freq float64) {
+	t.Helper()
+	ns := sbRadio.SetState{
+		UserId:     userID,
+		RequestId:  requestID,
+		CurrentVfo: r.state.CurrentVfo,
+		Md:         &sbRadio.MetaData{HasFrequency: true},
+		Vfo:        &sbRadio.Vfo{Frequency: freq},
+	}
+	data, err := ns.Marshal()
+	if err != nil {
+		t.Fatal(err)
+	}
+	r.queueCatRequest(data)
+}
+
+// run executes all queued jobs.
+func run(r *radio) {
+	for {
+		j, ok := r.sched.pop()
+		if !ok {
+			return
+		}
+		r.sched.exec(j)
+	}
+}
+
+func TestCoalescing(t *testing.T) {
+	r := newTestRadio(t, RadioSettings{})
+
+	queueFrequency(t, r, "a", "1", 7001000)
+	queueFrequency(t, r, "a", "2", 7002000)
+	queueFrequency(t, r, "b", "3", 7003000)
+	queueFrequency(t, r, "a", "4", 7004000)
+
+	if n := len(r.sched.queues[prioUser]); n != 2 {
+		t.Fatalf("%d queued requests, want 2", n)
+	}
+
+	run(r)
+
+	// the coalesced request of a is executed after the one of b
+	if r.state.Vfo.Frequency != 7004000 {
+		t.Errorf("frequency = %v, want 7004000", r.state.Vfo.Frequency)
+	}
+
+	acks := map[string]bool{}
+	for _, msg := range drain(r) {
+		if msg.Topic != r.settings.AckTopic {
+			continue
+		}
+		ack := sbRadio.Ack{}
+		if err := ack.Unmarshal(msg.Data); err != nil {
+			t.Fatal(err)
+		}
+		acks[ack.GetRequestId()] = true
+	}
+	for _, id := range []string{"1", "2", "3", "4"} {
+		if !acks[id] {
+			t.Errorf("no ack for request %s", id)
+		}
+	}
+}
+
+// TestCoalescingOrder verifies that the requests of a user are executed
+// in the order they have been sent, even if some have been coalesced.
+func TestCoalescingOrder(t *testing.T) {
+	r := newTestRadio(t, RadioSettings{})
+
+	queueFrequency(t, r, "a", "1", 7001000)
+	queueRequest(t, r, sbRadio.SetState{UserId: "a", RequestId: "2",
+		Md: &sbRadio.MetaData{HasMode: true}, Vfo: &sbRadio.Vfo{Mode: "LSB"}})
+	queueFrequency(t, r, "a", "3", 7002000)
+
+	order := []string{}
+	for {
+		j, ok := r.sched.pop()
+		if !ok {
+			break
+		}
+		if j.name == "setstate" {
+			order = append(order, j.requestID)
+		}
+		r.sched.exec(j)
+	}
+
+	if want := []string{"2", "3"}; !reflect.DeepEqual(order, want) {
+		t.Errorf("executed %v, want %v", order, want)
+	}
+	if r.state.Vfo.Frequency != 7002000 || r.state.Vfo.Mode != "LSB" {
+		t.Errorf("vfo = %.0f %s, want 7002000 LSB", r.state.Vfo.Frequency, r.state.Vfo.Mode)
+	}
+}
+
+// echoes returns the frequencies which have been echoed.
+func echoes(t *testing.T, r *radio) []float64 {
+	freqs := []float64{}
+	for _, msg := range drain(r) {
+		if msg.Topic == r.settings.CatResponseTopic {
+			t.Error("echo published on the state topic")
+		}
+		if msg.Topic != r.settings.EchoTopic {
+			continue
+		}
+		echo := sbRadio.StateDelta{}
+		if err := echo.Unmarshal(msg.Data); err != nil {
+			t.Fatal(err)
+		}
+		if echo.GetVersion() != echo.GetBaseVersion() {
+			t.Error("echo changes the state version")
+		}
+		freqs = append(freqs, echo.GetState().GetVfo().GetFrequency())
+	}
+	return freqs
+}
+
+func TestEcho(t *testing.T) {
+
+	bp := testBandPlan()
+
+	tests := []struct {
+		name   string
+		rs     RadioSettings
+		setup  func(r *radio)
+		userID string
+		freq   float64
+		want   []float64 // echo, correction
+	}{
+		{
+			name:   "applied",
+			userID: "dh1tw",
+			freq:   14100000,
+			want:   []float64{14100000},
+		},
+		{
+			name:   "rejected by the rig",
+			userID: "dh1tw",
+			freq:   -1,
+			want:   []float64{-1, 14250000},
+		},
+		{
+			name:   "locked by somebody else",
+			setup:  func(r *radio) { r.updateLock("other", true, false) },
+			userID: "dh1tw",
+			freq:   14100000,
+			want:   []float64{14250000},
+		},
+		{
+			name: "not permitted while transmitting",
+			rs:   RadioSettings{BandPlan: &bp},
+			setup: func(r *radio) {
+				setState(t, r, sbRadio.SetState{UserId: "dh1tw", Md: &sbRadio.MetaData{HasPtt: true}, Ptt: true})
+				drain(r)
+			},
+			userID: "dh1tw",
+			freq:   10120000,
+			want:   []float64{14250000},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			r := newTestRadio(t, tc.rs)
+			if tc.setup != nil {
+				tc.setup(r)
+			}
+
+			queueFrequency(t, r, tc.userID, "1", tc.freq)
+
+			// the echo is executed ahead of the request
+			j, _ := r.sched.pop()
+			if j.name != "echo" {
+				t.Fatalf("first job = %s, want echo", j.name)
+			}
+			r.sched.exec(j)
+			got := echoes(t, r)
+
+			run(r)
+			got = append(got, echoes(t, r)...)
+
+			if len(got) != len(tc.want) {
+				t.Fatalf("echoed %v, want %v", got, tc.want)
+			}
+			for i := range got {
+				if got[i] != tc.want[i] {
+					t.Errorf("echoed %v, want %v", got, tc.want)
+				}
+			}
+		})
+	}
+}
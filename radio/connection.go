@@ -0,0 +1,129 @@
+package radio
+
+import (
+	"log"
+	"strings"
+)
+
+// transportErrors are the (Hamlib) error messages which indicate that the
+// communication with the rig has failed. Other errors (e.g. a level which
+// is not available) are answers of the rig and don't require a reconnect.
+var transportErrors = []string{
+	"timed out",
+	"io error",
+	"protocol error",
+	"communication bus error",
+	"communication bus collision",
+}
+
+// isTransportError returns true if err indicates that the communication
+// with the rig has failed.
+func isTransportError(err error) bool {
+	if err == nil {
+		return false
+	}
+	msg := strings.ToLower(err.Error())
+	for _, e := range transportErrors {
+		if strings.Contains(msg, e) {
+			return true
+		}
+	}
+	return false
+}
+
+// connect opens the rig, queries its capabilities & state and
+// publishes them.
+func (r *radio) connect() error {
+
+	rig, err := newRig(*r.settings)
+	if err != nil {
+		return err
+	}
+
+	if err := rig.Open(); err != nil {
+		rig.Close()
+		return err
+	}
+
+	r.rig = rig
+	r.conn.SetConnected()
+	r.meterErrors = make(map[string]bool)
+
+	r.caps = r.rig.Caps()
+	r.logUnsupportedMeters()
+
+	// publish the radio's capabilities
+	if err := r.sendCaps(); err != nil {
+		log.Println(err)
+	}
+
+	// check if the radio is turned on and query its state
+	rigOn, err := r.rig.GetPowerStat()
+	if err != nil {
+		log.Println(err)
+		// we should check if the rig might no have the
+		// ability to be turn on/off through CapsTopic
+
+		// let's hope for the best and query it
+		if err := r.queryVfo(); err != nil {
+			log.Println(err)
+		}
+	} else {
+		// no error and the rig is on so we can query it
+		if rigOn {
+			if err := r.queryVfo(); err != nil {
+				log.Println(err)
+			}
+		} else {
+			r.state.RadioOn = false
+		}
+	}
+
+	err = r.rig.SetConf("fast_commands_token", "1")
+	if err != nil {
+		log.Println(err)
+	}
+
+	// publish the radio's state
+	if err := r.sendSnapshot(); err != nil {
+		log.Println(err)
+	}
+
+	return nil
+}
+
+// disconnect closes the rig after it has been lost or could not be
+// opened. Since nobody can unkey the transmitter remotely anymore, it
+// tries to unkey it before.
+func (r *radio) disconnect(err error) {
+
+	if r.conn.Connected() {
+		if r.morse != nil {
+			r.abortMorse("rig disconnected")
+		}
+		if r.state.Ptt {
+			if err := r.rig.SetPtt(r.state.CurrentVfo, false); err != nil {
+				log.Println("unable to unkey the transmitter:", err)
+			}
+			r.state.Ptt = false
+			r.state.PttOffReason = "rig disconnected"
+		}
+		r.rig.Close()
+		r.pttOwner = ""
+		r.morse = nil
+		if err := r.sendState(); err != nil {
+			log.Println(err)
+		}
+	}
+	r.conn.SetDisconnected(err)
+}
+
+// checkRigError counts the consecutive transport errors of the polls.
+// After connection.MaxErrors the rig is considered lost. Any other error
+// is an answer of the rig and proves that the connection is still alive.
+func (r *radio) checkRigError(err error) {
+	if !isTransportError(err) {
+		err = nil
+	}
+	r.conn.Check(err)
+}
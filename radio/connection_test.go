@@ -0,0 +1,110 @@
+package radio
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/dh1tw/remoteRadio/connection"
+	sbRadio "github.com/dh1tw/remoteRadio/sb_radio"
+)
+
+func TestIsTransportError(t *testing.T) {
+
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{"nil", nil, false},
+		{"timeout", errors.New("Communication timed out"), true},
+		{"io error", errors.New("IO error"), true},
+		{"protocol error", errors.New("Protocol error"), true},
+		{"bus error", errors.New("Communication bus error"), true},
+		{"bus collision", errors.New("Communication bus collision"), true},
+		{"feature not available", errors.New("Feature not available"), false},
+		{"invalid parameter", errors.New("Invalid parameter"), false},
+		{"simulator timeout", errSimTimeout, true},
+		{"simulator error", errSimError, false},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := isTransportError(tc.err); got != tc.want {
+				t.Errorf("isTransportError(%v) = %v, want %v", tc.err, got, tc.want)
+			}
+		})
+	}
+}
+
+// TestMeterErrors verifies that only transport errors of the meter
+// polls count towards a reconnect.
+func TestMeterErrors(t *testing.T) {
+
+	tests := []struct {
+		name     string
+		settings SimulatorSettings
+		level    string // additional level announced in the caps
+		wantLost bool
+	}{
+		{"healthy", SimulatorSettings{}, "", false},
+		{"meter not available", SimulatorSettings{}, "FOO_METER", false},
+		{"rig error", SimulatorSettings{ErrorRate: 1}, "", false},
+		{"timeout", SimulatorSettings{TimeoutRate: 1, Timeout: time.Millisecond}, "", true},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			r := newTestRadio(t, RadioSettings{Meters: MeterSettings{Rx: []string{"STRENGTH"}}})
+			if tc.level != "" {
+				r.caps.GetLevels = append(r.caps.GetLevels, &sbRadio.Value{Name: tc.level})
+				r.settings.Meters.Rx = append(r.settings.Meters.Rx, tc.level)
+			}
+			r.rig.(*simRig).settings = tc.settings
+
+			for i := 0; i < connection.MaxErrors; i++ {
+				r.checkRigError(r.updateMeter())
+			}
+			if r.conn.Lost() != tc.wantLost {
+				t.Errorf("rig lost = %v, want %v", r.conn.Lost(), tc.wantLost)
+			}
+		})
+	}
+}
+
+// TestDisconnectUnkeys verifies that the transmitter is unkeyed when the
+// rig is disconnected.
+func TestDisconnectUnkeys(t *testing.T) {
+	r := newTestRadio(t, RadioSettings{})
+
+	setState(t, r, sbRadio.SetState{UserId: "dh1tw", Md: &sbRadio.MetaData{HasPtt: true}, Ptt: true})
+	if !r.state.Ptt {
+		t.Fatal("unable to key the transmitter")
+	}
+	rig := r.rig
+	drain(r)
+
+	r.disconnect(errors.New("test"))
+
+	if ptt, _ := rig.GetPtt(r.state.CurrentVfo); ptt {
+		t.Error("rig still keyed")
+	}
+	if r.state.Ptt || r.pttOwner != "" {
+		t.Errorf("state ptt = %v, ptt owner = %q", r.state.Ptt, r.pttOwner)
+	}
+
+	published := false
+	for _, msg := range drain(r) {
+		if msg.Topic != r.settings.DeltaTopic {
+			continue
+		}
+		delta := sbRadio.StateDelta{}
+		if err := delta.Unmarshal(msg.Data); err != nil {
+			t.Fatal(err)
+		}
+		published = delta.GetState().GetPttOffReason() != ""
+	}
+	if !published {
+		t.Error("unkeying not published")
+	}
+}
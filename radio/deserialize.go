@@ -2,187 +2,421 @@ package radio
 
 import (
 	"errors"
+	"fmt"
 	"log"
 	"reflect"
 
 	"time"
 
-	hl "github.com/dh1tw/goHamlib"
 	sbRadio "github.com/dh1tw/remoteRadio/sb_radio"
 	"github.com/dh1tw/remoteRadio/utils"
 )
 
-func (r *radio) deserializeCatRequest(request []byte) error {
+// deserializeCatRequest applies a SetState request to the rig. The
+// returned Ack lists which of the requested fields have been applied
+// and which have been rejected.
+func (r *radio) deserializeCatRequest(request []byte) (sbRadio.Ack, error) {
 
 	ns := sbRadio.SetState{}
 	if err := ns.Unmarshal(request); err != nil {
-		return err
+		return sbRadio.Ack{}, err
+	}
+
+	return r.execCatRequest(&ns), nil
+}
+
+// execCatRequest applies a SetState request to the rig.
+func (r *radio) execCatRequest(ns *sbRadio.SetState) sbRadio.Ack {
+
+	ack := sbRadio.Ack{
+		RequestId: ns.GetRequestId(),
+		UserId:    ns.GetUserId(),
+	}
+
+	// protect against malformed requests
+	if ns.Md == nil {
+		ns.Md = &sbRadio.MetaData{}
+	}
+	if ns.Vfo == nil {
+		ns.Vfo = &sbRadio.Vfo{}
+	}
+
+	if !r.conn.Connected() {
+		for _, field := range requestedFields(ns) {
+			r.addResult(&ack, field, errRigDisconnected)
+		}
+		return ack
+	}
+
+	// only the lock holder may change the radio's state, but anybody
+	// may unkey the transmitter or turn the radio off
+	if err := r.checkLock(ns.GetUserId()); err != nil {
+		safe := safetyRequest(ns, r.state.CurrentVfo)
+		safeFields := requestedFields(safe)
+		for _, field := range requestedFields(ns) {
+			if !utils.StringInSlice(field, safeFields) {
+				r.addResult(&ack, field, err)
+			}
+		}
+		r.applySetState(safe, &ack)
+		return ack
+	}
+
+	if ns.GetAtomic() {
+		r.applyAtomic(ns, &ack)
+		return ack
 	}
 
+	r.applySetState(ns, &ack)
+
+	return ack
+}
+
+// applySetState applies the requested fields one after the other. The
+// result of each field is recorded in the Ack.
+func (r *radio) applySetState(ns *sbRadio.SetState, ack *sbRadio.Ack) {
+
 	if ns.Md.HasRadioOn {
+		var err error
 		if ns.GetRadioOn() != r.state.RadioOn {
-			if err := r.updatePowerOn(ns.GetRadioOn()); err != nil {
-				log.Println(err)
-			} else {
-				if r.state.RadioOn {
-					r.queryVfo()
-				}
+			err = r.updatePowerOn(ns.GetRadioOn())
+			if err == nil && r.state.RadioOn {
+				r.queryVfo()
 			}
 		}
+		r.addResult(ack, "radio_on", err)
 	}
 
 	if r.state.RadioOn {
 
 		if ns.CurrentVfo != r.state.CurrentVfo {
-			if err := r.updateCurrentVfo(ns.CurrentVfo); err != nil {
-				log.Println(err)
+			err := r.updateCurrentVfo(ns.CurrentVfo)
+			if err == nil {
+				err = r.enforceTxState(ns.GetUserId())
 			}
+			r.addResult(ack, "current_vfo", err)
 		}
 
 		if len(ns.VfoOperations) > 0 {
-			if err := r.execVfoOperations(ns.GetVfoOperations()); err != nil {
-				log.Println(err)
+			err := r.execVfoOperations(ns.GetVfoOperations())
+			if err == nil {
+				err = r.enforceTxState(ns.GetUserId())
 			}
+			r.addResult(ack, "vfo_operations", err)
 		}
 
 		if ns.Md.HasFrequency {
+			var err error
 			if ns.Vfo.GetFrequency() != r.state.Vfo.Frequency {
-				if err := r.updateFrequency(ns.Vfo.GetFrequency()); err != nil {
-					log.Println(err)
+				// don't allow to leave the permitted segment while transmitting
+				if r.state.Ptt && !r.state.Vfo.Split.GetEnabled() {
+					err = r.checkTx(ns.GetUserId(),
+						ns.Vfo.GetFrequency()+float64(r.state.Vfo.Xit),
+						r.state.Vfo.Mode, r.state.Vfo.Levels["RFPOWER"])
+				}
+				if err == nil {
+					err = r.updateFrequency(ns.Vfo.GetFrequency())
 				}
 			}
+			r.addResult(ack, "frequency", err)
 		}
 
 		if ns.Md.HasMode {
+			var err error
 			if ns.Vfo.GetMode() != r.state.Vfo.Mode {
-				if err := r.updateMode(ns.Vfo.GetMode(), ns.Vfo.GetPbWidth()); err != nil {
-					log.Println(err)
+				if r.state.Ptt && !r.state.Vfo.Split.GetEnabled() {
+					freq, _ := r.txFrequency()
+					err = r.checkTx(ns.GetUserId(), freq, ns.Vfo.GetMode(),
+						r.state.Vfo.Levels["RFPOWER"])
+				}
+				if err == nil {
+					err = r.updateMode(ns.Vfo.GetMode(), ns.Vfo.GetPbWidth())
 				}
 			}
+			r.addResult(ack, "mode", err)
 		}
 
 		if ns.Md.HasPbWidth {
+			var err error
 			if ns.Vfo.GetPbWidth() != r.state.Vfo.PbWidth {
-				if err := r.updatePbWidth(ns.Vfo.GetPbWidth()); err != nil {
-					log.Println(err)
-				}
+				err = r.updatePbWidth(ns.Vfo.GetPbWidth())
 			}
+			r.addResult(ack, "pb_width", err)
 		}
 
 		if ns.Md.HasAnt {
+			var err error
 			if ns.Vfo.GetAnt() != r.state.Vfo.Ant {
-				if err := r.updateAntenna(ns.Vfo.GetAnt()); err != nil {
-					log.Println(err)
-				}
+				err = r.updateAntenna(ns.Vfo.GetAnt())
 			}
+			r.addResult(ack, "ant", err)
 		}
 
 		if ns.Md.HasRit {
+			var err error
 			if ns.Vfo.GetRit() != r.state.Vfo.Rit {
-				if err := r.updateRit(ns.Vfo.GetRit()); err != nil {
-					log.Println(err)
-				}
+				err = r.updateRit(ns.Vfo.GetRit())
 			}
+			r.addResult(ack, "rit", err)
 		}
 
 		if ns.Md.HasXit {
+			var err error
 			if ns.Vfo.GetXit() != r.state.Vfo.Xit {
-				if err := r.updateXit(ns.Vfo.GetXit()); err != nil {
-					log.Println(err)
+				if r.state.Ptt && !r.state.Vfo.Split.GetEnabled() {
+					err = r.checkTx(ns.GetUserId(),
+						r.state.Vfo.Frequency+float64(ns.Vfo.GetXit()),
+						r.state.Vfo.Mode, r.state.Vfo.Levels["RFPOWER"])
+				}
+				if err == nil {
+					err = r.updateXit(ns.Vfo.GetXit())
 				}
 			}
+			r.addResult(ack, "xit", err)
 		}
 
 		if ns.Md.HasSplit {
 			if ns.Vfo.Split != nil {
+				var err error
 				if !reflect.DeepEqual(ns.Vfo.Split, r.state.Vfo.Split) {
-					if err := r.updateSplit(ns.Vfo.Split); err != nil {
-						log.Println(err)
+					if r.state.Ptt {
+						// the tx frequency may only be known once
+						// the split has been applied
+						if freq, mode := r.splitTxFrequency(ns.Vfo.Split); freq > 0 {
+							err = r.checkTx(ns.GetUserId(), freq, mode,
+								r.state.Vfo.Levels["RFPOWER"])
+						}
+					}
+					if err == nil {
+						err = r.updateSplit(ns.Vfo.Split)
+					}
+					if err == nil {
+						err = r.enforceTxState(ns.GetUserId())
 					}
 				}
+				r.addResult(ack, "split", err)
 			}
 		}
 
 		if ns.Md.HasTuningStep {
+			var err error
 			if ns.Vfo.GetTuningStep() != r.state.Vfo.TuningStep {
-				if err := r.updateTs(ns.Vfo.GetTuningStep()); err != nil {
-					log.Println(err)
-				}
+				err = r.updateTs(ns.Vfo.GetTuningStep())
+			}
+			r.addResult(ack, "tuning_step", err)
+		}
+
+		if ns.Md.HasCtcssTone {
+			var err error
+			if ns.Vfo.GetCtcssTone() != r.state.Vfo.CtcssTone {
+				err = r.updateCtcssTone(ns.Vfo.GetCtcssTone())
+			}
+			r.addResult(ack, "ctcss_tone", err)
+		}
+
+		if ns.Md.HasCtcssSql {
+			var err error
+			if ns.Vfo.GetCtcssSql() != r.state.Vfo.CtcssSql {
+				err = r.updateCtcssSql(ns.Vfo.GetCtcssSql())
+			}
+			r.addResult(ack, "ctcss_sql", err)
+		}
+
+		if ns.Md.HasDcsCode {
+			var err error
+			if ns.Vfo.GetDcsCode() != r.state.Vfo.DcsCode {
+				err = r.updateDcsCode(ns.Vfo.GetDcsCode())
+			}
+			r.addResult(ack, "dcs_code", err)
+		}
+
+		if ns.Md.HasDcsSql {
+			var err error
+			if ns.Vfo.GetDcsSql() != r.state.Vfo.DcsSql {
+				err = r.updateDcsSql(ns.Vfo.GetDcsSql())
 			}
+			r.addResult(ack, "dcs_sql", err)
+		}
+
+		if ns.Md.HasRptrShift {
+			var err error
+			if ns.Vfo.GetRptrShift() != r.state.Vfo.RptrShift {
+				err = r.updateRptrShift(ns.Vfo.GetRptrShift())
+			}
+			r.addResult(ack, "rptr_shift", err)
+		}
+
+		if ns.Md.HasRptrOffset {
+			var err error
+			if ns.Vfo.GetRptrOffset() != r.state.Vfo.RptrOffset {
+				err = r.updateRptrOffset(ns.Vfo.GetRptrOffset())
+			}
+			r.addResult(ack, "rptr_offset", err)
 		}
 
 		if ns.Md.HasFunctions {
 			if ns.Vfo.Functions != nil {
+				var err error
 				if !reflect.DeepEqual(ns.Vfo.Functions, r.state.Vfo.Functions) {
-					if err := r.updateFunctions(ns.Vfo.GetFunctions()); err != nil {
-						log.Println(err)
-					}
+					err = r.updateFunctions(ns.Vfo.GetFunctions())
 				}
+				r.addResult(ack, "functions", err)
 			}
 		}
 
 		if ns.Md.HasLevels {
-			if ns.Vfo.Levels != nil {
-				if !reflect.DeepEqual(ns.Vfo.Levels, r.state.Vfo.Levels) {
-					if err := r.updateLevels(ns.Vfo.GetLevels()); err != nil {
-						log.Println(err)
+			// invalid levels are rejected individually
+			levels := r.validateLevels(ack, ns.Vfo.GetLevels())
+			if len(levels) > 0 {
+				var err error
+				if !reflect.DeepEqual(levels, r.state.Vfo.Levels) {
+					if power, ok := levels["RFPOWER"]; ok &&
+						power != r.state.Vfo.Levels["RFPOWER"] {
+						freq, mode := r.txFrequency()
+						err = r.checkTx(ns.GetUserId(), freq, mode, power)
+					}
+					if err == nil {
+						err = r.updateLevels(levels)
 					}
 				}
+				r.addResult(ack, "levels", err)
 			}
 		}
 
 		if ns.Md.HasParameters {
-			if ns.Vfo.Parameters != nil {
-				if !reflect.DeepEqual(ns.Vfo.Parameters, r.state.Vfo.Parameters) {
-					if err := r.updateParams(ns.Vfo.GetParameters()); err != nil {
-						log.Println(err)
-					}
+			params := r.validateParams(ack, ns.Vfo.GetParameters())
+			if len(params) > 0 {
+				var err error
+				if !reflect.DeepEqual(params, r.state.Vfo.Parameters) {
+					err = r.updateParams(params)
 				}
+				r.addResult(ack, "parameters", err)
 			}
 		}
+	} else {
+		// VFO related settings can't be applied while the radio is off
+		for _, field := range requestedVfoFields(ns.Md) {
+			r.addResult(ack, field, errRadioOff)
+		}
 	}
 
 	if ns.Md.HasPtt {
+		var err error
 		if ns.GetPtt() != r.state.Ptt {
-			if err := r.updatePtt(ns.GetPtt()); err != nil {
-				log.Println(err)
+			if ns.GetPtt() {
+				err = r.checkTxState(ns.GetUserId())
+			}
+			if err == nil {
+				err = r.updatePtt(ns.GetPtt())
+			}
+			if err == nil {
+				if r.state.Ptt {
+					r.keyedBy(ns.GetUserId())
+				} else {
+					r.pttOwner = ""
+				}
 			}
 		}
+		r.addResult(ack, "ptt", err)
 	}
 
 	if ns.Md.HasPollingInterval {
 		if ns.GetPollingInterval() != r.state.PollingInterval {
 			if ns.GetPollingInterval() > 0 {
 				newPollingInterval := time.Millisecond * time.Duration(ns.GetPollingInterval())
-				r.pollingTicker.Stop()
-				r.pollingTicker = time.NewTicker(newPollingInterval)
+				r.setPollingInterval(newPollingInterval)
 				r.state.PollingInterval = ns.GetPollingInterval()
 			} else {
-				r.pollingTicker.Stop()
+				r.setPollingInterval(0)
 				r.state.PollingInterval = 0
 			}
 		}
+		r.addResult(ack, "polling_interval", nil)
 	}
+}
 
-	return nil
+var errRadioOff = errors.New("radio is turned off")
+var errRigDisconnected = errors.New("rig disconnected")
+
+// addResult records the outcome of setting a particular field. Failures
+// are published immediately on the error topic.
+func (r *radio) addResult(ack *sbRadio.Ack, field string, err error) {
+	if err == nil {
+		ack.Applied = append(ack.Applied, field)
+		return
+	}
+	e := r.newError(field, err, ack.GetUserId(), ack.GetRequestId())
+	ack.Rejected = append(ack.Rejected, &e)
+	if err := r.sendError(e); err != nil {
+		log.Println(err)
+	}
+}
+
+// requestedFields returns the names of all fields which a
+// SetState asks to change.
+func requestedFields(ns *sbRadio.SetState) []string {
+	fields := []string{}
+	if ns.Md.HasRadioOn {
+		fields = append(fields, "radio_on")
+	}
+	if len(ns.VfoOperations) > 0 {
+		fields = append(fields, "vfo_operations")
+	}
+	fields = append(fields, requestedVfoFields(ns.Md)...)
+	if ns.Md.HasPtt {
+		fields = append(fields, "ptt")
+	}
+	if ns.Md.HasPollingInterval {
+		fields = append(fields, "polling_interval")
+	}
+	return fields
+}
+
+// requestedVfoFields returns the names of the VFO related fields
+// which are flagged in the MetaData of a SetState.
+func requestedVfoFields(md *sbRadio.MetaData) []string {
+	fields := []string{}
+	flags := []struct {
+		set  bool
+		name string
+	}{
+		{md.HasFrequency, "frequency"},
+		{md.HasMode, "mode"},
+		{md.HasPbWidth, "pb_width"},
+		{md.HasAnt, "ant"},
+		{md.HasRit, "rit"},
+		{md.HasXit, "xit"},
+		{md.HasSplit, "split"},
+		{md.HasTuningStep, "tuning_step"},
+		{md.HasCtcssTone, "ctcss_tone"},
+		{md.HasCtcssSql, "ctcss_sql"},
+		{md.HasDcsCode, "dcs_code"},
+		{md.HasDcsSql, "dcs_sql"},
+		{md.HasRptrShift, "rptr_shift"},
+		{md.HasRptrOffset, "rptr_offset"},
+		{md.HasFunctions, "functions"},
+		{md.HasLevels, "levels"},
+		{md.HasParameters, "parameters"},
+	}
+	for _, f := range flags {
+		if f.set {
+			fields = append(fields, f.name)
+		}
+	}
+	return fields
 }
 
 func (r *radio) updateCurrentVfo(newVfo string) error {
-	if vfo, ok := hl.VfoValue[newVfo]; ok {
-		err := r.rig.SetVfo(vfo)
-		if err != nil {
-			return err
-		}
-		r.queryVfo()
-	} else {
-		return errors.New("unknown Vfo")
+	err := r.rig.SetVfo(newVfo)
+	if err != nil {
+		return err
 	}
+	r.queryVfo()
 	return nil
 }
 
 func (r *radio) updateFrequency(newFreq float64) error {
-	vfo, _ := hl.VfoValue[r.state.CurrentVfo]
-	err := r.rig.SetFreq(vfo, newFreq)
+	err := r.rig.SetFreq(r.state.CurrentVfo, newFreq)
 	if err != nil {
 		return err
 	}
@@ -191,13 +425,9 @@ func (r *radio) updateFrequency(newFreq float64) error {
 }
 
 func (r *radio) execVfoOperations(vfoOps []string) error {
-	vfo, _ := hl.VfoValue[r.state.CurrentVfo]
+	vfo := r.state.CurrentVfo
 	for _, v := range vfoOps {
-		vfoOpValue, ok := hl.OperationValue[v]
-		if !ok {
-			return errors.New("unknown VFO Operation")
-		}
-		err := r.rig.VfoOp(vfo, vfoOpValue)
+		err := r.rig.VfoOp(vfo, v)
 		if err != nil {
 			return err
 		}
@@ -214,28 +444,32 @@ func (r *radio) execVfoOperations(vfoOps []string) error {
 		}
 	}
 
+	// UP, DOWN, BAND_UP and BAND_DOWN change the frequency, which has
+	// to be known for checking the transmit privileges
+	freq, err := r.rig.GetFreq(r.state.CurrentVfo)
+	if err != nil {
+		return err
+	}
+	r.state.Vfo.Frequency = freq
+
 	return nil
 }
 
 func (r *radio) updateMode(newMode string, newPbWidth int32) error {
-	vfo, _ := hl.VfoValue[r.state.CurrentVfo]
+	vfo := r.state.CurrentVfo
 
 	pbWidth := int(r.state.Vfo.PbWidth)
 	if newPbWidth > 0 {
 		pbWidth = int(newPbWidth)
 	}
 
-	newModeValue, ok := hl.ModeValue[newMode]
-	if !ok {
-		return errors.New("unknown mode")
-	}
-	err := r.rig.SetMode(vfo, newModeValue, pbWidth)
+	err := r.rig.SetMode(vfo, newMode, pbWidth)
 	if err != nil {
-		pbNormal, err := r.rig.GetPbNormal(newModeValue)
+		pbNormal, err := r.rig.GetPbNormal(newMode)
 		if err != nil {
 			return err
 		}
-		err = r.rig.SetMode(vfo, newModeValue, pbNormal)
+		err = r.rig.SetMode(vfo, newMode, pbNormal)
 		if err != nil {
 			return err
 		}
@@ -251,16 +485,15 @@ func (r *radio) updateMode(newMode string, newPbWidth int32) error {
 		return err
 	}
 	r.state.Vfo.TuningStep = int32(ts)
-	r.state.Vfo.Mode = hl.ModeName[mode]
+	r.state.Vfo.Mode = mode
 	r.state.Vfo.PbWidth = int32(pbWidth)
 
 	return nil
 }
 
 func (r *radio) updatePbWidth(newPbWidth int32) error {
-	vfo, _ := hl.VfoValue[r.state.CurrentVfo]
-	modeValue := hl.ModeValue[r.state.Vfo.Mode]
-	err := r.rig.SetMode(vfo, modeValue, int(newPbWidth))
+	vfo := r.state.CurrentVfo
+	err := r.rig.SetMode(vfo, r.state.Vfo.Mode, int(newPbWidth))
 	if err != nil {
 		return err
 	}
@@ -276,14 +509,14 @@ func (r *radio) updatePbWidth(newPbWidth int32) error {
 	}
 
 	r.state.Vfo.TuningStep = int32(ts)
-	r.state.Vfo.Mode = hl.ModeName[mode]
+	r.state.Vfo.Mode = mode
 	r.state.Vfo.PbWidth = int32(pbWidth)
 
 	return nil
 }
 
 func (r *radio) updateAntenna(newAnt int32) error {
-	vfo, _ := hl.VfoValue[r.state.CurrentVfo]
+	vfo := r.state.CurrentVfo
 	err := r.rig.SetAnt(vfo, int(newAnt))
 	if err != nil {
 		return err
@@ -299,7 +532,7 @@ func (r *radio) updateAntenna(newAnt int32) error {
 }
 
 func (r *radio) updateRit(newRit int32) error {
-	vfo, _ := hl.VfoValue[r.state.CurrentVfo]
+	vfo := r.state.CurrentVfo
 	err := r.rig.SetRit(vfo, int(newRit))
 	if err != nil {
 		return err
@@ -327,7 +560,7 @@ func (r *radio) updateRit(newRit int32) error {
 }
 
 func (r *radio) updateXit(newXit int32) error {
-	vfo, _ := hl.VfoValue[r.state.CurrentVfo]
+	vfo := r.state.CurrentVfo
 	err := r.rig.SetXit(vfo, int(newXit))
 	if err != nil {
 		return err
@@ -350,9 +583,9 @@ func (r *radio) updateXit(newXit int32) error {
 }
 
 func (r *radio) updateSplit(newSplit *sbRadio.Split) error {
-	vfo, _ := hl.VfoValue[r.state.CurrentVfo]
+	vfo := r.state.CurrentVfo
 	if newSplit.GetEnabled() != r.state.Vfo.Split.Enabled {
-		err := r.rig.SetSplit(vfo, utils.Btoi(newSplit.GetEnabled()))
+		err := r.rig.SetSplit(vfo, newSplit.GetEnabled())
 		if err != nil {
 			return err
 		}
@@ -365,49 +598,35 @@ func (r *radio) updateSplit(newSplit *sbRadio.Split) error {
 		if newSplit.GetVfo() != r.state.Vfo.Split.Vfo &&
 			len(newSplit.GetVfo()) > 0 {
 
-			txVfo, ok := hl.VfoValue[newSplit.GetVfo()]
-			if !ok {
-				return errors.New("unknown split tx vfo")
-			}
-
-			err := r.rig.SetSplitVfo(vfo, utils.Btoi(newSplit.GetEnabled()), txVfo)
+			err := r.rig.SetSplitVfo(vfo, newSplit.GetEnabled(), newSplit.GetVfo())
 			if err != nil {
 				return err
 			}
 			r.state.Vfo.Split.Vfo = newSplit.GetVfo()
 		} else {
-			txVfo := hl.RIG_VFO_NONE
-			if vfo == hl.RIG_VFO_A {
-				txVfo = hl.RIG_VFO_B
-			} else {
-				txVfo = hl.RIG_VFO_A
+			txVfo := "VFOA"
+			if vfo == "VFOA" {
+				txVfo = "VFOB"
 			}
 
-			err := r.rig.SetSplitVfo(vfo, utils.Btoi(true), txVfo)
+			err := r.rig.SetSplitVfo(vfo, true, txVfo)
 			if err != nil {
 				return err
 			}
-			r.state.Vfo.Split.Vfo = hl.VfoName[txVfo]
+			r.state.Vfo.Split.Vfo = txVfo
 		}
 
+		txVfo := r.state.Vfo.Split.Vfo
+
 		if newSplit.GetFrequency() != r.state.Vfo.Split.Frequency &&
 			newSplit.GetFrequency() > 0 {
 
-			txVfo, ok := hl.VfoValue[r.state.Vfo.Split.Vfo]
-			if !ok {
-				return errors.New("unknown VFO")
-			}
-
 			err := r.rig.SetSplitFreq(txVfo, newSplit.GetFrequency())
 			if err != nil {
 				return err
 			}
 			r.state.Vfo.Split.Frequency = newSplit.GetFrequency()
 		} else {
-			txVfo, ok := hl.VfoValue[r.state.Vfo.Split.Vfo]
-			if !ok {
-				return errors.New("unknown VFO")
-			}
 			txFreq, err := r.rig.GetSplitFreq(txVfo)
 			if err != nil {
 				return err
@@ -418,58 +637,36 @@ func (r *radio) updateSplit(newSplit *sbRadio.Split) error {
 		if newSplit.GetMode() != r.state.Vfo.Split.Mode &&
 			len(newSplit.GetMode()) > 0 {
 
-			txVfo, ok := hl.VfoValue[r.state.Vfo.Split.Vfo]
-			if !ok {
-				return errors.New("unknown VFO")
-			}
-
-			newSplitModeValue, ok := hl.ModeValue[newSplit.GetMode()]
-			if !ok {
-				return errors.New("unknown split mode")
-			}
-
 			pbWidth := r.state.Vfo.Split.PbWidth
 			if newSplit.GetPbWidth() > 0 {
 				pbWidth = newSplit.GetPbWidth()
 			}
 
-			err := r.rig.SetSplitMode(txVfo, newSplitModeValue, int(pbWidth))
+			err := r.rig.SetSplitMode(txVfo, newSplit.GetMode(), int(pbWidth))
 			if err != nil {
+				pbNormal, err := r.rig.GetPbNormal(newSplit.GetMode())
 				if err != nil {
-					pbNormal, err := r.rig.GetPbNormal(newSplitModeValue)
-					if err != nil {
-						return err
-					}
-					err = r.rig.SetSplitMode(txVfo, newSplitModeValue, pbNormal)
-					if err != nil {
-						return err
-					}
+					return err
+				}
+				err = r.rig.SetSplitMode(txVfo, newSplit.GetMode(), pbNormal)
+				if err != nil {
+					return err
 				}
 			}
 			r.state.Vfo.Split.Mode = newSplit.GetMode()
 
 		} else {
-			txVfo, ok := hl.VfoValue[r.state.Vfo.Split.Vfo]
-			if !ok {
-				return errors.New("unknown VFO")
-			}
 			txMode, txPbWidth, err := r.rig.GetSplitMode(txVfo)
 			if err != nil {
 				return err
 			}
-			r.state.Vfo.Split.Mode = hl.ModeName[txMode]
+			r.state.Vfo.Split.Mode = txMode
 			r.state.Vfo.Split.PbWidth = int32(txPbWidth)
 		}
 
 		if newSplit.GetPbWidth() != r.state.Vfo.Split.PbWidth {
 
-			txVfo, ok := hl.VfoValue[r.state.Vfo.Split.Vfo]
-			if !ok {
-				return errors.New("unknown VFO")
-			}
-
-			splitModeValue := hl.ModeValue[newSplit.GetMode()]
-			err := r.rig.SetSplitMode(txVfo, splitModeValue, int(newSplit.GetPbWidth()))
+			err := r.rig.SetSplitMode(txVfo, r.state.Vfo.Split.Mode, int(newSplit.GetPbWidth()))
 			if err != nil {
 				return err
 			}
@@ -487,7 +684,7 @@ func (r *radio) updateSplit(newSplit *sbRadio.Split) error {
 }
 
 func (r *radio) updateTs(newTs int32) error {
-	vfo, _ := hl.VfoValue[r.state.CurrentVfo]
+	vfo := r.state.CurrentVfo
 	err := r.rig.SetTs(vfo, int(newTs))
 	if err != nil {
 		return err
@@ -504,16 +701,12 @@ func (r *radio) updateTs(newTs int32) error {
 }
 
 func (r *radio) updateFunctions(newFuncs []string) error {
-	vfo, _ := hl.VfoValue[r.state.CurrentVfo]
+	vfo := r.state.CurrentVfo
 
 	// functions to be enabled
 	diff := utils.SliceDiff(newFuncs, r.state.Vfo.Functions)
 	for _, f := range diff {
-		funcValue, ok := hl.FuncValue[f]
-		if !ok {
-			return errors.New("unknown function")
-		}
-		err := r.rig.SetFunc(vfo, funcValue, true)
+		err := r.rig.SetFunc(vfo, f, true)
 		if err != nil {
 			return err
 		}
@@ -524,11 +717,7 @@ func (r *radio) updateFunctions(newFuncs []string) error {
 	// functions to be disabled
 	diff = utils.SliceDiff(r.state.Vfo.Functions, newFuncs)
 	for _, f := range diff {
-		funcValue, ok := hl.FuncValue[f]
-		if !ok {
-			return errors.New("unknown function")
-		}
-		err := r.rig.SetFunc(vfo, funcValue, false)
+		err := r.rig.SetFunc(vfo, f, false)
 		if err != nil {
 			return err
 		}
@@ -540,21 +729,17 @@ func (r *radio) updateFunctions(newFuncs []string) error {
 }
 
 func (r *radio) updateLevels(newLevels map[string]float32) error {
-	vfo, _ := hl.VfoValue[r.state.CurrentVfo]
+	vfo := r.state.CurrentVfo
 
 	for k, v := range newLevels {
-		levelValue, ok := hl.LevelValue[k]
-		if !ok {
-			return errors.New("unknown Level")
-		}
 		if _, ok := r.state.Vfo.Levels[k]; !ok {
-			return errors.New("unsupported Level for this rig")
+			return fmt.Errorf("unsupported Level %s for this rig", k)
 		}
 
 		if r.state.Vfo.Levels[k] != v {
-			err := r.rig.SetLevel(vfo, levelValue, v)
+			err := r.rig.SetLevel(vfo, k, v)
 			if err != nil {
-				return nil
+				return err
 			}
 
 			r.state.Vfo.Levels[k] = v
@@ -565,21 +750,19 @@ func (r *radio) updateLevels(newLevels map[string]float32) error {
 }
 
 func (r *radio) updateParams(newParams map[string]float32) error {
-	vfo, _ := hl.VfoValue[r.state.CurrentVfo]
+	vfo := r.state.CurrentVfo
 
 	for k, v := range newParams {
-		paramValue, ok := hl.ParmValue[k]
-		if !ok {
-			return errors.New("unknown Parameter")
-		}
 		if _, ok := r.state.Vfo.Parameters[k]; !ok {
-			return errors.New("unsupported Parameter for this rig")
+			return fmt.Errorf("unsupported Parameter %s for this rig", k)
 		}
-		if r.state.Vfo.Levels[k] != v {
-			err := r.rig.SetLevel(vfo, paramValue, v)
+		if r.state.Vfo.Parameters[k] != v {
+			err := r.rig.SetParm(vfo, k, v)
 			if err != nil {
-				return nil
+				return err
 			}
+
+			r.state.Vfo.Parameters[k] = v
 		}
 	}
 
@@ -588,14 +771,7 @@ func (r *radio) updateParams(newParams map[string]float32) error {
 
 func (r *radio) updatePowerOn(pwrOn bool) error {
 
-	var pwrStat int
-	if pwrOn {
-		pwrStat = hl.RIG_POWER_ON
-	} else {
-		pwrStat = hl.RIG_POWER_OFF
-	}
-
-	err := r.rig.SetPowerStat(pwrStat)
+	err := r.rig.SetPowerStat(pwrOn)
 	if err != nil {
 		return err
 	}
@@ -606,15 +782,8 @@ func (r *radio) updatePowerOn(pwrOn bool) error {
 }
 
 func (r *radio) updatePtt(ptt bool) error {
-	vfo, _ := hl.VfoValue[r.state.CurrentVfo]
-	var pttValue int
-	if ptt {
-		pttValue = hl.RIG_PTT_ON
-	} else {
-		pttValue = hl.RIG_PTT_OFF
-	}
 
-	err := r.rig.SetPtt(vfo, pttValue)
+	err := r.rig.SetPtt(r.state.CurrentVfo, ptt)
 	if err != nil {
 		return err
 	}
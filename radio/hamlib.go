@@ -0,0 +1,552 @@
+//go:build cgo
+
+package radio
+
+import (
+	"errors"
+
+	hl "github.com/dh1tw/goHamlib"
+	sbRadio "github.com/dh1tw/remoteRadio/sb_radio"
+	"github.com/dh1tw/remoteRadio/utils"
+)
+
+// HamlibSettings contain the settings of the Hamlib backend. Since
+// goHamlib requires cgo, radio is built with the simulator backend only
+// when cgo is disabled.
+type HamlibSettings struct {
+	Port hl.Port
+}
+
+// hamlibRig implements the Rig interface through goHamlib.
+type hamlibRig struct {
+	rig      hl.Rig
+	rigModel int
+}
+
+func newHamlibRig(rs RadioSettings) (*hamlibRig, error) {
+
+	h := &hamlibRig{
+		rig:      hl.Rig{},
+		rigModel: rs.RigModel,
+	}
+
+	if err := h.rig.Init(rs.RigModel); err != nil {
+		return nil, err
+	}
+
+	// the debug level is set through hamlibLogs
+
+	if err := h.rig.SetPort(rs.Hamlib.Port); err != nil {
+		h.rig.Cleanup()
+		return nil, err
+	}
+
+	return h, nil
+}
+
+// setHamlibDebugCallback forwards hamlib's debug output to fn.
+func setHamlibDebugCallback(fn func(level int, msg string)) {
+	hl.SetDebugCallback(fn)
+}
+
+// setHamlibDebugLevel sets hamlib's process wide debug level.
+func setHamlibDebugLevel(level int) {
+	hl.SetDebugLevel(level)
+}
+
+func (h *hamlibRig) Open() error {
+	if h.rigModel == 1 { // exception for Dummy Rig
+		return nil
+	}
+	return h.rig.Open()
+}
+
+func (h *hamlibRig) Close() error {
+	// maybe we have to check if the connection is really open
+	h.rig.Close()
+	return h.rig.Cleanup()
+}
+
+func (h *hamlibRig) Caps() sbRadio.Capabilities {
+
+	caps := sbRadio.Capabilities{}
+	caps.Vfos = h.rig.Caps.Vfos
+	caps.Modes = h.rig.Caps.Modes
+	caps.VfoOps = h.rig.Caps.Operations
+	caps.GetFunctions = h.rig.Caps.GetFunctions
+	caps.SetFunctions = h.rig.Caps.SetFunctions
+	caps.GetLevels = hlValuesToPbValues(h.rig.Caps.GetLevels)
+	caps.SetLevels = hlValuesToPbValues(h.rig.Caps.SetLevels)
+	caps.GetParameters = hlValuesToPbValues(h.rig.Caps.GetParameters)
+	caps.SetParameters = hlValuesToPbValues(h.rig.Caps.SetParameters)
+	caps.MaxRit = int32(h.rig.Caps.MaxRit)
+	caps.MaxXit = int32(h.rig.Caps.MaxXit)
+	caps.MaxIfShift = int32(h.rig.Caps.MaxIfShift)
+	caps.Filters = hlMapToPbMap(h.rig.Caps.Filters)
+	caps.TuningSteps = hlMapToPbMap(h.rig.Caps.TuningSteps)
+	caps.Preamps = intListToint32List(h.rig.Caps.Preamps)
+	caps.Attenuators = intListToint32List(h.rig.Caps.Attenuators)
+	caps.RigModel = int32(h.rig.Caps.RigModel)
+	caps.ModelName = h.rig.Caps.ModelName
+	caps.Version = h.rig.Caps.Version
+	caps.MfgName = h.rig.Caps.MfgName
+	status, ok := hl.RigStatusName[h.rig.Caps.Status]
+	if ok {
+		caps.Status = status
+	}
+	caps.MemFirst, caps.MemLast = memRange(h.rig.Caps.ChannelList)
+	caps.CtcssList = uintListToUint32List(h.rig.Caps.CtcssList)
+	caps.DcsList = uintListToUint32List(h.rig.Caps.DcsList)
+
+	return caps
+}
+
+func (h *hamlibRig) SetConf(token string, value string) error {
+	return h.rig.SetConf(token, value)
+}
+
+func (h *hamlibRig) GetPowerStat() (bool, error) {
+	pwrStat, err := h.rig.GetPowerStat()
+	if err != nil {
+		return false, err
+	}
+	return pwrStat == hl.RIG_POWER_ON, nil
+}
+
+func (h *hamlibRig) SetPowerStat(on bool) error {
+	if on {
+		return h.rig.SetPowerStat(hl.RIG_POWER_ON)
+	}
+	return h.rig.SetPowerStat(hl.RIG_POWER_OFF)
+}
+
+func (h *hamlibRig) GetVfo() (string, error) {
+	vfo, err := h.rig.GetVfo()
+	if err != nil {
+		return "", err
+	}
+	vfoName, ok := hl.VfoName[vfo]
+	if !ok {
+		return "", errors.New("unknown Vfo Name")
+	}
+	return vfoName, nil
+}
+
+func (h *hamlibRig) SetVfo(vfo string) error {
+	vfoValue, ok := hl.VfoValue[vfo]
+	if !ok {
+		return errors.New("unknown Vfo")
+	}
+	return h.rig.SetVfo(vfoValue)
+}
+
+func (h *hamlibRig) VfoOp(vfo string, op string) error {
+	vfoOpValue, ok := hl.OperationValue[op]
+	if !ok {
+		return errors.New("unknown VFO Operation")
+	}
+	return h.rig.VfoOp(hl.VfoValue[vfo], vfoOpValue)
+}
+
+func (h *hamlibRig) GetFreq(vfo string) (float64, error) {
+	return h.rig.GetFreq(hl.VfoValue[vfo])
+}
+
+func (h *hamlibRig) SetFreq(vfo string, freq float64) error {
+	return h.rig.SetFreq(hl.VfoValue[vfo], freq)
+}
+
+func (h *hamlibRig) GetMode(vfo string) (string, int, error) {
+	mode, pbWidth, err := h.rig.GetMode(hl.VfoValue[vfo])
+	if err != nil {
+		return "", 0, err
+	}
+	modeName, ok := hl.ModeName[mode]
+	if !ok {
+		return "", 0, errors.New("unknown mode")
+	}
+	return modeName, pbWidth, nil
+}
+
+func (h *hamlibRig) SetMode(vfo string, mode string, pbWidth int) error {
+	modeValue, ok := hl.ModeValue[mode]
+	if !ok {
+		return errors.New("unknown mode")
+	}
+	return h.rig.SetMode(hl.VfoValue[vfo], modeValue, pbWidth)
+}
+
+func (h *hamlibRig) GetPbNormal(mode string) (int, error) {
+	modeValue, ok := hl.ModeValue[mode]
+	if !ok {
+		return 0, errors.New("unknown mode")
+	}
+	return h.rig.GetPbNormal(modeValue)
+}
+
+func (h *hamlibRig) GetAnt(vfo string) (int, error) {
+	return h.rig.GetAnt(hl.VfoValue[vfo])
+}
+
+func (h *hamlibRig) SetAnt(vfo string, ant int) error {
+	return h.rig.SetAnt(hl.VfoValue[vfo], ant)
+}
+
+func (h *hamlibRig) GetRit(vfo string) (int, error) {
+	return h.rig.GetRit(hl.VfoValue[vfo])
+}
+
+func (h *hamlibRig) SetRit(vfo string, rit int) error {
+	return h.rig.SetRit(hl.VfoValue[vfo], rit)
+}
+
+func (h *hamlibRig) GetXit(vfo string) (int, error) {
+	return h.rig.GetXit(hl.VfoValue[vfo])
+}
+
+func (h *hamlibRig) SetXit(vfo string, xit int) error {
+	return h.rig.SetXit(hl.VfoValue[vfo], xit)
+}
+
+func (h *hamlibRig) GetTs(vfo string) (int, error) {
+	return h.rig.GetTs(hl.VfoValue[vfo])
+}
+
+func (h *hamlibRig) SetTs(vfo string, ts int) error {
+	return h.rig.SetTs(hl.VfoValue[vfo], ts)
+}
+
+func (h *hamlibRig) GetCtcssTone(vfo string) (uint, error) {
+	return h.rig.GetCtcssTone(hl.VfoValue[vfo])
+}
+
+func (h *hamlibRig) SetCtcssTone(vfo string, tone uint) error {
+	return h.rig.SetCtcssTone(hl.VfoValue[vfo], tone)
+}
+
+func (h *hamlibRig) GetCtcssSql(vfo string) (uint, error) {
+	return h.rig.GetCtcssSql(hl.VfoValue[vfo])
+}
+
+func (h *hamlibRig) SetCtcssSql(vfo string, tone uint) error {
+	return h.rig.SetCtcssSql(hl.VfoValue[vfo], tone)
+}
+
+func (h *hamlibRig) GetDcsCode(vfo string) (uint, error) {
+	return h.rig.GetDcsCode(hl.VfoValue[vfo])
+}
+
+func (h *hamlibRig) SetDcsCode(vfo string, code uint) error {
+	return h.rig.SetDcsCode(hl.VfoValue[vfo], code)
+}
+
+func (h *hamlibRig) GetDcsSql(vfo string) (uint, error) {
+	return h.rig.GetDcsSql(hl.VfoValue[vfo])
+}
+
+func (h *hamlibRig) SetDcsSql(vfo string, code uint) error {
+	return h.rig.SetDcsSql(hl.VfoValue[vfo], code)
+}
+
+// repeater shift names as used on the wire
+var rptrShiftName = map[int]string{
+	hl.RIG_RPT_SHIFT_NONE:  "NONE",
+	hl.RIG_RPT_SHIFT_MINUS: "-",
+	hl.RIG_RPT_SHIFT_PLUS:  "+",
+}
+
+func (h *hamlibRig) GetRptrShift(vfo string) (string, error) {
+	shift, err := h.rig.GetRptrShift(hl.VfoValue[vfo])
+	if err != nil {
+		return "", err
+	}
+	name, ok := rptrShiftName[shift]
+	if !ok {
+		return "", errors.New("unknown repeater shift")
+	}
+	return name, nil
+}
+
+func (h *hamlibRig) SetRptrShift(vfo string, shift string) error {
+	for value, name := range rptrShiftName {
+		if name == shift {
+			return h.rig.SetRptrShift(hl.VfoValue[vfo], value)
+		}
+	}
+	return errors.New("unknown repeater shift")
+}
+
+func (h *hamlibRig) GetRptrOffs(vfo string) (int, error) {
+	return h.rig.GetRptrOffs(hl.VfoValue[vfo])
+}
+
+func (h *hamlibRig) SetRptrOffs(vfo string, offs int) error {
+	return h.rig.SetRptrOffs(hl.VfoValue[vfo], offs)
+}
+
+func (h *hamlibRig) GetSplit(vfo string) (bool, string, error) {
+	splitOn, txVfo, err := h.rig.GetSplit(hl.VfoValue[vfo])
+	if err != nil {
+		return false, "", err
+	}
+	if splitOn != hl.RIG_SPLIT_ON {
+		return false, "", nil
+	}
+	txVfoName, ok := hl.VfoName[txVfo]
+	if !ok {
+		return false, "", errors.New("unknown Vfo Name")
+	}
+	return true, txVfoName, nil
+}
+
+func (h *hamlibRig) SetSplit(vfo string, enabled bool) error {
+	return h.rig.SetSplit(hl.VfoValue[vfo], utils.Btoi(enabled))
+}
+
+func (h *hamlibRig) SetSplitVfo(vfo string, enabled bool, txVfo string) error {
+	txVfoValue, ok := hl.VfoValue[txVfo]
+	if !ok {
+		return errors.New("unknown split tx vfo")
+	}
+	return h.rig.SetSplitVfo(hl.VfoValue[vfo], utils.Btoi(enabled), txVfoValue)
+}
+
+func (h *hamlibRig) GetSplitFreq(txVfo string) (float64, error) {
+	txVfoValue, ok := hl.VfoValue[txVfo]
+	if !ok {
+		return 0, errors.New("unknown VFO")
+	}
+	return h.rig.GetSplitFreq(txVfoValue)
+}
+
+func (h *hamlibRig) SetSplitFreq(txVfo string, freq float64) error {
+	txVfoValue, ok := hl.VfoValue[txVfo]
+	if !ok {
+		return errors.New("unknown VFO")
+	}
+	return h.rig.SetSplitFreq(txVfoValue, freq)
+}
+
+func (h *hamlibRig) GetSplitMode(txVfo string) (string, int, error) {
+	txVfoValue, ok := hl.VfoValue[txVfo]
+	if !ok {
+		return "", 0, errors.New("unknown VFO")
+	}
+	txMode, txPbWidth, err := h.rig.GetSplitMode(txVfoValue)
+	if err != nil {
+		return "", 0, err
+	}
+	txModeName, ok := hl.ModeName[txMode]
+	if !ok {
+		return "", 0, errors.New("unknown Mode")
+	}
+	return txModeName, txPbWidth, nil
+}
+
+func (h *hamlibRig) SetSplitMode(txVfo string, mode string, pbWidth int) error {
+	txVfoValue, ok := hl.VfoValue[txVfo]
+	if !ok {
+		return errors.New("unknown VFO")
+	}
+	modeValue, ok := hl.ModeValue[mode]
+	if !ok {
+		return errors.New("unknown split mode")
+	}
+	return h.rig.SetSplitMode(txVfoValue, modeValue, pbWidth)
+}
+
+func (h *hamlibRig) GetFunc(vfo string, function string) (bool, error) {
+	funcValue, ok := hl.FuncValue[function]
+	if !ok {
+		return false, errors.New("unknown function")
+	}
+	return h.rig.GetFunc(hl.VfoValue[vfo], funcValue)
+}
+
+func (h *hamlibRig) SetFunc(vfo string, function string, on bool) error {
+	funcValue, ok := hl.FuncValue[function]
+	if !ok {
+		return errors.New("unknown function")
+	}
+	return h.rig.SetFunc(hl.VfoValue[vfo], funcValue, on)
+}
+
+func (h *hamlibRig) GetLevel(vfo string, level string) (float32, error) {
+	levelValue, ok := hl.LevelValue[level]
+	if !ok {
+		return 0, errors.New("unknown Level")
+	}
+	return h.rig.GetLevel(hl.VfoValue[vfo], levelValue)
+}
+
+func (h *hamlibRig) SetLevel(vfo string, level string, value float32) error {
+	levelValue, ok := hl.LevelValue[level]
+	if !ok {
+		return errors.New("unknown Level")
+	}
+	return h.rig.SetLevel(hl.VfoValue[vfo], levelValue, value)
+}
+
+func (h *hamlibRig) GetParm(vfo string, parm string) (float32, error) {
+	parmValue, ok := hl.ParmValue[parm]
+	if !ok {
+		return 0, errors.New("unknown Parameter")
+	}
+	return h.rig.GetParm(hl.VfoValue[vfo], parmValue)
+}
+
+func (h *hamlibRig) SetParm(vfo string, parm string, value float32) error {
+	parmValue, ok := hl.ParmValue[parm]
+	if !ok {
+		return errors.New("unknown Parameter")
+	}
+	return h.rig.SetParm(hl.VfoValue[vfo], parmValue, value)
+}
+
+func (h *hamlibRig) GetPtt(vfo string) (bool, error) {
+	ptt, err := h.rig.GetPtt(hl.VfoValue[vfo])
+	if err != nil {
+		return false, err
+	}
+	return ptt == hl.RIG_PTT_ON, nil
+}
+
+func (h *hamlibRig) SetPtt(vfo string, on bool) error {
+	if on {
+		return h.rig.SetPtt(hl.VfoValue[vfo], hl.RIG_PTT_ON)
+	}
+	return h.rig.SetPtt(hl.VfoValue[vfo], hl.RIG_PTT_OFF)
+}
+
+func (h *hamlibRig) GetMem(vfo string) (int, error) {
+	return h.rig.GetMem(hl.VfoValue[vfo])
+}
+
+func (h *hamlibRig) SetMem(vfo string, ch int) error {
+	return h.rig.SetMem(hl.VfoValue[vfo], ch)
+}
+
+func (h *hamlibRig) GetChannel(vfo string, ch int) (sbRadio.Channel, error) {
+	hlCh, err := h.rig.GetChannel(hl.VfoValue[vfo], ch)
+	if err != nil {
+		return sbRadio.Channel{}, err
+	}
+
+	c := sbRadio.Channel{
+		Channel:   int32(ch),
+		Name:      hlCh.ChannelDesc,
+		Frequency: hlCh.Freq,
+		Mode:      hl.ModeName[hlCh.Mode],
+		PbWidth:   int32(hlCh.Width),
+		CtcssTone: uint32(hlCh.CtcssTone),
+		CtcssSql:  uint32(hlCh.CtcssSql),
+		DcsCode:   uint32(hlCh.DcsCode),
+		DcsSql:    uint32(hlCh.DcsSql),
+	}
+
+	c.Split = &sbRadio.Split{}
+	if hlCh.Split == hl.RIG_SPLIT_ON {
+		c.Split.Enabled = true
+		c.Split.Vfo = hl.VfoName[hlCh.TxVfo]
+		c.Split.Frequency = hlCh.TxFreq
+		c.Split.Mode = hl.ModeName[hlCh.TxMode]
+		c.Split.PbWidth = int32(hlCh.TxWidth)
+	}
+
+	return c, nil
+}
+
+func (h *hamlibRig) SetChannel(vfo string, ch sbRadio.Channel) error {
+
+	modeValue, ok := hl.ModeValue[ch.GetMode()]
+	if !ok {
+		return errors.New("unknown mode")
+	}
+
+	hlCh := hl.Channel{
+		ChannelNum:  int(ch.GetChannel()),
+		Vfo:         hl.VfoValue[vfo],
+		Freq:        ch.GetFrequency(),
+		Mode:        modeValue,
+		Width:       int(ch.GetPbWidth()),
+		CtcssTone:   uint(ch.GetCtcssTone()),
+		CtcssSql:    uint(ch.GetCtcssSql()),
+		DcsCode:     uint(ch.GetDcsCode()),
+		DcsSql:      uint(ch.GetDcsSql()),
+		ChannelDesc: ch.GetName(),
+	}
+
+	if ch.Split.GetEnabled() {
+		txModeValue, ok := hl.ModeValue[ch.Split.GetMode()]
+		if !ok {
+			return errors.New("unknown split mode")
+		}
+		hlCh.Split = hl.RIG_SPLIT_ON
+		hlCh.TxVfo = hl.VfoValue[ch.Split.GetVfo()]
+		hlCh.TxFreq = ch.Split.GetFrequency()
+		hlCh.TxMode = txModeValue
+		hlCh.TxWidth = int(ch.Split.GetPbWidth())
+	}
+
+	return h.rig.SetChannel(hl.VfoValue[vfo], hlCh)
+}
+
+func (h *hamlibRig) ClearChannel(vfo string, ch int) error {
+	// hamlib considers channels with a frequency of 0 as empty
+	return h.rig.SetChannel(hl.VfoValue[vfo], hl.Channel{ChannelNum: ch})
+}
+
+func (h *hamlibRig) SendMorse(vfo string, text string) error {
+	return h.rig.SendMorse(hl.VfoValue[vfo], text)
+}
+
+func (h *hamlibRig) StopMorse(vfo string) error {
+	return h.rig.StopMorse(hl.VfoValue[vfo])
+}
+
+// memRange returns the first and the last regular memory channel
+func memRange(chanList []hl.ChannelRange) (int32, int32) {
+	first, last := -1, -1
+	for _, cr := range chanList {
+		if cr.Type != "MEM" {
+			continue
+		}
+		if first < 0 || cr.Start < first {
+			first = cr.Start
+		}
+		if cr.End > last {
+			last = cr.End
+		}
+	}
+	if first < 0 {
+		return 0, 0
+	}
+	return int32(first), int32(last)
+}
+
+func uintListToUint32List(uintList []uint) []uint32 {
+
+	uint32List := make([]uint32, 0, len(uintList))
+
+	for _, i := range uintList {
+		uint32List = append(uint32List, uint32(i))
+	}
+
+	return uint32List
+}
+
+func hlValuesToPbValues(hlValues hl.Values) []*sbRadio.Value {
+
+	pbValues := make([]*sbRadio.Value, 0, len(hlValues))
+
+	for _, hlValue := range hlValues {
+		var v sbRadio.Value
+		v.Name = hlValue.Name
+		v.Max = hlValue.Max
+		v.Min = hlValue.Min
+		v.Step = hlValue.Step
+		pbValues = append(pbValues, &v)
+	}
+
+	return pbValues
+}
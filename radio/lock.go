@@ -0,0 +1,169 @@
+package radio
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/dh1tw/remoteRadio/comms"
+	sbRadio "github.com/dh1tw/remoteRadio/sb_radio"
+	"github.com/dh1tw/remoteRadio/utils"
+)
+
+// An operator can acquire exclusive control of the radio. While the
+// radio is locked, SetState requests from all other operators are
+// rejected, except for admins and for requests which unkey the
+// transmitter or turn the radio off. The lock expires after
+// RadioSettings.LockLease unless the holder keeps using the radio.
+// Admins can take over the lock.
+
+// userRequest is a request which carries the user_id of its sender.
+type userRequest interface {
+	Unmarshal([]byte) error
+	GetUserId() string
+}
+
+// checkRequestUser verifies that the user_id of a request matches the
+// last level of the topic it was published to. The broker's ACL
+// restricts this level to the username with which the client
+// authenticated, so a client can't claim the user_id of someone else.
+func checkRequestUser(msg comms.IOMsg, req userRequest) error {
+	if err := req.Unmarshal(msg.Data); err != nil {
+		return err
+	}
+	topicUser := msg.Topic[strings.LastIndex(msg.Topic, "/")+1:]
+	if req.GetUserId() != topicUser {
+		return fmt.Errorf("request of user_id %q published to the topic of %q",
+			req.GetUserId(), topicUser)
+	}
+	return nil
+}
+
+// deserializeLockRequest handles a request to acquire or release
+// the operator lock.
+func (r *radio) deserializeLockRequest(request []byte) (sbRadio.Ack, error) {
+
+	req := sbRadio.Lock{}
+	if err := req.Unmarshal(request); err != nil {
+		return sbRadio.Ack{}, err
+	}
+
+	ack := sbRadio.Ack{
+		RequestId: req.GetRequestId(),
+		UserId:    req.GetUserId(),
+	}
+
+	err := r.updateLock(req.GetUserId(), req.GetAcquire(), req.GetTakeover())
+	r.addResult(&ack, "lock", err)
+
+	return ack, nil
+}
+
+func (r *radio) updateLock(userID string, acquire, takeover bool) error {
+
+	if userID == "" {
+		return errors.New("user_id missing")
+	}
+
+	holder := r.state.LockHolder
+	isAdmin := utils.StringInSlice(userID, r.settings.Admins)
+
+	if acquire {
+		if holder != "" && holder != userID {
+			if !takeover {
+				return fmt.Errorf("radio is locked by %s", holder)
+			}
+			if !isAdmin {
+				return fmt.Errorf("only admins can take over the lock from %s", holder)
+			}
+			log.Printf("%s took over the lock from %s\n", userID, holder)
+		}
+		r.state.LockHolder = userID
+		r.state.LockExpires = 0
+		r.renewLock()
+		return nil
+	}
+
+	// release
+	if holder == "" {
+		return nil
+	}
+	if holder != userID && !isAdmin {
+		return fmt.Errorf("radio is locked by %s", holder)
+	}
+	r.state.LockHolder = ""
+	r.state.LockExpires = 0
+	r.lockDeadline = time.Time{}
+
+	return nil
+}
+
+// renewLock extends the lease of the current lock holder. Without
+// a lease time the lock never expires. The published expiry is only
+// refreshed shortly before it is reached (see checkLockExpired), so
+// that not every request changes the state.
+func (r *radio) renewLock() {
+	if r.settings.LockLease <= 0 {
+		r.lockDeadline = time.Time{}
+		r.state.LockExpires = 0
+		return
+	}
+	r.lockDeadline = time.Now().Add(r.settings.LockLease)
+	if r.state.LockExpires == 0 {
+		r.state.LockExpires = r.lockDeadline.UnixNano()
+	}
+}
+
+// lockedBy returns an error if the radio is locked by somebody else.
+// Admins are never locked out.
+func (r *radio) lockedBy(userID string) error {
+	if r.state.LockHolder == "" || r.state.LockHolder == userID {
+		return nil
+	}
+	if utils.StringInSlice(userID, r.settings.Admins) {
+		return nil
+	}
+	return fmt.Errorf("radio is locked by %s", r.state.LockHolder)
+}
+
+// checkLock returns an error if the radio is locked by somebody else.
+// Requests from the lock holder renew the lease.
+func (r *radio) checkLock(userID string) error {
+	if err := r.lockedBy(userID); err != nil {
+		return err
+	}
+	if r.state.LockHolder == userID {
+		r.renewLock()
+	}
+	return nil
+}
+
+// checkLockExpired releases the lock once its lease has expired and
+// refreshes the published expiry when it is about to be reached while
+// the lease has been renewed in the meantime. It returns true if the
+// state has changed.
+func (r *radio) checkLockExpired() bool {
+	if r.state.LockHolder == "" || r.lockDeadline.IsZero() {
+		return false
+	}
+
+	now := time.Now()
+
+	if !now.Before(r.lockDeadline) {
+		log.Printf("lock of %s expired\n", r.state.LockHolder)
+		r.state.LockHolder = ""
+		r.state.LockExpires = 0
+		r.lockDeadline = time.Time{}
+		return true
+	}
+
+	expires := time.Unix(0, r.state.LockExpires)
+	if expires.Sub(now) < r.settings.LockLease/10 && r.lockDeadline.After(expires) {
+		r.state.LockExpires = r.lockDeadline.UnixNano()
+		return true
+	}
+
+	return false
+}
@@ -0,0 +1,153 @@
+package radio
+
+import (
+	"testing"
+	"time"
+
+	"github.com/dh1tw/remoteRadio/comms"
+	sbRadio "github.com/dh1tw/remoteRadio/sb_radio"
+)
+
+func TestLockedOutRequests(t *testing.T) {
+
+	tests := []struct {
+		name     string
+		userID   string
+		req      sbRadio.SetState
+		applied  []string
+		rejected []string
+	}{
+		{
+			name:     "frequency",
+			userID:   "other",
+			req:      sbRadio.SetState{Md: &sbRadio.MetaData{HasFrequency: true}, Vfo: &sbRadio.Vfo{Frequency: 7000000}},
+			rejected: []string{"frequency"},
+		},
+		{
+			name:    "ptt off",
+			userID:  "other",
+			req:     sbRadio.SetState{Md: &sbRadio.MetaData{HasPtt: true}},
+			applied: []string{"ptt"},
+		},
+		{
+			name:     "ptt off with frequency",
+			userID:   "other",
+			req:      sbRadio.SetState{Md: &sbRadio.MetaData{HasPtt: true, HasFrequency: true}, Vfo: &sbRadio.Vfo{Frequency: 7000000}},
+			applied:  []string{"ptt"},
+			rejected: []string{"frequency"},
+		},
+		{
+			name:     "ptt on",
+			userID:   "other",
+			req:      sbRadio.SetState{Md: &sbRadio.MetaData{HasPtt: true}, Ptt: true},
+			rejected: []string{"ptt"},
+		},
+		{
+			name:    "radio off",
+			userID:  "other",
+			req:     sbRadio.SetState{Md: &sbRadio.MetaData{HasRadioOn: true}},
+			applied: []string{"radio_on"},
+		},
+		{
+			name:    "admin",
+			userID:  "admin",
+			req:     sbRadio.SetState{Md: &sbRadio.MetaData{HasFrequency: true}, Vfo: &sbRadio.Vfo{Frequency: 7000000}},
+			applied: []string{"frequency"},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			r := newTestRadio(t, RadioSettings{Admins: []string{"admin"}})
+			if err := r.updateLock("holder", true, false); err != nil {
+				t.Fatal(err)
+			}
+			setState(t, r, sbRadio.SetState{UserId: "holder", Md: &sbRadio.MetaData{HasPtt: true}, Ptt: true})
+
+			tc.req.UserId = tc.userID
+			if tc.req.Vfo == nil {
+				tc.req.Vfo = &sbRadio.Vfo{}
+			}
+			ack := setState(t, r, tc.req)
+
+			if len(ack.Applied) != len(tc.applied) {
+				t.Errorf("applied = %v, want %v", ack.Applied, tc.applied)
+			}
+			for _, field := range tc.rejected {
+				if !rejected(ack, field) {
+					t.Errorf("%s not rejected", field)
+				}
+			}
+			if len(ack.Rejected) != len(tc.rejected) {
+				t.Errorf("rejected = %v, want %v", ack.Rejected, tc.rejected)
+			}
+		})
+	}
+}
+
+func TestLockRenewal(t *testing.T) {
+	r := newTestRadio(t, RadioSettings{LockLease: time.Minute})
+
+	if err := r.updateLock("holder", true, false); err != nil {
+		t.Fatal(err)
+	}
+	expires := r.state.LockExpires
+	if expires == 0 {
+		t.Fatal("LockExpires not set")
+	}
+
+	// renewing the lease must not change the published state
+	time.Sleep(time.Millisecond)
+	if err := r.checkLock("holder"); err != nil {
+		t.Fatal(err)
+	}
+	if r.state.LockExpires != expires {
+		t.Error("LockExpires changed on renewal")
+	}
+	if r.checkLockExpired() {
+		t.Error("expiry refreshed too early")
+	}
+
+	// shortly before the published expiry, the renewed lease is published
+	r.state.LockExpires = time.Now().Add(time.Second).UnixNano()
+	if !r.checkLockExpired() {
+		t.Fatal("expiry not refreshed")
+	}
+	if r.state.LockExpires != r.lockDeadline.UnixNano() {
+		t.Error("LockExpires doesn't match the renewed lease")
+	}
+
+	// without renewal the lock is released
+	r.lockDeadline = time.Now().Add(-time.Second)
+	if !r.checkLockExpired() || r.state.LockHolder != "" {
+		t.Error("lock not released")
+	}
+}
+
+func TestCheckRequestUser(t *testing.T) {
+
+	tests := []struct {
+		name    string
+		topic   string
+		userID  string
+		wantErr bool
+	}{
+		{"own topic", "test/cat/setstate/dh1tw", "dh1tw", false},
+		{"topic of another user", "test/cat/setstate/dl0abc", "dh1tw", true},
+		{"no user_id", "test/cat/setstate/dh1tw", "", true},
+		{"user level missing", "test/cat/setstate", "dh1tw", true},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			data, err := (&sbRadio.SetState{UserId: tc.userID}).Marshal()
+			if err != nil {
+				t.Fatal(err)
+			}
+			err = checkRequestUser(comms.IOMsg{Topic: tc.topic, Data: data}, &sbRadio.SetState{})
+			if (err != nil) != tc.wantErr {
+				t.Errorf("checkRequestUser() error = %v, wantErr %v", err, tc.wantErr)
+			}
+		})
+	}
+}
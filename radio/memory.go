@@ -0,0 +1,210 @@
+package radio
+
+import (
+	"errors"
+	"fmt"
+	"log"
+
+	"github.com/dh1tw/remoteRadio/comms"
+	sbRadio "github.com/dh1tw/remoteRadio/sb_radio"
+	"github.com/dh1tw/remoteRadio/utils"
+)
+
+// memoryBatch is the number of channels which are read by one job when
+// listing the memory channels, so that urgent jobs (e.g. PTT off) can
+// be executed in between.
+const memoryBatch = 10
+
+// memoryList is a list request whose channels are being read.
+type memoryList struct {
+	resp *sbRadio.MemoryResponse
+	ack  sbRadio.Ack
+	next int // next channel to be read
+}
+
+func (r *radio) execMemoryRequest(req sbRadio.MemoryRequest) (*sbRadio.MemoryResponse, error) {
+
+	if !r.conn.Connected() {
+		return nil, errRigDisconnected
+	}
+
+	if r.caps.MemLast == 0 {
+		return nil, errors.New("rig has no memory channels")
+	}
+
+	if !r.state.RadioOn {
+		return nil, errRadioOff
+	}
+
+	ch := int(req.GetChannel())
+	vfo := r.state.CurrentVfo
+
+	if req.GetOperation() != "list" {
+		if ch < int(r.caps.MemFirst) || ch > int(r.caps.MemLast) {
+			return nil, fmt.Errorf("memory channel must be within %d..%d",
+				r.caps.MemFirst, r.caps.MemLast)
+		}
+	}
+
+	resp := &sbRadio.MemoryResponse{
+		UserId:    req.GetUserId(),
+		RequestId: req.GetRequestId(),
+	}
+
+	switch req.GetOperation() {
+	case "list":
+		// the channels are read by listMemory
+		return resp, nil
+
+	case "read":
+		c, err := r.rig.GetChannel(vfo, ch)
+		if err != nil {
+			return nil, err
+		}
+		resp.Channels = append(resp.Channels, &c)
+		return resp, nil
+	}
+
+	// all other operations modify the rig
+	if err := r.checkLock(req.GetUserId()); err != nil {
+		return nil, err
+	}
+
+	switch req.GetOperation() {
+	case "write":
+		if req.Data == nil || req.Data.GetFrequency() <= 0 {
+			return nil, errors.New("memory channel data missing")
+		}
+		c := *req.Data
+		c.Channel = int32(ch)
+		return nil, r.rig.SetChannel(vfo, c)
+
+	case "clear":
+		return nil, r.rig.ClearChannel(vfo, ch)
+
+	case "recall":
+		if err := r.rig.SetMem(vfo, ch); err != nil {
+			return nil, err
+		}
+		// copy the memory channel into the vfo
+		if utils.StringInSlice("TO_VFO", r.caps.VfoOps) {
+			if err := r.rig.VfoOp(vfo, "TO_VFO"); err != nil {
+				return nil, err
+			}
+		}
+		r.state.Channel.Channel = int32(ch)
+		if err := r.queryVfo(); err != nil {
+			return nil, err
+		}
+		return nil, r.enforceTxState(req.GetUserId())
+	}
+
+	return nil, fmt.Errorf("unknown memory operation '%s'", req.GetOperation())
+}
+
+func (r *radio) sendMemoryResponse(resp *sbRadio.MemoryResponse) error {
+
+	data, err := resp.Marshal()
+	if err != nil {
+		return err
+	}
+
+	msg := comms.IOMsg{}
+	msg.Data = data
+	msg.Topic = r.settings.MemoryTopic
+	r.settings.ToWireCh <- msg
+
+	return nil
+}
+
+// handleMemoryRequest handles requests to list, read, write, clear and
+// recall memory channels. List and read requests are answered with a
+// MemoryResponse containing the requested channels.
+func (r *radio) handleMemoryRequest(msg []byte) {
+
+	req := sbRadio.MemoryRequest{}
+	if err := req.Unmarshal(msg); err != nil {
+		log.Println(err)
+		return
+	}
+
+	ack := sbRadio.Ack{
+		RequestId: req.GetRequestId(),
+		UserId:    req.GetUserId(),
+	}
+
+	resp, err := r.execMemoryRequest(req)
+	if err == nil && req.GetOperation() == "list" {
+		r.listMemory(&memoryList{
+			resp: resp,
+			ack:  ack,
+			next: int(r.caps.MemFirst),
+		})
+		return
+	}
+
+	r.addResult(&ack, "memory", err)
+	r.publishMemoryResult(resp, ack)
+}
+
+// listMemory reads the next batch of memory channels. The following
+// batch is queued behind the jobs which have been queued in the
+// meantime. The response is published once all channels have been read.
+func (r *radio) listMemory(l *memoryList) {
+
+	var err error
+	if !r.conn.Connected() {
+		err = errRigDisconnected
+	}
+
+	vfo := r.state.CurrentVfo
+	last := l.next + memoryBatch - 1
+	if last > int(r.caps.MemLast) {
+		last = int(r.caps.MemLast)
+	}
+
+	for ; err == nil && l.next <= last; l.next++ {
+		var c sbRadio.Channel
+		c, err = r.rig.GetChannel(vfo, l.next)
+		if err == nil && c.Frequency > 0 {
+			l.resp.Channels = append(l.resp.Channels, &c)
+		}
+	}
+
+	if err != nil {
+		r.addResult(&l.ack, "memory", err)
+		r.publishMemoryResult(nil, l.ack)
+		return
+	}
+
+	if l.next > int(r.caps.MemLast) {
+		r.addResult(&l.ack, "memory", nil)
+		r.publishMemoryResult(l.resp, l.ack)
+		return
+	}
+
+	r.sched.push(job{
+		name: "memory",
+		prio: prioUser,
+		run:  func(job) { r.listMemory(l) },
+	})
+}
+
+// publishMemoryResult publishes the response (if any), the state and
+// the ack of a memory request.
+func (r *radio) publishMemoryResult(resp *sbRadio.MemoryResponse, ack sbRadio.Ack) {
+
+	if resp != nil {
+		if err := r.sendMemoryResponse(resp); err != nil {
+			log.Println(err)
+		}
+	}
+
+	if err := r.sendState(); err != nil {
+		log.Println(err)
+	}
+
+	if err := r.sendAck(ack); err != nil {
+		log.Println(err)
+	}
+}
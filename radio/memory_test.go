@@ -0,0 +1,84 @@
+package radio
+
+import (
+	"testing"
+
+	sbRadio "github.com/dh1tw/remoteRadio/sb_radio"
+)
+
+// TestListMemory verifies that the memory channels are listed in
+// batches and that safety jobs are executed in between.
+func TestListMemory(t *testing.T) {
+	r := newTestRadio(t, RadioSettings{MemoryTopic: "test/cat/memory"})
+
+	stored := []int{1, 5, 11, 42, 99}
+	for _, ch := range stored {
+		c := sbRadio.Channel{Channel: int32(ch), Frequency: 7000000 + float64(ch), Mode: "CW"}
+		if err := r.rig.SetChannel("VFOA", c); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	req := sbRadio.MemoryRequest{UserId: "dh1tw", RequestId: "1", Operation: "list"}
+	data, err := req.Marshal()
+	if err != nil {
+		t.Fatal(err)
+	}
+	r.handleMemoryRequest(data)
+
+	// the first batch has been read; the rest is queued
+	if msgs := drain(r); len(msgs) > 0 {
+		t.Fatalf("response published after the first batch: %v", msgs)
+	}
+
+	batches := 1
+	safetyJobs := 0
+	for len(r.sched.queues[prioUser]) > 0 {
+		r.sched.push(job{name: "ping", prio: prioSafety, run: func(job) { safetyJobs++ }})
+		for i := 0; i < 2; i++ {
+			j, _ := r.sched.pop()
+			if j.name == "memory" && safetyJobs < batches {
+				t.Fatal("safety job not executed before the next batch")
+			}
+			r.sched.exec(j)
+			if j.name == "memory" {
+				batches++
+			}
+		}
+		if batches > 20 {
+			t.Fatal("listing doesn't terminate")
+		}
+	}
+
+	want := (simMemLast-simMemFirst)/memoryBatch + 1
+	if batches != want {
+		t.Errorf("read the channels in %d batches, want %d", batches, want)
+	}
+
+	var resp sbRadio.MemoryResponse
+	var ack sbRadio.Ack
+	for _, msg := range drain(r) {
+		switch msg.Topic {
+		case r.settings.MemoryTopic:
+			if err := resp.Unmarshal(msg.Data); err != nil {
+				t.Fatal(err)
+			}
+		case r.settings.AckTopic:
+			if err := ack.Unmarshal(msg.Data); err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+
+	if len(resp.Channels) != len(stored) {
+		t.Fatalf("got %d channels, want %d", len(resp.Channels), len(stored))
+	}
+	for i, c := range resp.Channels {
+		if int(c.Channel) != stored[i] {
+			t.Errorf("channel %d = %d, want %d", i, c.Channel, stored[i])
+		}
+	}
+	if ack.GetRequestId() != "1" || len(ack.Applied) != 1 {
+		t.Errorf("unexpected ack: %v", ack)
+	}
+}
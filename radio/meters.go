@@ -0,0 +1,153 @@
+package radio
+
+import (
+	"log"
+	"time"
+
+	"github.com/dh1tw/remoteRadio/comms"
+	sbRadio "github.com/dh1tw/remoteRadio/sb_radio"
+)
+
+// MeterSettings selects the levels which are read at the polling interval
+// and published on the meters topic. The meters for receive (Rx) and
+// transmit (Tx) can be overridden per mode.
+type MeterSettings struct {
+	Rx    []string            `mapstructure:"rx"`
+	Tx    []string            `mapstructure:"tx"`
+	Modes map[string]MeterSet `mapstructure:"modes"` // key: mode (e.g. "CW")
+}
+
+// MeterSet contains the meters for one mode.
+type MeterSet struct {
+	Rx []string `mapstructure:"rx"`
+	Tx []string `mapstructure:"tx"`
+}
+
+// DefaultMeterSettings reads the S-Meter while receiving and power, SWR,
+// ALC, compression, drain current and supply voltage while transmitting.
+var DefaultMeterSettings = MeterSettings{
+	Rx: []string{"STRENGTH"},
+	Tx: []string{"RFPOWER_METER", "SWR", "ALC", "COMP_METER", "ID_METER", "VD_METER"},
+}
+
+// meterSet returns the meters for the given mode and PTT state.
+func (ms *MeterSettings) meterSet(mode string, ptt bool) []string {
+	rx, tx := ms.Rx, ms.Tx
+	if set, ok := ms.Modes[mode]; ok {
+		if set.Rx != nil {
+			rx = set.Rx
+		}
+		if set.Tx != nil {
+			tx = set.Tx
+		}
+	}
+	if ptt {
+		return tx
+	}
+	return rx
+}
+
+// updateMeter reads the meters for the current mode and PTT state and
+// publishes them if any of the readings has changed. Meters which are not
+// supported by the rig or which can't be read are skipped; only transport
+// errors are returned.
+func (r *radio) updateMeter() error {
+
+	if !r.conn.Connected() || !r.state.RadioOn {
+		return nil
+	}
+
+	vfo := r.state.CurrentVfo
+
+	meters := sbRadio.Meters{
+		Ptt:    r.state.Ptt,
+		Values: make(map[string]float32),
+	}
+
+	newValueAvailable := r.meters.GetPtt() != meters.Ptt
+
+	for _, name := range r.settings.Meters.meterSet(r.state.Vfo.Mode, r.state.Ptt) {
+		if !r.canGetLevel(name) {
+			continue
+		}
+		value, err := r.rig.GetLevel(vfo, name)
+		if isTransportError(err) {
+			return err
+		}
+		if err != nil {
+			if !r.meterErrors[name] {
+				log.Printf("unable to read meter %s: %v\n", name, err)
+				r.meterErrors[name] = true
+			}
+			continue
+		}
+		meters.Values[name] = value
+		if old, ok := r.meters.Values[name]; !ok || old != value {
+			newValueAvailable = true
+		}
+	}
+
+	meters.Timestamp = time.Now().UnixNano()
+
+	if !newValueAvailable {
+		return nil
+	}
+
+	r.meters = meters
+
+	return r.sendMeters()
+}
+
+func (r *radio) canGetLevel(name string) bool {
+	for _, l := range r.caps.GetLevels {
+		if l.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+func (r *radio) canSetLevel(name string) bool {
+	for _, l := range r.caps.SetLevels {
+		if l.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+// sendMeters publishes the meter readings. Meters are not retained since
+// they are outdated after the next polling interval.
+func (r *radio) sendMeters() error {
+
+	data, err := r.meters.Marshal()
+	if err != nil {
+		return err
+	}
+
+	r.settings.ToWireCh <- comms.IOMsg{
+		Topic: r.settings.MetersTopic,
+		Data:  data,
+	}
+
+	return nil
+}
+
+// logUnsupportedMeters informs the operator about configured meters
+// which the rig can not read.
+func (r *radio) logUnsupportedMeters() {
+	ms := r.settings.Meters
+	sets := [][]string{ms.Rx, ms.Tx}
+	for _, set := range ms.Modes {
+		sets = append(sets, set.Rx, set.Tx)
+	}
+	logged := make(map[string]bool)
+	for _, set := range sets {
+		for _, name := range set {
+			if !r.canGetLevel(name) && !logged[name] {
+				log.Printf("meter %s not supported by the rig\n", name)
+				logged[name] = true
+			}
+		}
+	}
+}
@@ -0,0 +1,219 @@
+package radio
+
+import (
+	"errors"
+	"log"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/dh1tw/remoteRadio/comms"
+	sbRadio "github.com/dh1tw/remoteRadio/sb_radio"
+)
+
+// morseMsg is the CW message which is currently being sent. The text is
+// handed over to the rig word by word, so that the rig remains available
+// for other jobs (e.g. PTT off) and the progress can be reported.
+type morseMsg struct {
+	userID    string
+	requestID string
+	words     []string
+	sent      int
+	total     int
+}
+
+var errMorseInProgress = errors.New("CW message in progress")
+
+// queueMorseRequest is called by the intake goroutine. Aborts jump the
+// queue, like unkeying the transmitter.
+func (r *radio) queueMorseRequest(msg []byte) {
+
+	req := sbRadio.SendMorse{}
+	if err := req.Unmarshal(msg); err != nil {
+		log.Println(err)
+		return
+	}
+
+	if req.GetAbort() {
+		r.sched.push(job{
+			name: "morse_abort",
+			prio: prioSafety,
+			run:  func(job) { r.abortMorse(req.GetUserId()) },
+		})
+		return
+	}
+
+	r.sched.push(job{
+		name:   "morse",
+		prio:   prioUser,
+		keying: true,
+		run:    func(job) { r.startMorse(&req) },
+		cancel: func(job) {
+			r.sendMorseProgress(sbRadio.MorseProgress{
+				RequestId: req.GetRequestId(),
+				UserId:    req.GetUserId(),
+				Total:     int32(utf8.RuneCountInString(req.GetText())),
+				Done:      true,
+				Error:     errCancelled.Error(),
+			})
+		},
+	})
+}
+
+// startMorse validates a SendMorse request, sets the keyer speed and
+// sends the first word.
+func (r *radio) startMorse(req *sbRadio.SendMorse) {
+
+	progress := sbRadio.MorseProgress{
+		RequestId: req.GetRequestId(),
+		UserId:    req.GetUserId(),
+		Total:     int32(utf8.RuneCountInString(req.GetText())),
+		Done:      true,
+	}
+
+	if err := r.checkMorse(req); err != nil {
+		progress.Error = err.Error()
+		r.sendMorseProgress(progress)
+		return
+	}
+
+	if speed := req.GetSpeed(); speed > 0 {
+		vfo := r.state.CurrentVfo
+		if err := r.rig.SetLevel(vfo, "KEYSPD", float32(speed)); err != nil {
+			progress.Error = err.Error()
+			r.sendMorseProgress(progress)
+			return
+		}
+		r.state.Vfo.Levels["KEYSPD"] = float32(speed)
+		if err := r.sendState(); err != nil {
+			log.Println(err)
+		}
+	}
+
+	words := strings.SplitAfter(req.GetText(), " ")
+
+	r.morse = &morseMsg{
+		userID:    req.GetUserId(),
+		requestID: req.GetRequestId(),
+		words:     words,
+		total:     int(progress.Total),
+	}
+
+	r.sendMorseWord(r.morse)
+}
+
+func (r *radio) checkMorse(req *sbRadio.SendMorse) error {
+	if !r.conn.Connected() {
+		return errRigDisconnected
+	}
+	if !r.state.RadioOn {
+		return errRadioOff
+	}
+	if err := r.checkLock(req.GetUserId()); err != nil {
+		return err
+	}
+	if r.morse != nil {
+		return errMorseInProgress
+	}
+	if strings.TrimSpace(req.GetText()) == "" {
+		return errors.New("CW message is empty")
+	}
+	if req.GetSpeed() < 0 {
+		return errors.New("invalid CW speed")
+	}
+	if req.GetSpeed() > 0 && !r.canSetLevel("KEYSPD") {
+		return errors.New("rig doesn't support setting the CW speed")
+	}
+	// the message is sent in CW, regardless of the vfo's mode
+	freq, _ := r.txFrequency()
+	return r.checkTx(req.GetUserId(), freq, "CW", r.state.Vfo.Levels["RFPOWER"])
+}
+
+// sendMorseWord hands the next word over to the rig and queues the
+// following one behind the jobs which have been queued in the meantime.
+func (r *radio) sendMorseWord(m *morseMsg) {
+
+	if r.morse != m {
+		// aborted
+		return
+	}
+
+	progress := sbRadio.MorseProgress{
+		RequestId: m.requestID,
+		UserId:    m.userID,
+		Total:     int32(m.total),
+	}
+
+	word := m.words[0]
+	m.words = m.words[1:]
+
+	if err := r.rig.SendMorse(r.state.CurrentVfo, word); err != nil {
+		r.morse = nil
+		progress.Sent = int32(m.sent)
+		progress.Done = true
+		progress.Error = err.Error()
+		r.sendMorseProgress(progress)
+		return
+	}
+
+	m.sent += utf8.RuneCountInString(word)
+	progress.Sent = int32(m.sent)
+
+	if len(m.words) == 0 {
+		r.morse = nil
+		progress.Done = true
+		r.sendMorseProgress(progress)
+		return
+	}
+
+	r.sendMorseProgress(progress)
+
+	r.sched.push(job{
+		name: "morse",
+		prio: prioUser,
+		run:  func(job) { r.sendMorseWord(m) },
+	})
+}
+
+// abortMorse drops the remaining words and stops the keyer. Anybody
+// may abort a CW message, since it keys the transmitter.
+func (r *radio) abortMorse(userID string) {
+
+	m := r.morse
+	if m == nil {
+		return
+	}
+	r.morse = nil
+
+	log.Printf("%s aborted the CW message of %s\n", userID, m.userID)
+
+	if r.conn.Connected() {
+		if err := r.rig.StopMorse(r.state.CurrentVfo); err != nil {
+			// not supported by all rigs; the words which have
+			// already been handed over will still be sent
+			log.Println("unable to stop the keyer:", err)
+		}
+	}
+
+	r.sendMorseProgress(sbRadio.MorseProgress{
+		RequestId: m.requestID,
+		UserId:    m.userID,
+		Sent:      int32(m.sent),
+		Total:     int32(m.total),
+		Done:      true,
+		Aborted:   true,
+	})
+}
+
+func (r *radio) sendMorseProgress(progress sbRadio.MorseProgress) {
+
+	data, err := progress.Marshal()
+	if err != nil {
+		log.Println(err)
+		return
+	}
+
+	r.settings.ToWireCh <- comms.IOMsg{
+		Topic: r.settings.MorseProgressTopic,
+		Data:  data,
+	}
+}
@@ -0,0 +1,17 @@
+//go:build !cgo
+
+package radio
+
+import "errors"
+
+// HamlibSettings are empty since the Hamlib backend is not available
+// without cgo.
+type HamlibSettings struct{}
+
+func newHamlibRig(rs RadioSettings) (Rig, error) {
+	return nil, errors.New("hamlib backend not available (built without cgo)")
+}
+
+func setHamlibDebugCallback(fn func(level int, msg string)) {}
+
+func setHamlibDebugLevel(level int) {}
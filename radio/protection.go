@@ -0,0 +1,244 @@
+package radio
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/dh1tw/remoteRadio/comms"
+	sbRadio "github.com/dh1tw/remoteRadio/sb_radio"
+)
+
+// ProtectionSettings configure the SWR / ALC protection. The transmitter
+// is unkeyed if a reading stays above its limit for longer than the
+// Duration. The limits can be overridden per band. A limit of 0 disables
+// the protection for this meter. After an alarm, RFPOWER is reduced to
+// ReducePower (0..1) unless it is 0.
+type ProtectionSettings struct {
+	MaxSwr      float32          `mapstructure:"max_swr"`
+	MaxAlc      float32          `mapstructure:"max_alc"`
+	Duration    time.Duration    `mapstructure:"duration"`
+	ReducePower float32          `mapstructure:"reduce_power"`
+	Bands       []ProtectionBand `mapstructure:"bands"`
+}
+
+// ProtectionBand overrides the limits within a frequency range [Hz].
+// Limits which are not set (0) are taken from the ProtectionSettings.
+type ProtectionBand struct {
+	Name   string  `mapstructure:"name"`
+	From   float64 `mapstructure:"from"`
+	To     float64 `mapstructure:"to"`
+	MaxSwr float32 `mapstructure:"max_swr"`
+	MaxAlc float32 `mapstructure:"max_alc"`
+}
+
+// Validate checks the protection settings for inconsistencies.
+func (ps *ProtectionSettings) Validate() error {
+	if ps.MaxSwr < 0 || ps.MaxAlc < 0 || ps.Duration < 0 {
+		return errors.New("limits and duration must not be negative")
+	}
+	if ps.MaxSwr > 0 && ps.MaxSwr < 1 {
+		return errors.New("max_swr must be >= 1")
+	}
+	if ps.ReducePower < 0 || ps.ReducePower > 1 {
+		return errors.New("reduce_power must be within 0..1")
+	}
+	for _, b := range ps.Bands {
+		if b.From <= 0 || b.To <= b.From {
+			return fmt.Errorf("invalid band %s (%.0f-%.0fHz)", b.Name, b.From, b.To)
+		}
+		if b.MaxSwr < 0 || b.MaxAlc < 0 || (b.MaxSwr > 0 && b.MaxSwr < 1) {
+			return fmt.Errorf("band %s: invalid limits", b.Name)
+		}
+	}
+	return nil
+}
+
+// enabled returns true if any limit has been configured.
+func (ps *ProtectionSettings) enabled() bool {
+	if ps.MaxSwr > 0 || ps.MaxAlc > 0 {
+		return true
+	}
+	for _, b := range ps.Bands {
+		if b.MaxSwr > 0 || b.MaxAlc > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// limits returns the limits which apply on the given frequency
+// together with the name of the band (if any).
+func (ps *ProtectionSettings) limits(freq float64) (maxSwr, maxAlc float32, band string) {
+	maxSwr, maxAlc = ps.MaxSwr, ps.MaxAlc
+	for _, b := range ps.Bands {
+		if freq < b.From || freq > b.To {
+			continue
+		}
+		if b.MaxSwr > 0 {
+			maxSwr = b.MaxSwr
+		}
+		if b.MaxAlc > 0 {
+			maxAlc = b.MaxAlc
+		}
+		return maxSwr, maxAlc, b.Name
+	}
+	return maxSwr, maxAlc, ""
+}
+
+// protectionInterval is the interval at which SWR and ALC are checked
+// while transmitting.
+const protectionInterval = time.Millisecond * 200
+
+// protection keeps track of how long the limits have been exceeded.
+// A zero time means that the reading is within its limit.
+type protection struct {
+	swrSince time.Time
+	alcSince time.Time
+}
+
+// checkProtection reads SWR and ALC while transmitting and unkeys the
+// transmitter if a limit has been exceeded for too long. It is executed
+// as a safety job every protectionInterval, independent of the polling
+// interval of the meters. Only transport errors are returned.
+func (r *radio) checkProtection() error {
+
+	ps := &r.settings.Protection
+
+	if !r.conn.Connected() || !r.state.Ptt || !ps.enabled() {
+		r.protection = protection{}
+		return nil
+	}
+
+	freq, _ := r.txFrequency()
+	maxSwr, maxAlc, band := ps.limits(freq)
+
+	// the last published meter readings are attached to the alarm
+	values := make(map[string]float32, len(r.meters.Values)+2)
+	for name, value := range r.meters.Values {
+		values[name] = value
+	}
+
+	checks := []struct {
+		meter string
+		limit float32
+		since *time.Time
+	}{
+		{"SWR", maxSwr, &r.protection.swrSince},
+		{"ALC", maxAlc, &r.protection.alcSince},
+	}
+
+	for _, c := range checks {
+		if c.limit <= 0 || !r.canGetLevel(c.meter) {
+			*c.since = time.Time{}
+			continue
+		}
+
+		value, err := r.rig.GetLevel(r.state.CurrentVfo, c.meter)
+		if isTransportError(err) {
+			return err
+		}
+		if err != nil {
+			if !r.meterErrors[c.meter] {
+				log.Printf("unable to read meter %s: %v\n", c.meter, err)
+				r.meterErrors[c.meter] = true
+			}
+			continue
+		}
+		values[c.meter] = value
+
+		if value <= c.limit {
+			*c.since = time.Time{}
+			continue
+		}
+
+		if c.since.IsZero() {
+			*c.since = time.Now()
+		}
+
+		exceeded := time.Since(*c.since)
+		if exceeded < ps.Duration {
+			continue
+		}
+
+		r.tripProtection(sbRadio.Alarm{
+			Meter:     c.meter,
+			Value:     value,
+			Limit:     c.limit,
+			Duration:  int64(exceeded / time.Millisecond),
+			Frequency: freq,
+			Band:      band,
+			UserId:    r.pttOwner,
+			Meters:    values,
+		})
+		return nil
+	}
+
+	return nil
+}
+
+// tripProtection unkeys the transmitter, optionally reduces the power
+// and publishes the alarm.
+func (r *radio) tripProtection(alarm sbRadio.Alarm) {
+
+	reason := fmt.Sprintf("%s protection: %s %.2f exceeded the limit of %.2f for %dms",
+		alarm.Meter, alarm.Meter, alarm.Value, alarm.Limit, alarm.Duration)
+
+	if r.morse != nil {
+		r.abortMorse(alarm.Meter + " protection")
+	}
+
+	if err := r.updatePtt(false); err != nil {
+		// we will try again with the next reading
+		log.Printf("%s: unable to unkey the transmitter: %v\n", reason, err)
+		return
+	}
+
+	log.Println(reason)
+
+	r.protection = protection{}
+	r.state.PttOffReason = reason
+
+	if power := r.settings.Protection.ReducePower; power > 0 && r.canSetLevel("RFPOWER") &&
+		r.state.Vfo.Levels["RFPOWER"] > power {
+		if err := r.rig.SetLevel(r.state.CurrentVfo, "RFPOWER", power); err != nil {
+			log.Println("unable to reduce RFPOWER:", err)
+		} else {
+			r.state.Vfo.Levels["RFPOWER"] = power
+		}
+	}
+
+	alarm.Rfpower = r.state.Vfo.Levels["RFPOWER"]
+	alarm.Timestamp = time.Now().UnixNano()
+
+	if err := r.sendAlarm(alarm); err != nil {
+		log.Println(err)
+	}
+
+	e := r.newError("ptt", errors.New(reason), r.pttOwner, "")
+	if err := r.sendError(e); err != nil {
+		log.Println(err)
+	}
+	if err := r.sendState(); err != nil {
+		log.Println(err)
+	}
+
+	r.pttOwner = ""
+}
+
+// sendAlarm publishes the alarm on the (non retained) alarm topic.
+func (r *radio) sendAlarm(alarm sbRadio.Alarm) error {
+
+	data, err := alarm.Marshal()
+	if err != nil {
+		return err
+	}
+
+	r.settings.ToWireCh <- comms.IOMsg{
+		Topic: r.settings.AlarmTopic,
+		Data:  data,
+	}
+
+	return nil
+}
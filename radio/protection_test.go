@@ -0,0 +1,60 @@
+package radio
+
+import (
+	"testing"
+
+	sbRadio "github.com/dh1tw/remoteRadio/sb_radio"
+)
+
+// TestCheckProtection verifies that the protection reads SWR / ALC by
+// itself, without any meters being polled. While transmitting, the
+// simulator reports an SWR between 1.0 and 1.6.
+func TestCheckProtection(t *testing.T) {
+
+	tests := []struct {
+		name        string
+		settings    ProtectionSettings
+		wantPtt     bool
+		wantRfpower float32
+	}{
+		{"disabled", ProtectionSettings{}, true, 1},
+		{"within limit", ProtectionSettings{MaxSwr: 3}, true, 1},
+		{"swr exceeded", ProtectionSettings{MaxSwr: 1}, false, 1},
+		{"power reduced", ProtectionSettings{MaxSwr: 1, ReducePower: 0.25}, false, 0.25},
+		{"band limit", ProtectionSettings{MaxSwr: 3,
+			Bands: []ProtectionBand{{Name: "20m", From: 14000000, To: 14350000, MaxSwr: 1}}}, false, 1},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			r := newTestRadio(t, RadioSettings{Protection: tc.settings, AlarmTopic: "test/cat/alarm"})
+
+			setState(t, r, sbRadio.SetState{UserId: "dh1tw", Md: &sbRadio.MetaData{HasPtt: true}, Ptt: true})
+			if !r.state.Ptt {
+				t.Fatal("unable to key the transmitter")
+			}
+			drain(r)
+
+			if err := r.checkProtection(); err != nil {
+				t.Fatal(err)
+			}
+
+			if r.state.Ptt != tc.wantPtt {
+				t.Errorf("ptt = %v, want %v", r.state.Ptt, tc.wantPtt)
+			}
+			if rfpower := r.state.Vfo.Levels["RFPOWER"]; rfpower != tc.wantRfpower {
+				t.Errorf("RFPOWER = %v, want %v", rfpower, tc.wantRfpower)
+			}
+
+			alarms := 0
+			for _, msg := range drain(r) {
+				if msg.Topic == r.settings.AlarmTopic {
+					alarms++
+				}
+			}
+			if tripped := !tc.wantPtt; (alarms > 0) != tripped {
+				t.Errorf("%d alarms published", alarms)
+			}
+		})
+	}
+}
@@ -1,37 +1,80 @@
 package radio
 
 import (
-	"errors"
 	"log"
 	"sync"
 
 	"time"
 
 	"github.com/cskr/pubsub"
-	hl "github.com/dh1tw/goHamlib"
 	"github.com/dh1tw/remoteRadio/comms"
+	"github.com/dh1tw/remoteRadio/connection"
 	"github.com/dh1tw/remoteRadio/events"
 	sbRadio "github.com/dh1tw/remoteRadio/sb_radio"
+	"github.com/dh1tw/remoteRadio/utils"
 )
 
 type RadioSettings struct {
-	RigModel         int
-	Port             hl.Port
-	HlDebugLevel     int
-	CatRequestCh     chan []byte
-	ToWireCh         chan comms.IOMsg
-	CatResponseTopic string
-	CapsTopic        string
-	WaitGroup        *sync.WaitGroup
-	Events           *pubsub.PubSub
-	PollingInterval  time.Duration
+	ID                 string // used in the logs and metrics
+	Backend            string
+	RigModel           int
+	Hamlib             HamlibSettings
+	HlDebugLevel       int         // initial level of the rig's debug output
+	LogLevelCh         chan []byte // requests to change the level
+	LogTopic           string
+	CatRequestCh       chan comms.IOMsg
+	ToWireCh           chan comms.IOMsg
+	CatResponseTopic   string
+	DeltaTopic         string
+	EchoTopic          string // requested values of coalescable fields
+	ResyncRequestCh    chan []byte
+	SnapshotInterval   time.Duration // 0 = snapshots only on request
+	CapsTopic          string
+	ErrorTopic         string
+	AckTopic           string
+	WaitGroup          *sync.WaitGroup
+	Events             *pubsub.PubSub
+	PollingInterval    time.Duration
+	ResyncInterval     time.Duration // 0 = don't re-read the radio's state
+	MaxTxTime          time.Duration // 0 = no TX timeout
+	PingTimeout        time.Duration // 0 = don't watch the client's pings
+	BandPlan           *BandPlan     // nil = no transmit restrictions
+	RejectOutOfRange   bool          // reject levels & parameters out of range instead of clamping them
+	LockRequestCh      chan comms.IOMsg
+	LockLease          time.Duration // 0 = the lock never expires
+	Admins             []string      // user_ids which may take over the lock
+	MemoryRequestCh    chan comms.IOMsg
+	MemoryTopic        string
+	MetersTopic        string
+	MorseCh            chan comms.IOMsg
+	MorseProgressTopic string
+	Meters             MeterSettings
+	Protection         ProtectionSettings
+	AlarmTopic         string
+	Simulator          SimulatorSettings
 }
 
 type radio struct {
-	rig           hl.Rig
-	state         sbRadio.State
-	settings      *RadioSettings
-	pollingTicker *time.Ticker
+	rig       Rig
+	caps      sbRadio.Capabilities
+	state     sbRadio.State
+	published sbRadio.State // state as last published to the clients
+	meters    sbRadio.Meters
+	settings  *RadioSettings
+	sched     *scheduler
+	pttOwner  string
+	pttSince  time.Time
+	lastPing  map[string]time.Time
+	// the lease of the lock; published as State.LockExpires
+	lockDeadline time.Time
+	// connection to the rig
+	conn        *connection.Monitor
+	meterErrors map[string]bool // meters which failed to read; logged once
+	// changes of the polling interval for the intake goroutine
+	pollingIntervalCh chan time.Duration
+	rigLog            *rigLog
+	morse             *morseMsg // CW message in progress
+	protection        protection
 }
 
 func HandleRadio(rs RadioSettings) {
@@ -39,95 +82,240 @@ func HandleRadio(rs RadioSettings) {
 	defer rs.WaitGroup.Done()
 
 	shutdownCh := rs.Events.Sub(events.Shutdown)
+	pingCh := rs.Events.Sub(events.RadioPing(rs.ID))
 
 	r := radio{}
-	r.rig = hl.Rig{}
 	r.state = sbRadio.State{}
 	r.state.Vfo = &sbRadio.Vfo{}
 	r.state.Channel = &sbRadio.Channel{}
 	r.settings = &rs
+	r.lastPing = make(map[string]time.Time)
+	r.pollingIntervalCh = make(chan time.Duration, 1)
+	r.conn = connection.NewMonitor("rig", rs.ID, rs.Events, events.RigStatus)
+	r.rigLog = newRigLog(rs.HlDebugLevel)
+
+	hamlibLogs.add(r.rigLog)
+	defer hamlibLogs.remove(r.rigLog)
 
 	r.state.PollingInterval = int32(r.settings.PollingInterval.Nanoseconds() / 1000000)
 
-	err := r.rig.Init(rs.RigModel)
-	if err != nil {
+	// an invalid configuration can not be fixed by reconnecting
+	if err := checkBackend(rs.Backend); err != nil {
 		log.Println(err)
+		r.settings.Events.Pub(true, events.Shutdown)
 		return
 	}
 
-	r.rig.SetDebugLevel(rs.HlDebugLevel)
+	r.sched = newScheduler(r.settings.ID)
+	done := make(chan struct{})
+	go r.intake(pingCh, done)
 
-	err = r.rig.SetPort(rs.Port)
-	if err != nil {
-		// if we can not set the port, we shut down
-		log.Println(err)
-		r.settings.Events.Pub(true, events.Shutdown)
-		return
+	if err := r.connect(); err != nil {
+		r.disconnect(err)
 	}
 
-	if rs.RigModel != 1 { // exception for Dummy Rig
-		if err := r.rig.Open(); err != nil {
-			// if we can not open the port, we shut down
-			log.Println(err)
-			r.settings.Events.Pub(true, events.Shutdown)
+	// the rig is only accessed from this goroutine; the jobs are
+	// executed one by one in the order of their priority
+	for {
+		select {
+		case <-shutdownCh:
+			close(done)
+			log.Println("Disconnecting from Radio")
+			if r.conn.Connected() {
+				r.rig.Close()
+			}
 			return
+
+		case <-r.sched.ready:
+			if j, ok := r.sched.pop(); ok {
+				r.sched.exec(j)
+			}
+			if r.conn.Lost() {
+				r.disconnect(r.conn.LastError())
+			}
+
+		// only armed while the rig is disconnected
+		case <-r.conn.Reconnect():
+			if err := r.connect(); err != nil {
+				r.disconnect(err)
+			}
 		}
 	}
+}
 
-	// publish the radio's capabilities
-	if err := r.sendCaps(); err != nil {
-		log.Println(err)
+// intake receives the requests from the clients and the timer events
+// and queues them as jobs on the scheduler.
+func (r *radio) intake(pingCh chan interface{}, done chan struct{}) {
+
+	pollingInterval := r.settings.PollingInterval
+	pollingTicker := time.NewTicker(pollingInterval)
+	watchdogTicker := time.NewTicker(time.Millisecond * 500)
+
+	// a nil channel blocks forever and disables the resync
+	var resyncCh <-chan time.Time
+	if r.settings.ResyncInterval > 0 {
+		resyncTicker := time.NewTicker(r.settings.ResyncInterval)
+		defer resyncTicker.Stop()
+		resyncCh = resyncTicker.C
 	}
 
-	// check if the radio is turned on and query its state
-	rigOn, err := r.rig.GetPowerStat()
-	if err != nil {
-		log.Println(err)
-		// we should check if the rig might no have the
-		// ability to be turn on/off through CapsTopic
+	// SWR / ALC are checked independently of the polling interval
+	var protectionCh <-chan time.Time
+	if r.settings.Protection.enabled() {
+		protectionTicker := time.NewTicker(protectionInterval)
+		defer protectionTicker.Stop()
+		protectionCh = protectionTicker.C
+	}
 
-		// let's hope for the best and query it
-		if err := r.queryVfo(); err != nil {
-			log.Println(err)
-		}
-	} else {
-		// no error and the rig is on so we can query it
-		if rigOn == hl.RIG_POWER_ON {
-			if err := r.queryVfo(); err != nil {
+	var snapshotCh <-chan time.Time
+	if r.settings.SnapshotInterval > 0 {
+		snapshotTicker := time.NewTicker(r.settings.SnapshotInterval)
+		defer snapshotTicker.Stop()
+		snapshotCh = snapshotTicker.C
+	}
+
+	defer func() {
+		pollingTicker.Stop()
+		watchdogTicker.Stop()
+	}()
+
+	for {
+		select {
+		case <-done:
+			return
+
+		case msg := <-r.settings.CatRequestCh:
+			if err := checkRequestUser(msg, &sbRadio.SetState{}); err != nil {
+				log.Println(err)
+				continue
+			}
+			r.queueCatRequest(msg.Data)
+
+		case msg := <-r.settings.LockRequestCh:
+			if err := checkRequestUser(msg, &sbRadio.Lock{}); err != nil {
+				log.Println(err)
+				continue
+			}
+			r.sched.push(job{
+				name: "lock",
+				prio: prioUser,
+				run:  func(job) { r.handleLockRequest(msg.Data) },
+			})
+
+		case msg := <-r.settings.MemoryRequestCh:
+			if err := checkRequestUser(msg, &sbRadio.MemoryRequest{}); err != nil {
+				log.Println(err)
+				continue
+			}
+			r.sched.push(job{
+				name: "memory",
+				prio: prioUser,
+				run:  func(job) { r.handleMemoryRequest(msg.Data) },
+			})
+
+		case msg := <-r.settings.MorseCh:
+			if err := checkRequestUser(msg, &sbRadio.SendMorse{}); err != nil {
 				log.Println(err)
+				continue
+			}
+			r.queueMorseRequest(msg.Data)
+
+		case msg := <-r.settings.LogLevelCh:
+			r.sched.push(job{
+				name: "loglevel",
+				prio: prioUser,
+				run:  func(job) { r.handleLogLevelRequest(msg) },
+			})
+
+		case line := <-r.rigLog.lines:
+			r.publishLogLine(line)
+
+		case d := <-r.pollingIntervalCh:
+			pollingTicker.Stop()
+			pollingInterval = d
+			if d > 0 {
+				pollingTicker = time.NewTicker(d)
 			}
-		} else {
-			r.state.RadioOn = false
+
+		case <-pollingTicker.C:
+			r.sched.push(job{
+				name:   "meter",
+				prio:   prioPoll,
+				maxAge: pollingInterval,
+				run:    func(job) { r.checkRigError(r.updateMeter()) },
+			})
+
+		case <-protectionCh:
+			r.sched.push(job{
+				name: "protection",
+				prio: prioSafety,
+				key:  "protection",
+				run:  func(job) { r.checkRigError(r.checkProtection()) },
+			})
+
+		case <-resyncCh:
+			r.sched.push(job{
+				name:   "resync",
+				prio:   prioPoll,
+				maxAge: r.settings.ResyncInterval,
+				run:    func(job) { r.handleResync() },
+			})
+
+		case msg := <-r.settings.ResyncRequestCh:
+			r.sched.push(job{
+				name: "snapshot",
+				prio: prioUser,
+				key:  "snapshot",
+				run:  func(job) { r.handleResyncRequest(msg) },
+			})
+
+		case <-snapshotCh:
+			r.sched.push(job{
+				name: "snapshot",
+				prio: prioPoll,
+				run:  func(job) { r.sendSnapshot() },
+			})
+
+		case <-watchdogTicker.C:
+			r.sched.push(job{
+				name: "watchdog",
+				prio: prioSafety,
+				run:  func(job) { r.handleWatchdog() },
+			})
+
+		case ev := <-pingCh:
+			// bookkeeping only, but it must not be delayed by slow
+			// requests or the watchdog might unkey the transmitter
+			userID := ev.(string)
+			now := time.Now()
+			r.sched.push(job{
+				name: "ping",
+				prio: prioSafety,
+				run:  func(job) { r.lastPing[userID] = now },
+			})
 		}
 	}
+}
 
-	err = r.rig.SetConf("fast_commands_token", "1")
+func (r *radio) handleLockRequest(msg []byte) {
+	ack, err := r.deserializeLockRequest(msg)
 	if err != nil {
 		log.Println(err)
+		return
 	}
-
-	// publish the radio's state
 	if err := r.sendState(); err != nil {
 		log.Println(err)
 	}
+	if err := r.sendAck(ack); err != nil {
+		log.Println(err)
+	}
+}
 
-	r.pollingTicker = time.NewTicker(r.settings.PollingInterval)
-
-	for {
-		select {
-		case msg := <-rs.CatRequestCh:
-			r.deserializeCatRequest(msg)
-			r.sendState()
-
-		case <-shutdownCh:
-			log.Println("Disconnecting from Radio")
-			// maybe we have to check if the connection is really open
-			r.rig.Close()
-			r.rig.Cleanup()
-			return
-
-		case <-r.pollingTicker.C:
-			r.updateMeter()
+func (r *radio) handleWatchdog() {
+	r.checkTxWatchdog()
+	if r.checkLockExpired() {
+		if err := r.sendState(); err != nil {
+			log.Println(err)
 		}
 	}
 }
@@ -137,17 +325,13 @@ func (r *radio) queryVfo() error {
 	if err != nil {
 		return err
 	}
-	r.state.CurrentVfo = hl.VfoName[vfo]
+	r.state.CurrentVfo = vfo
 
-	if pwrOn, err := r.rig.GetPowerStat(); err != nil {
+	pwrOn, err := r.rig.GetPowerStat()
+	if err != nil {
 		return err
-	} else {
-		if pwrOn == hl.RIG_POWER_ON {
-			r.state.RadioOn = true
-		} else {
-			r.state.RadioOn = false
-		}
 	}
+	r.state.RadioOn = pwrOn
 
 	freq, err := r.rig.GetFreq(vfo)
 	if err != nil {
@@ -159,12 +343,7 @@ func (r *radio) queryVfo() error {
 	if err != nil {
 		return err
 	}
-	if modeName, ok := hl.ModeName[mode]; ok {
-		r.state.Vfo.Mode = modeName
-	} else {
-		return errors.New("unknown mode")
-	}
-
+	r.state.Vfo.Mode = mode
 	r.state.Vfo.PbWidth = int32(pbWidth)
 
 	ant, err := r.rig.GetAnt(vfo)
@@ -192,13 +371,9 @@ func (r *radio) queryVfo() error {
 		return err
 	}
 
-	if splitOn == hl.RIG_SPLIT_ON {
-		split.Enabled = true
-	} else {
-		split.Enabled = false
-	}
+	split.Enabled = splitOn
 
-	if splitOn == hl.RIG_SPLIT_ON {
+	if splitOn {
 
 		txFreq, err := r.rig.GetSplitFreq(txVfo)
 		if err != nil {
@@ -210,20 +385,9 @@ func (r *radio) queryVfo() error {
 			return err
 		}
 		split.Frequency = txFreq
-		if txVfoName, ok := hl.VfoName[txVfo]; ok {
-			split.Vfo = txVfoName
-		} else {
-			return errors.New("unknown Vfo Name")
-		}
-
-		if txModeName, ok := hl.ModeName[txMode]; ok {
-			split.Mode = txModeName
-		} else {
-			return errors.New("unknown Mode")
-		}
-
+		split.Vfo = txVfo
+		split.Mode = txMode
 		split.PbWidth = int32(txPbWidth)
-
 	}
 
 	r.state.Vfo.Split = &split
@@ -234,10 +398,12 @@ func (r *radio) queryVfo() error {
 	}
 	r.state.Vfo.TuningStep = int32(tStep)
 
-	r.state.Vfo.Functions = make([]string, 0, len(hl.FuncName))
+	r.queryTones(vfo)
 
-	for _, f := range r.rig.Caps.GetFunctions {
-		fValue, err := r.rig.GetFunc(vfo, hl.FuncValue[f])
+	r.state.Vfo.Functions = make([]string, 0, len(r.caps.GetFunctions))
+
+	for _, f := range r.caps.GetFunctions {
+		fValue, err := r.rig.GetFunc(vfo, f)
 		if err != nil {
 			return err
 		}
@@ -247,8 +413,12 @@ func (r *radio) queryVfo() error {
 	}
 
 	r.state.Vfo.Levels = make(map[string]float32)
-	for _, level := range r.rig.Caps.GetLevels {
-		lValue, err := r.rig.GetLevel(vfo, hl.LevelValue[level.Name])
+	for _, level := range r.caps.GetLevels {
+		// read-only levels are meters and published separately
+		if !r.canSetLevel(level.Name) {
+			continue
+		}
+		lValue, err := r.rig.GetLevel(vfo, level.Name)
 		if err != nil {
 			// return err
 			log.Println("Warning:", level.Name, "-", err)
@@ -257,8 +427,8 @@ func (r *radio) queryVfo() error {
 	}
 
 	r.state.Vfo.Parameters = make(map[string]float32)
-	for _, param := range r.rig.Caps.GetParameters {
-		pValue, err := r.rig.GetParm(vfo, hl.ParmValue[param.Name])
+	for _, param := range r.caps.GetParameters {
+		pValue, err := r.rig.GetParm(vfo, param.Name)
 		if err != nil {
 			return err
 		}
@@ -268,21 +438,72 @@ func (r *radio) queryVfo() error {
 	return nil
 }
 
+// sendState publishes the changes since the last published state
+// as a StateDelta. Nothing is published if the state hasn't changed.
 func (r *radio) sendState() error {
 
-	if state, err := r.state.Marshal(); err == nil {
-		stateMsg := comms.IOMsg{}
-		stateMsg.Data = state
-		stateMsg.Retain = true
-		stateMsg.Topic = r.settings.CatResponseTopic
-		r.settings.ToWireCh <- stateMsg
-	} else {
+	delta := utils.StateDiff(&r.published, &r.state)
+	if len(delta.Changed) == 0 {
+		return nil
+	}
+
+	r.state.Version++
+	delta.Version = r.state.Version
+
+	data, err := delta.Marshal()
+	if err != nil {
 		return err
 	}
 
+	deltaMsg := comms.IOMsg{}
+	deltaMsg.Data = data
+	deltaMsg.Topic = r.settings.DeltaTopic
+	r.settings.ToWireCh <- deltaMsg
+
+	r.published, err = utils.CopyState(&r.state)
+	return err
+}
+
+// sendSnapshot publishes the complete state. The snapshot is retained
+// so that clients which join late and clients which have missed a
+// StateDelta can synchronize. Pending changes are published as a
+// StateDelta first, so that the version is never incremented without
+// a delta.
+func (r *radio) sendSnapshot() error {
+
+	if err := r.sendState(); err != nil {
+		return err
+	}
+
+	state, err := r.state.Marshal()
+	if err != nil {
+		return err
+	}
+
+	stateMsg := comms.IOMsg{}
+	stateMsg.Data = state
+	stateMsg.Retain = true
+	stateMsg.Topic = r.settings.CatResponseTopic
+	r.settings.ToWireCh <- stateMsg
+
 	return nil
 }
 
+// handleResyncRequest publishes a snapshot for a client which has
+// detected a gap in the StateDeltas.
+func (r *radio) handleResyncRequest(msg []byte) {
+	req := sbRadio.ResyncRequest{}
+	if err := req.Unmarshal(msg); err != nil {
+		log.Println(err)
+		return
+	}
+	log.Printf("resync requested by %s (version %d, current %d)\n",
+		req.GetUserId(), req.GetVersion(), r.state.Version)
+	if err := r.sendSnapshot(); err != nil {
+		log.Println(err)
+	}
+}
+
 func (r *radio) sendCaps() error {
 
 	if caps, err := r.serializeCaps(); err == nil {
@@ -298,54 +519,58 @@ func (r *radio) sendCaps() error {
 	return nil
 }
 
-func (r *radio) updateMeter() error {
+// newError creates an Error message for a field of a SetState request
+// which could not be applied to the rig.
+func (r *radio) newError(field string, err error, userID, requestID string) sbRadio.Error {
 
-	if !r.state.RadioOn {
-		return nil
-	}
+	log.Printf("unable to set %s (requested by %s): %s\n", field, userID, err)
 
-	vfo := hl.VfoValue[r.state.CurrentVfo]
+	return sbRadio.Error{
+		Field:     field,
+		Error:     err.Error(),
+		UserId:    userID,
+		RequestId: requestID,
+		Timestamp: time.Now().UnixNano(),
+	}
+}
 
-	newValueAvailable := false
+// sendError informs the clients that (a part of) a SetState request
+// could not be applied to the rig. The error is published non-retained
+// on the error topic.
+func (r *radio) sendError(e sbRadio.Error) error {
 
-	if r.state.Ptt {
-		swr, err := r.rig.GetLevel(vfo, hl.RIG_LEVEL_SWR)
-		if err != nil {
-			return err
-		}
+	data, err := e.Marshal()
+	if err != nil {
+		return err
+	}
 
-		alc, err := r.rig.GetLevel(vfo, hl.RIG_LEVEL_ALC)
-		if err != nil {
-			return err
-		}
+	errMsg := comms.IOMsg{}
+	errMsg.Data = data
+	errMsg.Topic = r.settings.ErrorTopic
+	r.settings.ToWireCh <- errMsg
 
-		if r.state.Vfo.Levels["SWR"] != swr {
-			r.state.Vfo.Levels["SWR"] = swr
-			newValueAvailable = true
-		}
+	return nil
+}
 
-		if r.state.Vfo.Levels["ALC"] != alc {
-			r.state.Vfo.Levels["ALC"] = alc
-			newValueAvailable = true
-		}
-	} else {
-		strength, err := r.rig.GetLevel(vfo, hl.RIG_LEVEL_STRENGTH)
-		if err != nil {
-			return err
-		}
+// sendAck publishes the result of a SetState request. Requests without
+// a request_id are not acknowledged.
+func (r *radio) sendAck(ack sbRadio.Ack) error {
 
-		if r.state.Vfo.Levels["STRENGTH"] != strength {
-			r.state.Vfo.Levels["STRENGTH"] = strength
-			newValueAvailable = true
-		}
+	if len(ack.GetRequestId()) == 0 {
+		return nil
 	}
 
-	if newValueAvailable {
-		err := r.sendState()
-		if err != nil {
-			return err
-		}
+	ack.StateVersion = r.state.Version
+
+	data, err := ack.Marshal()
+	if err != nil {
+		return err
 	}
 
+	ackMsg := comms.IOMsg{}
+	ackMsg.Data = data
+	ackMsg.Topic = r.settings.AckTopic
+	r.settings.ToWireCh <- ackMsg
+
 	return nil
 }
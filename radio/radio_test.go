@@ -0,0 +1,135 @@
+package radio
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cskr/pubsub"
+	"github.com/dh1tw/remoteRadio/comms"
+	"github.com/dh1tw/remoteRadio/connection"
+	"github.com/dh1tw/remoteRadio/events"
+	sbRadio "github.com/dh1tw/remoteRadio/sb_radio"
+)
+
+// newTestRadio returns a radio which is connected to the simulator.
+// Everything the radio publishes can be read from the ToWireCh of the
+// returned settings.
+func newTestRadio(t *testing.T, rs RadioSettings) *radio {
+	t.Helper()
+
+	rs.ID = "test"
+	rs.Backend = SimulatorBackend
+	rs.Events = pubsub.New(10)
+	rs.ToWireCh = make(chan comms.IOMsg, 1000)
+	rs.CatResponseTopic = "test/cat/state"
+	rs.DeltaTopic = "test/cat/delta"
+	rs.EchoTopic = "test/cat/echo"
+	rs.AckTopic = "test/cat/ack"
+	rs.ErrorTopic = "test/cat/error"
+	if rs.PollingInterval == 0 {
+		rs.PollingInterval = time.Millisecond * 100
+	}
+
+	r := &radio{}
+	r.state = sbRadio.State{}
+	r.state.Vfo = &sbRadio.Vfo{}
+	r.state.Channel = &sbRadio.Channel{}
+	r.settings = &rs
+	r.lastPing = make(map[string]time.Time)
+	r.pollingIntervalCh = make(chan time.Duration, 1)
+	r.conn = connection.NewMonitor("rig", rs.ID, rs.Events, events.RigStatus)
+	r.sched = newScheduler(rs.ID)
+
+	if err := r.connect(); err != nil {
+		t.Fatal(err)
+	}
+	drain(r)
+
+	return r
+}
+
+// drain returns the messages the radio has published so far.
+func drain(r *radio) []comms.IOMsg {
+	msgs := []comms.IOMsg{}
+	for {
+		select {
+		case msg := <-r.settings.ToWireCh:
+			msgs = append(msgs, msg)
+		default:
+			return msgs
+		}
+	}
+}
+
+// setState applies a SetState request and returns its Ack.
+func setState(t *testing.T, r *radio, ns sbRadio.SetState) sbRadio.Ack {
+	t.Helper()
+
+	if ns.CurrentVfo == "" {
+		ns.CurrentVfo = r.state.CurrentVfo
+	}
+	data, err := ns.Marshal()
+	if err != nil {
+		t.Fatal(err)
+	}
+	ack, err := r.deserializeCatRequest(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return ack
+}
+
+func rejected(ack sbRadio.Ack, field string) bool {
+	for _, e := range ack.Rejected {
+		if e.GetField() == field {
+			return true
+		}
+	}
+	return false
+}
+
+// TestSendSnapshot verifies that the state version is only incremented
+// together with a StateDelta.
+func TestSendSnapshot(t *testing.T) {
+	r := newTestRadio(t, RadioSettings{})
+
+	versions := func() (delta, snapshot uint64, deltas int) {
+		for _, msg := range drain(r) {
+			switch msg.Topic {
+			case r.settings.DeltaTopic:
+				d := sbRadio.StateDelta{}
+				if err := d.Unmarshal(msg.Data); err != nil {
+					t.Fatal(err)
+				}
+				delta = d.GetVersion()
+				deltas++
+			case r.settings.CatResponseTopic:
+				s := sbRadio.State{}
+				if err := s.Unmarshal(msg.Data); err != nil {
+					t.Fatal(err)
+				}
+				snapshot = s.GetVersion()
+			}
+		}
+		return
+	}
+
+	version := r.state.Version
+	r.state.Vfo.Frequency = 7025000
+	if err := r.sendSnapshot(); err != nil {
+		t.Fatal(err)
+	}
+	delta, snapshot, deltas := versions()
+	if deltas != 1 || delta != version+1 || snapshot != delta {
+		t.Errorf("pending change: %d deltas, delta version %d, snapshot version %d, want 1, %d, %d",
+			deltas, delta, snapshot, version+1, version+1)
+	}
+
+	if err := r.sendSnapshot(); err != nil {
+		t.Fatal(err)
+	}
+	_, snapshot, deltas = versions()
+	if deltas != 0 || snapshot != version+1 {
+		t.Errorf("no change: %d deltas, snapshot version %d, want 0, %d", deltas, snapshot, version+1)
+	}
+}
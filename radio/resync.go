@@ -0,0 +1,146 @@
+package radio
+
+import (
+	"log"
+	"reflect"
+
+	sbRadio "github.com/dh1tw/remoteRadio/sb_radio"
+	"github.com/dh1tw/remoteRadio/utils"
+)
+
+// resync re-reads the settings which are typically changed on the
+// front panel of the radio (power, VFO, frequency, mode, split, RIT/XIT
+// and functions) and returns the names of the fields which have changed.
+// This is a slower polling tier than updateMeter.
+func (r *radio) resync() ([]string, error) {
+
+	changed := []string{}
+
+	pwrOn, err := r.rig.GetPowerStat()
+	if err != nil {
+		return changed, err
+	}
+	if pwrOn != r.state.RadioOn {
+		r.state.RadioOn = pwrOn
+		changed = append(changed, "radio_on")
+	}
+
+	if !r.state.RadioOn {
+		return changed, nil
+	}
+
+	vfo, err := r.rig.GetVfo()
+	if err != nil {
+		return changed, err
+	}
+	if vfo != r.state.CurrentVfo {
+		r.state.CurrentVfo = vfo
+		changed = append(changed, "current_vfo")
+	}
+
+	freq, err := r.rig.GetFreq(vfo)
+	if err != nil {
+		return changed, err
+	}
+	if freq != r.state.Vfo.Frequency {
+		r.state.Vfo.Frequency = freq
+		changed = append(changed, "frequency")
+	}
+
+	mode, pbWidth, err := r.rig.GetMode(vfo)
+	if err != nil {
+		return changed, err
+	}
+	if mode != r.state.Vfo.Mode {
+		r.state.Vfo.Mode = mode
+		changed = append(changed, "mode")
+	}
+	if int32(pbWidth) != r.state.Vfo.PbWidth {
+		r.state.Vfo.PbWidth = int32(pbWidth)
+		changed = append(changed, "pb_width")
+	}
+
+	rit, err := r.rig.GetRit(vfo)
+	if err != nil {
+		return changed, err
+	}
+	if int32(rit) != r.state.Vfo.Rit {
+		r.state.Vfo.Rit = int32(rit)
+		changed = append(changed, "rit")
+	}
+
+	xit, err := r.rig.GetXit(vfo)
+	if err != nil {
+		return changed, err
+	}
+	if int32(xit) != r.state.Vfo.Xit {
+		r.state.Vfo.Xit = int32(xit)
+		changed = append(changed, "xit")
+	}
+
+	split := sbRadio.Split{}
+	splitOn, txVfo, err := r.rig.GetSplit(vfo)
+	if err != nil {
+		return changed, err
+	}
+	split.Enabled = splitOn
+	if splitOn {
+		split.Vfo = txVfo
+		split.Frequency, err = r.rig.GetSplitFreq(txVfo)
+		if err != nil {
+			return changed, err
+		}
+		txMode, txPbWidth, err := r.rig.GetSplitMode(txVfo)
+		if err != nil {
+			return changed, err
+		}
+		split.Mode = txMode
+		split.PbWidth = int32(txPbWidth)
+	}
+	if !reflect.DeepEqual(&split, r.state.Vfo.Split) {
+		r.state.Vfo.Split = &split
+		changed = append(changed, "split")
+	}
+
+	funcs := make([]string, 0, len(r.caps.GetFunctions))
+	for _, f := range r.caps.GetFunctions {
+		on, err := r.rig.GetFunc(vfo, f)
+		if err != nil {
+			return changed, err
+		}
+		if on {
+			funcs = append(funcs, f)
+		}
+	}
+	// the order of the functions doesn't matter
+	if len(funcs) != len(r.state.Vfo.Functions) ||
+		len(utils.SliceDiff(funcs, r.state.Vfo.Functions)) > 0 {
+		r.state.Vfo.Functions = funcs
+		changed = append(changed, "functions")
+	}
+
+	return changed, nil
+}
+
+// handleResync publishes the state if anything has been changed
+// locally on the radio.
+func (r *radio) handleResync() {
+
+	if !r.conn.Connected() {
+		return
+	}
+
+	changed, err := r.resync()
+	if err != nil {
+		log.Println("resync:", err)
+	}
+	r.checkRigError(err)
+
+	if len(changed) == 0 {
+		return
+	}
+
+	if err := r.sendState(); err != nil {
+		log.Println(err)
+	}
+}
@@ -0,0 +1,45 @@
+package radio
+
+import (
+	"testing"
+
+	"github.com/dh1tw/remoteRadio/utils"
+)
+
+// TestResyncFunctions verifies that functions which have been turned
+// on or off on the front panel are detected.
+func TestResyncFunctions(t *testing.T) {
+
+	tests := []struct {
+		name  string
+		state []string // functions known to be on
+		rig   []string // functions which are on
+		want  bool
+	}{
+		{"unchanged", []string{"NB"}, []string{"NB"}, false},
+		{"turned on", []string{"NB"}, []string{"NB", "NR"}, true},
+		{"turned off", []string{"NB", "NR"}, []string{"NB"}, true},
+		{"all turned off", []string{"NB"}, []string{}, true},
+		{"replaced", []string{"NB"}, []string{"NR"}, true},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			r := newTestRadio(t, RadioSettings{})
+			for _, f := range tc.rig {
+				if err := r.rig.SetFunc("VFOA", f, true); err != nil {
+					t.Fatal(err)
+				}
+			}
+			r.state.Vfo.Functions = tc.state
+
+			changed, err := r.resync()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := utils.StringInSlice("functions", changed); got != tc.want {
+				t.Errorf("functions changed = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
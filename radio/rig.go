@@ -0,0 +1,113 @@
+package radio
+
+import (
+	"fmt"
+
+	sbRadio "github.com/dh1tw/remoteRadio/sb_radio"
+)
+
+// Rig backends which can be selected through RadioSettings.Backend
+const (
+	HamlibBackend    = "hamlib"
+	SimulatorBackend = "simulator"
+)
+
+// Rig is the interface which has to be implemented by a radio backend
+// so that it can be controlled by HandleRadio. VFOs, modes, functions,
+// levels, parameters and VFO operations are referenced by their
+// (Hamlib) names, as they are used on the wire.
+type Rig interface {
+	Open() error
+	Close() error
+	Caps() sbRadio.Capabilities
+	SetConf(token string, value string) error
+
+	GetPowerStat() (bool, error)
+	SetPowerStat(on bool) error
+
+	GetVfo() (string, error)
+	SetVfo(vfo string) error
+	VfoOp(vfo string, op string) error
+
+	GetFreq(vfo string) (float64, error)
+	SetFreq(vfo string, freq float64) error
+	GetMode(vfo string) (mode string, pbWidth int, err error)
+	SetMode(vfo string, mode string, pbWidth int) error
+	GetPbNormal(mode string) (int, error)
+	GetAnt(vfo string) (int, error)
+	SetAnt(vfo string, ant int) error
+	GetRit(vfo string) (int, error)
+	SetRit(vfo string, rit int) error
+	GetXit(vfo string) (int, error)
+	SetXit(vfo string, xit int) error
+	GetTs(vfo string) (int, error)
+	SetTs(vfo string, ts int) error
+
+	// CTCSS tones are in tenths of Hz; 0 disables the tone / DCS code
+	GetCtcssTone(vfo string) (uint, error)
+	SetCtcssTone(vfo string, tone uint) error
+	GetCtcssSql(vfo string) (uint, error)
+	SetCtcssSql(vfo string, tone uint) error
+	GetDcsCode(vfo string) (uint, error)
+	SetDcsCode(vfo string, code uint) error
+	GetDcsSql(vfo string) (uint, error)
+	SetDcsSql(vfo string, code uint) error
+	// the repeater shift is one of "NONE", "+" or "-"
+	GetRptrShift(vfo string) (string, error)
+	SetRptrShift(vfo string, shift string) error
+	GetRptrOffs(vfo string) (int, error)
+	SetRptrOffs(vfo string, offs int) error
+
+	GetSplit(vfo string) (enabled bool, txVfo string, err error)
+	SetSplit(vfo string, enabled bool) error
+	SetSplitVfo(vfo string, enabled bool, txVfo string) error
+	GetSplitFreq(txVfo string) (float64, error)
+	SetSplitFreq(txVfo string, freq float64) error
+	GetSplitMode(txVfo string) (mode string, pbWidth int, err error)
+	SetSplitMode(txVfo string, mode string, pbWidth int) error
+
+	GetFunc(vfo string, function string) (bool, error)
+	SetFunc(vfo string, function string, on bool) error
+	GetLevel(vfo string, level string) (float32, error)
+	SetLevel(vfo string, level string, value float32) error
+	GetParm(vfo string, parm string) (float32, error)
+	SetParm(vfo string, parm string, value float32) error
+
+	GetPtt(vfo string) (bool, error)
+	SetPtt(vfo string, on bool) error
+
+	GetMem(vfo string) (int, error)
+	SetMem(vfo string, ch int) error
+	// GetChannel returns a memory channel. Empty channels have a
+	// frequency of 0.
+	GetChannel(vfo string, ch int) (sbRadio.Channel, error)
+	SetChannel(vfo string, ch sbRadio.Channel) error
+	ClearChannel(vfo string, ch int) error
+
+	// SendMorse hands the text over to the keyer of the rig.
+	SendMorse(vfo string, text string) error
+	// StopMorse aborts the text which is being sent by the keyer.
+	StopMorse(vfo string) error
+}
+
+// checkBackend returns an error if the backend is unknown. If no backend
+// has been set, Hamlib will be used.
+func checkBackend(backend string) error {
+	switch backend {
+	case HamlibBackend, SimulatorBackend, "":
+		return nil
+	default:
+		return fmt.Errorf("unknown rig backend: %s", backend)
+	}
+}
+
+// newRig returns the Rig backend selected in the RadioSettings.
+func newRig(rs RadioSettings) (Rig, error) {
+	if err := checkBackend(rs.Backend); err != nil {
+		return nil, err
+	}
+	if rs.Backend == SimulatorBackend {
+		return newSimRig(rs.Simulator), nil
+	}
+	return newHamlibRig(rs)
+}
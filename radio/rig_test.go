@@ -0,0 +1,407 @@
+package radio
+
+import (
+	"testing"
+	"time"
+
+	sbRadio "github.com/dh1tw/remoteRadio/sb_radio"
+)
+
+// newTestRig returns the simulator through the Rig interface, the way
+// HandleRadio creates its backend.
+func newTestRig(t *testing.T, ss SimulatorSettings) Rig {
+	t.Helper()
+	rig, err := newRig(RadioSettings{Backend: SimulatorBackend, Simulator: ss})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := rig.Open(); err != nil {
+		t.Fatal(err)
+	}
+	return rig
+}
+
+func TestNewRigUnknownBackend(t *testing.T) {
+	if _, err := newRig(RadioSettings{Backend: "foo"}); err == nil {
+		t.Fatal("expected an error for an unknown backend")
+	}
+	for _, backend := range []string{"", HamlibBackend, SimulatorBackend} {
+		if err := checkBackend(backend); err != nil {
+			t.Errorf("backend %q: %v", backend, err)
+		}
+	}
+}
+
+func TestRigSetGet(t *testing.T) {
+
+	tests := []struct {
+		name    string
+		set     func(r Rig) error
+		get     func(r Rig) (interface{}, error)
+		want    interface{}
+		wantErr bool
+	}{
+		{
+			name: "frequency",
+			set:  func(r Rig) error { return r.SetFreq("VFOA", 7025000) },
+			get:  func(r Rig) (interface{}, error) { return r.GetFreq("VFOA") },
+			want: float64(7025000),
+		},
+		{
+			name:    "invalid frequency",
+			set:     func(r Rig) error { return r.SetFreq("VFOA", -1) },
+			wantErr: true,
+		},
+		{
+			name: "mode with default filter",
+			set:  func(r Rig) error { return r.SetMode("VFOA", "CW", 0) },
+			get: func(r Rig) (interface{}, error) {
+				mode, pbWidth, err := r.GetMode("VFOA")
+				return []interface{}{mode, pbWidth}, err
+			},
+			want: []interface{}{"CW", 500},
+		},
+		{
+			name: "mode with filter",
+			set:  func(r Rig) error { return r.SetMode("VFOA", "USB", 3000) },
+			get: func(r Rig) (interface{}, error) {
+				mode, pbWidth, err := r.GetMode("VFOA")
+				return []interface{}{mode, pbWidth}, err
+			},
+			want: []interface{}{"USB", 3000},
+		},
+		{
+			name:    "unsupported filter",
+			set:     func(r Rig) error { return r.SetMode("VFOA", "USB", 1234) },
+			wantErr: true,
+		},
+		{
+			name:    "unsupported mode",
+			set:     func(r Rig) error { return r.SetMode("VFOA", "DSTAR", 0) },
+			wantErr: true,
+		},
+		{
+			name: "rit",
+			set:  func(r Rig) error { return r.SetRit("VFOA", -500) },
+			get:  func(r Rig) (interface{}, error) { return r.GetRit("VFOA") },
+			want: -500,
+		},
+		{
+			name:    "rit out of range",
+			set:     func(r Rig) error { return r.SetRit("VFOA", 10000) },
+			wantErr: true,
+		},
+		{
+			name: "xit",
+			set:  func(r Rig) error { return r.SetXit("VFOA", 200) },
+			get:  func(r Rig) (interface{}, error) { return r.GetXit("VFOA") },
+			want: 200,
+		},
+		{
+			name: "tuning step",
+			set:  func(r Rig) error { return r.SetTs("VFOA", 100) },
+			get:  func(r Rig) (interface{}, error) { return r.GetTs("VFOA") },
+			want: 100,
+		},
+		{
+			name: "ctcss tone",
+			set:  func(r Rig) error { return r.SetCtcssTone("VFOA", 885) },
+			get:  func(r Rig) (interface{}, error) { return r.GetCtcssTone("VFOA") },
+			want: uint(885),
+		},
+		{
+			name:    "unsupported ctcss tone",
+			set:     func(r Rig) error { return r.SetCtcssTone("VFOA", 886) },
+			wantErr: true,
+		},
+		{
+			name: "dcs code",
+			set:  func(r Rig) error { return r.SetDcsCode("VFOA", 23) },
+			get:  func(r Rig) (interface{}, error) { return r.GetDcsCode("VFOA") },
+			want: uint(23),
+		},
+		{
+			name: "repeater shift",
+			set:  func(r Rig) error { return r.SetRptrShift("VFOA", "-") },
+			get:  func(r Rig) (interface{}, error) { return r.GetRptrShift("VFOA") },
+			want: "-",
+		},
+		{
+			name:    "unknown repeater shift",
+			set:     func(r Rig) error { return r.SetRptrShift("VFOA", "++") },
+			wantErr: true,
+		},
+		{
+			name: "function",
+			set:  func(r Rig) error { return r.SetFunc("VFOA", "NB", true) },
+			get:  func(r Rig) (interface{}, error) { return r.GetFunc("VFOA", "NB") },
+			want: true,
+		},
+		{
+			name:    "unsupported function",
+			set:     func(r Rig) error { return r.SetFunc("VFOA", "FOO", true) },
+			wantErr: true,
+		},
+		{
+			name: "level",
+			set:  func(r Rig) error { return r.SetLevel("VFOA", "AF", 0.25) },
+			get:  func(r Rig) (interface{}, error) { return r.GetLevel("VFOA", "AF") },
+			want: float32(0.25),
+		},
+		{
+			name:    "level out of range",
+			set:     func(r Rig) error { return r.SetLevel("VFOA", "AF", 2) },
+			wantErr: true,
+		},
+		{
+			name:    "meters are read-only",
+			set:     func(r Rig) error { return r.SetLevel("VFOA", "SWR", 1) },
+			wantErr: true,
+		},
+		{
+			name: "parameter",
+			set:  func(r Rig) error { return r.SetParm("VFOA", "BACKLIGHT", 0.5) },
+			get:  func(r Rig) (interface{}, error) { return r.GetParm("VFOA", "BACKLIGHT") },
+			want: float32(0.5),
+		},
+		{
+			name: "ptt",
+			set:  func(r Rig) error { return r.SetPtt("VFOA", true) },
+			get:  func(r Rig) (interface{}, error) { return r.GetPtt("VFOA") },
+			want: true,
+		},
+		{
+			name:    "unknown vfo",
+			set:     func(r Rig) error { return r.SetFreq("VFOC", 7025000) },
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			rig := newTestRig(t, SimulatorSettings{})
+			defer rig.Close()
+
+			err := tc.set(rig)
+			if tc.wantErr {
+				if err == nil {
+					t.Fatal("expected an error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			got, err := tc.get(rig)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !equalValues(got, tc.want) {
+				t.Errorf("got %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func equalValues(a, b interface{}) bool {
+	as, ok := a.([]interface{})
+	if !ok {
+		return a == b
+	}
+	bs, ok := b.([]interface{})
+	if !ok || len(as) != len(bs) {
+		return false
+	}
+	for i := range as {
+		if as[i] != bs[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func TestRigPowerOff(t *testing.T) {
+	rig := newTestRig(t, SimulatorSettings{})
+
+	if err := rig.SetPtt("VFOA", true); err != nil {
+		t.Fatal(err)
+	}
+	if err := rig.SetPowerStat(false); err != nil {
+		t.Fatal(err)
+	}
+	if on, err := rig.GetPowerStat(); err != nil || on {
+		t.Fatalf("GetPowerStat() = %v, %v; want false", on, err)
+	}
+	if _, err := rig.GetFreq("VFOA"); err == nil {
+		t.Error("expected an error while the rig is turned off")
+	}
+
+	if err := rig.SetPowerStat(true); err != nil {
+		t.Fatal(err)
+	}
+	if ptt, err := rig.GetPtt("VFOA"); err != nil || ptt {
+		t.Errorf("GetPtt() = %v, %v; the rig must not be keyed after power on", ptt, err)
+	}
+}
+
+func TestRigSplit(t *testing.T) {
+	rig := newTestRig(t, SimulatorSettings{})
+
+	if err := rig.SetSplitVfo("VFOA", true, "VFOB"); err != nil {
+		t.Fatal(err)
+	}
+	if err := rig.SetSplitFreq("VFOB", 14205000); err != nil {
+		t.Fatal(err)
+	}
+	if err := rig.SetSplitMode("VFOB", "USB", 2400); err != nil {
+		t.Fatal(err)
+	}
+
+	on, txVfo, err := rig.GetSplit("VFOA")
+	if err != nil || !on || txVfo != "VFOB" {
+		t.Fatalf("GetSplit() = %v, %s, %v; want true, VFOB", on, txVfo, err)
+	}
+	if freq, _ := rig.GetSplitFreq("VFOB"); freq != 14205000 {
+		t.Errorf("split frequency = %v, want 14205000", freq)
+	}
+	if freq, _ := rig.GetFreq("VFOA"); freq == 14205000 {
+		t.Error("the split frequency must not change the rx vfo")
+	}
+
+	if err := rig.SetSplit("VFOA", false); err != nil {
+		t.Fatal(err)
+	}
+	if on, _, _ := rig.GetSplit("VFOA"); on {
+		t.Error("split still enabled")
+	}
+	if err := rig.SetSplitVfo("VFOA", true, "VFOC"); err == nil {
+		t.Error("expected an error for an unknown tx vfo")
+	}
+}
+
+func TestRigVfoOps(t *testing.T) {
+
+	tests := []struct {
+		op       string
+		wantVfo  string
+		wantFreq float64 // frequency of the current vfo afterwards
+	}{
+		{"UP", "VFOA", 7000010},
+		{"DOWN", "VFOA", 6999990},
+		{"BAND_UP", "VFOA", 10100000},
+		{"BAND_DOWN", "VFOA", 3500000},
+		{"XCHG", "VFOA", 14250000},
+		{"CPY", "VFOA", 7000000},
+		{"TOGGLE", "VFOB", 14250000},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.op, func(t *testing.T) {
+			rig := newTestRig(t, SimulatorSettings{})
+			if err := rig.SetFreq("VFOA", 7000000); err != nil {
+				t.Fatal(err)
+			}
+			if err := rig.VfoOp("VFOA", tc.op); err != nil {
+				t.Fatal(err)
+			}
+			vfo, err := rig.GetVfo()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if vfo != tc.wantVfo {
+				t.Errorf("vfo = %s, want %s", vfo, tc.wantVfo)
+			}
+			if freq, _ := rig.GetFreq(vfo); freq != tc.wantFreq {
+				t.Errorf("frequency = %v, want %v", freq, tc.wantFreq)
+			}
+		})
+	}
+
+	rig := newTestRig(t, SimulatorSettings{})
+	if err := rig.VfoOp("VFOA", "FOO"); err == nil {
+		t.Error("expected an error for an unsupported vfo operation")
+	}
+}
+
+func TestRigMemory(t *testing.T) {
+	rig := newTestRig(t, SimulatorSettings{})
+	caps := rig.Caps()
+
+	ch := sbRadio.Channel{Channel: caps.MemFirst, Frequency: 3573000, Mode: "PKTUSB", PbWidth: 3000}
+	if err := rig.SetChannel("VFOA", ch); err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := rig.GetChannel("VFOA", int(caps.MemFirst))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.Frequency != ch.Frequency || got.Mode != ch.Mode {
+		t.Errorf("GetChannel() = %v, want %v", got, ch)
+	}
+
+	// recall the channel into the vfo
+	if err := rig.SetMem("VFOA", int(caps.MemFirst)); err != nil {
+		t.Fatal(err)
+	}
+	if err := rig.VfoOp("VFOA", "TO_VFO"); err != nil {
+		t.Fatal(err)
+	}
+	if mode, pbWidth, _ := rig.GetMode("VFOA"); mode != "PKTUSB" || pbWidth != 3000 {
+		t.Errorf("recalled mode = %s %d, want PKTUSB 3000", mode, pbWidth)
+	}
+
+	if err := rig.ClearChannel("VFOA", int(caps.MemFirst)); err != nil {
+		t.Fatal(err)
+	}
+	if got, _ := rig.GetChannel("VFOA", int(caps.MemFirst)); got.Frequency != 0 {
+		t.Error("cleared channels must have a frequency of 0")
+	}
+
+	if _, err := rig.GetChannel("VFOA", int(caps.MemLast)+1); err == nil {
+		t.Error("expected an error for an invalid channel")
+	}
+}
+
+func TestRigMorse(t *testing.T) {
+	rig := newTestRig(t, SimulatorSettings{})
+
+	if err := rig.SetMode("VFOA", "USB", 0); err != nil {
+		t.Fatal(err)
+	}
+	if err := rig.SendMorse("VFOA", "CQ"); err == nil {
+		t.Error("the keyer must only be available in CW")
+	}
+	if err := rig.SetMode("VFOA", "CW", 0); err != nil {
+		t.Fatal(err)
+	}
+	if err := rig.SendMorse("VFOA", "CQ"); err != nil {
+		t.Error(err)
+	}
+	if err := rig.StopMorse("VFOA"); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestRigFaults(t *testing.T) {
+
+	tests := []struct {
+		name     string
+		settings SimulatorSettings
+		wantErr  error
+	}{
+		{"errors", SimulatorSettings{ErrorRate: 1}, errSimError},
+		{"timeouts", SimulatorSettings{TimeoutRate: 1, Timeout: time.Millisecond}, errSimTimeout},
+		{"healthy", SimulatorSettings{}, nil},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			rig := newTestRig(t, tc.settings)
+			if _, err := rig.GetFreq("VFOA"); err != tc.wantErr {
+				t.Errorf("GetFreq() error = %v, want %v", err, tc.wantErr)
+			}
+		})
+	}
+}
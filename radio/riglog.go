@@ -0,0 +1,169 @@
+package radio
+
+import (
+	"fmt"
+	"log"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/dh1tw/remoteRadio/comms"
+	"github.com/dh1tw/remoteRadio/events"
+	sbRadio "github.com/dh1tw/remoteRadio/sb_radio"
+	"github.com/dh1tw/remoteRadio/utils"
+)
+
+// rigLog collects the debug output of the rig library for one radio.
+// The lines are filtered by level and handed over to the intake
+// goroutine through a buffered channel; lines are dropped if the
+// channel is full since the rig library must never be blocked.
+type rigLog struct {
+	level      int32 // published lines (atomic)
+	localLevel int   // lines printed on the server's console
+	lines      chan sbRadio.LogLine
+}
+
+func newRigLog(level int) *rigLog {
+	return &rigLog{
+		level:      int32(level),
+		localLevel: level,
+		lines:      make(chan sbRadio.LogLine, 100),
+	}
+}
+
+func (l *rigLog) getLevel() int {
+	return int(atomic.LoadInt32(&l.level))
+}
+
+func (l *rigLog) setLevel(level int) {
+	atomic.StoreInt32(&l.level, int32(level))
+	hamlibLogs.updateLevel()
+}
+
+// hamlib's debug callback and debug level are process wide. With several
+// radios served from the same process, the output can not be attributed
+// to a particular rig and is therefore forwarded to all of them.
+var hamlibLogs = &hamlibLogRegistry{
+	logs: make(map[*rigLog]bool),
+}
+
+type hamlibLogRegistry struct {
+	sync.Mutex
+	once sync.Once
+	logs map[*rigLog]bool
+}
+
+func (reg *hamlibLogRegistry) add(l *rigLog) {
+	reg.once.Do(func() {
+		setHamlibDebugCallback(reg.dispatch)
+	})
+	reg.Lock()
+	reg.logs[l] = true
+	reg.Unlock()
+	reg.updateLevel()
+}
+
+func (reg *hamlibLogRegistry) remove(l *rigLog) {
+	reg.Lock()
+	delete(reg.logs, l)
+	reg.Unlock()
+	reg.updateLevel()
+}
+
+// updateLevel sets hamlib's debug level to the highest level requested
+// by any of the radios.
+func (reg *hamlibLogRegistry) updateLevel() {
+	reg.Lock()
+	defer reg.Unlock()
+
+	max := 0
+	for l := range reg.logs {
+		if level := l.getLevel(); level > max {
+			max = level
+		}
+		if l.localLevel > max {
+			max = l.localLevel
+		}
+	}
+
+	setHamlibDebugLevel(max)
+}
+
+// dispatch is called by hamlib, possibly from the middle of a rig call.
+func (reg *hamlibLogRegistry) dispatch(level int, msg string) {
+	reg.Lock()
+	defer reg.Unlock()
+
+	line := sbRadio.LogLine{
+		Timestamp: time.Now().UnixNano(),
+		Level:     int32(level),
+		Msg:       msg,
+	}
+
+	for l := range reg.logs {
+		if level > l.getLevel() && level > l.localLevel {
+			continue
+		}
+		select {
+		case l.lines <- line:
+		default:
+		}
+	}
+}
+
+// publishLogLine prints the line on the server's console and publishes
+// it on the log topic and through the events.RadioLog event.
+func (r *radio) publishLogLine(line sbRadio.LogLine) {
+
+	level := int(line.GetLevel())
+
+	if level <= r.rigLog.localLevel {
+		log.Printf("hamlib (%s): %s", r.settings.ID, line.GetMsg())
+	}
+
+	if level > r.rigLog.getLevel() {
+		return
+	}
+
+	r.settings.Events.Pub(fmt.Sprintf("[%s] %s: %s", r.settings.ID,
+		utils.LogLevelName(level), line.GetMsg()), events.RadioLog)
+
+	data, err := line.Marshal()
+	if err != nil {
+		log.Println(err)
+		return
+	}
+
+	r.settings.ToWireCh <- comms.IOMsg{
+		Topic: r.settings.LogTopic,
+		Data:  data,
+	}
+}
+
+func (r *radio) handleLogLevelRequest(msg []byte) {
+
+	req := sbRadio.SetLogLevel{}
+	if err := req.Unmarshal(msg); err != nil {
+		log.Println(err)
+		return
+	}
+
+	ack := sbRadio.Ack{
+		RequestId: req.GetRequestId(),
+		UserId:    req.GetUserId(),
+	}
+
+	level := int(req.GetLevel())
+	var err error
+	if level < 0 || level > utils.MaxLogLevel {
+		err = fmt.Errorf("invalid log level %d", level)
+	} else {
+		log.Printf("%s set the log level to %s\n", req.GetUserId(), utils.LogLevelName(level))
+		r.rigLog.setLevel(level)
+	}
+	r.addResult(&ack, "log_level", err)
+
+	if err := r.sendAck(ack); err != nil {
+		log.Println(err)
+	}
+}
@@ -0,0 +1,275 @@
+package radio
+
+import (
+	"expvar"
+	"sync"
+	"time"
+
+	sbRadio "github.com/dh1tw/remoteRadio/sb_radio"
+)
+
+// priority of a job executed on the rig. Lower values are more urgent.
+type priority int
+
+const (
+	prioSafety priority = iota // PTT off, power off, watchdog
+	prioUser                   // requests from the clients
+	prioPoll                   // meter and resync polling
+	numPriorities
+)
+
+var priorityNames = [numPriorities]string{"safety", "user", "poll"}
+
+// job is a unit of work which has exclusive access to the rig.
+type job struct {
+	name   string
+	prio   priority
+	queued time.Time
+	maxAge time.Duration // stale jobs are dropped (0 = never stale)
+	// a queued job is replaced by a newer job with the same key
+	key string
+	// request_ids of the requests which have been coalesced into
+	// this job
+	coalesced []string
+	requestID string
+	run       func(j job)
+	// the job keys the transmitter or turns the radio on; it is
+	// cancelled by a safety request which has been queued later
+	keying bool
+	// informs the client if the job has been cancelled
+	cancel func(j job)
+}
+
+// scheduler queues the jobs for the rig and hands them out by priority.
+// The queues are filled by the intake goroutine and drained by the
+// goroutine which owns the rig.
+type scheduler struct {
+	sync.Mutex
+	queues [numPriorities][]job
+	ready  chan struct{}
+	// stats exposes the queue depth, the dropped polls and the
+	// latency of the jobs through expvar (/debug/vars).
+	stats *expvar.Map
+}
+
+func newScheduler(name string) *scheduler {
+	s := &scheduler{
+		ready: make(chan struct{}, 1),
+	}
+	// expvar names can only be published once per process
+	varName := "rig_scheduler/" + name
+	if v, ok := expvar.Get(varName).(*expvar.Map); ok {
+		s.stats = v
+	} else {
+		s.stats = expvar.NewMap(varName)
+	}
+	return s
+}
+
+// push adds a job to its queue. A poll is not queued if the same
+// poll is still waiting to be executed. A job with a key replaces
+// a queued job with the same key. The replacement is queued at the
+// back, so that it doesn't overtake the jobs which have been queued
+// after the replaced job.
+func (s *scheduler) push(j job) {
+	s.Lock()
+	defer s.Unlock()
+
+	if j.key != "" {
+		q := s.queues[j.prio]
+		for i, queued := range q {
+			if queued.key != j.key {
+				continue
+			}
+			j.coalesced = append(queued.coalesced, j.coalesced...)
+			if queued.requestID != "" {
+				j.coalesced = append(j.coalesced, queued.requestID)
+			}
+			s.queues[j.prio] = append(q[:i], q[i+1:]...)
+			s.stats.Add("coalesced", 1)
+			break
+		}
+	}
+
+	if j.prio == prioPoll {
+		for _, queued := range s.queues[prioPoll] {
+			if queued.name == j.name {
+				s.stats.Add("dropped_polls", 1)
+				return
+			}
+		}
+	}
+
+	j.queued = time.Now()
+	s.queues[j.prio] = append(s.queues[j.prio], j)
+	s.updateDepth(j.prio)
+	s.signal()
+}
+
+// pop returns the most urgent job. Jobs which have been waiting longer
+// than their maxAge are dropped.
+func (s *scheduler) pop() (job, bool) {
+	s.Lock()
+	defer s.Unlock()
+
+	for prio := range s.queues {
+		for len(s.queues[prio]) > 0 {
+			j := s.queues[prio][0]
+			s.queues[prio] = s.queues[prio][1:]
+			s.updateDepth(priority(prio))
+
+			if j.maxAge > 0 && time.Since(j.queued) > j.maxAge {
+				s.stats.Add("dropped_polls", 1)
+				continue
+			}
+
+			// there is more work to do; wake up the executor again
+			if s.pending() {
+				s.signal()
+			}
+			return j, true
+		}
+	}
+
+	return job{}, false
+}
+
+// cancelKeying removes all queued jobs which would key the transmitter
+// or turn the radio on and returns them.
+func (s *scheduler) cancelKeying() []job {
+	s.Lock()
+	defer s.Unlock()
+
+	cancelled := []job{}
+	for prio, q := range s.queues {
+		kept := q[:0]
+		for _, j := range q {
+			if j.keying {
+				cancelled = append(cancelled, j)
+				continue
+			}
+			kept = append(kept, j)
+		}
+		s.queues[prio] = kept
+		s.updateDepth(priority(prio))
+	}
+	if len(cancelled) > 0 {
+		s.stats.Add("cancelled", int64(len(cancelled)))
+	}
+	return cancelled
+}
+
+// exec runs the job and records how long it was queued and how long
+// it took to execute.
+func (s *scheduler) exec(j job) {
+	start := time.Now()
+	j.run(j)
+	end := time.Now()
+
+	wait := new(expvar.Int)
+	wait.Set(int64(start.Sub(j.queued) / time.Millisecond))
+	s.stats.Set(priorityNames[j.prio]+".wait_ms", wait)
+
+	latency := new(expvar.Int)
+	latency.Set(int64(end.Sub(start) / time.Millisecond))
+	s.stats.Set(j.name+".latency_ms", latency)
+}
+
+func (s *scheduler) pending() bool {
+	for _, q := range s.queues {
+		if len(q) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *scheduler) signal() {
+	select {
+	case s.ready <- struct{}{}:
+	default:
+	}
+}
+
+func (s *scheduler) updateDepth(prio priority) {
+	depth := new(expvar.Int)
+	depth.Set(int64(len(s.queues[prio])))
+	s.stats.Set(priorityNames[prio]+".queue_depth", depth)
+}
+
+// isSafetyRequest checks if a SetState request unkeys the transmitter or
+// turns the radio off. These fields of the request jump the queue.
+func isSafetyRequest(ns *sbRadio.SetState) bool {
+	if ns.Md == nil {
+		return false
+	}
+	if ns.Md.HasPtt && !ns.GetPtt() {
+		return true
+	}
+	if ns.Md.HasRadioOn && !ns.GetRadioOn() {
+		return true
+	}
+	return false
+}
+
+// safetyRequest returns a SetState request which contains only the
+// fields of ns that unkey the transmitter or turn the radio off.
+func safetyRequest(ns *sbRadio.SetState, currentVfo string) *sbRadio.SetState {
+	req := &sbRadio.SetState{
+		UserId:     ns.GetUserId(),
+		RequestId:  ns.GetRequestId(),
+		CurrentVfo: currentVfo,
+		Md:         &sbRadio.MetaData{},
+		Vfo:        &sbRadio.Vfo{},
+	}
+	if ns.Md == nil {
+		return req
+	}
+	if ns.Md.HasPtt && !ns.GetPtt() {
+		req.Md.HasPtt = true
+	}
+	if ns.Md.HasRadioOn && !ns.GetRadioOn() {
+		req.Md.HasRadioOn = true
+	}
+	return req
+}
+
+// remainingRequest returns a SetState request which contains the fields
+// of ns that are not part of its safetyRequest, or nil if there are none.
+func remainingRequest(ns *sbRadio.SetState) *sbRadio.SetState {
+	if ns.Md == nil {
+		return nil
+	}
+	req := *ns
+	md := *ns.Md
+	req.Md = &md
+	if md.HasPtt && !ns.GetPtt() {
+		md.HasPtt = false
+	}
+	if md.HasRadioOn && !ns.GetRadioOn() {
+		md.HasRadioOn = false
+	}
+	if len(requestedFields(&req)) == 0 {
+		return nil
+	}
+	return &req
+}
+
+// isKeyingRequest checks if a SetState request keys the transmitter or
+// turns the radio on.
+func isKeyingRequest(ns *sbRadio.SetState) bool {
+	if ns.Md == nil {
+		return false
+	}
+	return ns.Md.HasPtt && ns.GetPtt() || ns.Md.HasRadioOn && ns.GetRadioOn()
+}
+
+// setPollingInterval hands the new meter polling interval over to the
+// intake goroutine. Only the latest interval matters.
+func (r *radio) setPollingInterval(d time.Duration) {
+	select {
+	case <-r.pollingIntervalCh:
+	default:
+	}
+	r.pollingIntervalCh <- d
+}
@@ -0,0 +1,359 @@
+package radio
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	sbRadio "github.com/dh1tw/remoteRadio/sb_radio"
+)
+
+// popAll returns the names of the jobs in the order they are handed out.
+func popAll(s *scheduler) []string {
+	names := []string{}
+	for {
+		j, ok := s.pop()
+		if !ok {
+			return names
+		}
+		names = append(names, j.name)
+	}
+}
+
+func TestSchedulerOrder(t *testing.T) {
+
+	tests := []struct {
+		name string
+		jobs []job
+		want []string
+	}{
+		{
+			name: "priority",
+			jobs: []job{
+				{name: "meter", prio: prioPoll},
+				{name: "cat", prio: prioUser},
+				{name: "ptt off", prio: prioSafety},
+			},
+			want: []string{"ptt off", "cat", "meter"},
+		},
+		{
+			name: "fifo within priority",
+			jobs: []job{
+				{name: "cat1", prio: prioUser},
+				{name: "lock", prio: prioUser},
+				{name: "cat2", prio: prioUser},
+			},
+			want: []string{"cat1", "lock", "cat2"},
+		},
+		{
+			name: "duplicate poll dropped",
+			jobs: []job{
+				{name: "meter", prio: prioPoll},
+				{name: "resync", prio: prioPoll},
+				{name: "meter", prio: prioPoll},
+			},
+			want: []string{"meter", "resync"},
+		},
+		{
+			name: "duplicate user jobs kept",
+			jobs: []job{
+				{name: "cat", prio: prioUser},
+				{name: "cat", prio: prioUser},
+			},
+			want: []string{"cat", "cat"},
+		},
+		{
+			name: "key replaces queued job at the back",
+			jobs: []job{
+				{name: "freq1", prio: prioUser, key: "frequency"},
+				{name: "mode", prio: prioUser, key: "mode"},
+				{name: "freq2", prio: prioUser, key: "frequency"},
+			},
+			want: []string{"mode", "freq2"},
+		},
+		{
+			name: "keys are per priority",
+			jobs: []job{
+				{name: "protection", prio: prioSafety, key: "protection"},
+				{name: "cat", prio: prioUser, key: "protection"},
+			},
+			want: []string{"protection", "cat"},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			s := newScheduler("test")
+			for _, j := range tc.jobs {
+				s.push(j)
+			}
+			if got := popAll(s); !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("got %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestSchedulerCoalesced(t *testing.T) {
+	s := newScheduler("test")
+
+	for _, id := range []string{"1", "2", "3"} {
+		s.push(job{name: "cat", prio: prioUser, key: "frequency", requestID: id})
+	}
+
+	j, ok := s.pop()
+	if !ok {
+		t.Fatal("no job queued")
+	}
+	if j.requestID != "3" {
+		t.Errorf("requestID = %s, want 3", j.requestID)
+	}
+	if want := []string{"1", "2"}; !reflect.DeepEqual(j.coalesced, want) {
+		t.Errorf("coalesced = %v, want %v", j.coalesced, want)
+	}
+}
+
+func TestSchedulerMaxAge(t *testing.T) {
+	s := newScheduler("test")
+
+	s.push(job{name: "meter", prio: prioPoll, maxAge: time.Millisecond})
+	s.push(job{name: "resync", prio: prioPoll})
+	time.Sleep(time.Millisecond * 5)
+
+	if got, want := popAll(s), []string{"resync"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
+
+func TestSchedulerReady(t *testing.T) {
+	s := newScheduler("test")
+
+	s.push(job{name: "cat1", prio: prioUser})
+	s.push(job{name: "cat2", prio: prioUser})
+
+	for _, name := range []string{"cat1", "cat2"} {
+		select {
+		case <-s.ready:
+		default:
+			t.Fatalf("executor not signaled for %s", name)
+		}
+		if j, _ := s.pop(); j.name != name {
+			t.Errorf("got %s, want %s", j.name, name)
+		}
+	}
+
+	select {
+	case <-s.ready:
+		t.Error("executor signaled without pending jobs")
+	default:
+	}
+}
+
+func TestIsSafetyRequest(t *testing.T) {
+
+	tests := []struct {
+		name string
+		req  sbRadio.SetState
+		want bool
+	}{
+		{"no metadata", sbRadio.SetState{}, false},
+		{"ptt off", sbRadio.SetState{Md: &sbRadio.MetaData{HasPtt: true}}, true},
+		{"ptt on", sbRadio.SetState{Md: &sbRadio.MetaData{HasPtt: true}, Ptt: true}, false},
+		{"radio off", sbRadio.SetState{Md: &sbRadio.MetaData{HasRadioOn: true}}, true},
+		{"radio on", sbRadio.SetState{Md: &sbRadio.MetaData{HasRadioOn: true}, RadioOn: true}, false},
+		{"frequency", sbRadio.SetState{Md: &sbRadio.MetaData{HasFrequency: true}}, false},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := isSafetyRequest(&tc.req); got != tc.want {
+				t.Errorf("isSafetyRequest() = %v, want %v", got, tc.want)
+			}
+
+			req := safetyRequest(&tc.req, "VFOA")
+			if got := req.Md.HasPtt || req.Md.HasRadioOn; got != tc.want {
+				t.Errorf("safetyRequest() = %v, want safety fields %v", req.Md, tc.want)
+			}
+			if req.Md.HasFrequency {
+				t.Error("safetyRequest() contains the frequency")
+			}
+		})
+	}
+}
+
+// queueRequest puts a SetState request on the scheduler as the intake
+// goroutine does.
+func queueRequest(t *testing.T, r *radio, ns sbRadio.SetState) {
+	t.Helper()
+	if ns.CurrentVfo == "" {
+		ns.CurrentVfo = r.state.CurrentVfo
+	}
+	if ns.Vfo == nil {
+		ns.Vfo = &sbRadio.Vfo{}
+	}
+	data, err := ns.Marshal()
+	if err != nil {
+		t.Fatal(err)
+	}
+	r.queueCatRequest(data)
+}
+
+// acks returns the published Acks by request_id.
+func acks(t *testing.T, r *radio) map[string][]sbRadio.Ack {
+	t.Helper()
+	res := map[string][]sbRadio.Ack{}
+	for _, msg := range drain(r) {
+		if msg.Topic != r.settings.AckTopic {
+			continue
+		}
+		ack := sbRadio.Ack{}
+		if err := ack.Unmarshal(msg.Data); err != nil {
+			t.Fatal(err)
+		}
+		res[ack.GetRequestId()] = append(res[ack.GetRequestId()], ack)
+	}
+	return res
+}
+
+// TestSafetyRequestCancelsKeying verifies that a request to unkey the
+// transmitter or to turn the radio off can't be overtaken by an older
+// request which keys the transmitter or turns the radio on.
+func TestSafetyRequestCancelsKeying(t *testing.T) {
+
+	tests := []struct {
+		name      string
+		radioOff  bool // turn the radio off before
+		first     sbRadio.SetState
+		second    sbRadio.SetState
+		field     string
+		wantPtt   bool
+		wantRigOn bool
+	}{
+		{
+			name:      "ptt on, ptt off",
+			first:     sbRadio.SetState{Md: &sbRadio.MetaData{HasPtt: true}, Ptt: true},
+			second:    sbRadio.SetState{Md: &sbRadio.MetaData{HasPtt: true}},
+			field:     "ptt",
+			wantPtt:   false,
+			wantRigOn: true,
+		},
+		{
+			name:      "radio on, radio off",
+			radioOff:  true,
+			first:     sbRadio.SetState{Md: &sbRadio.MetaData{HasRadioOn: true}, RadioOn: true},
+			second:    sbRadio.SetState{Md: &sbRadio.MetaData{HasRadioOn: true}},
+			field:     "radio_on",
+			wantRigOn: false,
+		},
+		{
+			name:      "ptt on, radio off",
+			first:     sbRadio.SetState{Md: &sbRadio.MetaData{HasPtt: true}, Ptt: true},
+			second:    sbRadio.SetState{Md: &sbRadio.MetaData{HasRadioOn: true}},
+			field:     "ptt",
+			wantRigOn: false,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			r := newTestRadio(t, RadioSettings{})
+			if tc.radioOff {
+				setState(t, r, sbRadio.SetState{UserId: "a", Md: &sbRadio.MetaData{HasRadioOn: true}})
+			}
+			drain(r)
+
+			tc.first.UserId, tc.first.RequestId = "a", "1"
+			tc.second.UserId, tc.second.RequestId = "b", "2"
+			queueRequest(t, r, tc.first)
+			queueRequest(t, r, tc.second)
+			run(r)
+
+			rigOn, _ := r.rig.GetPowerStat()
+			if rigOn != tc.wantRigOn {
+				t.Errorf("rig on = %v, want %v", rigOn, tc.wantRigOn)
+			}
+			if rigOn {
+				if ptt, _ := r.rig.GetPtt(r.state.CurrentVfo); ptt != tc.wantPtt {
+					t.Errorf("rig ptt = %v, want %v", ptt, tc.wantPtt)
+				}
+			}
+
+			res := acks(t, r)
+			if len(res["1"]) != 1 || !rejected(res["1"][0], tc.field) {
+				t.Errorf("older request not rejected: %v", res["1"])
+			}
+			if len(res["2"]) != 1 || len(res["2"][0].Rejected) > 0 {
+				t.Errorf("safety request not applied: %v", res["2"])
+			}
+		})
+	}
+}
+
+func TestSafetyRequestCancelsMorse(t *testing.T) {
+	r := newTestRadio(t, RadioSettings{MorseProgressTopic: "test/cat/morse"})
+
+	morse := sbRadio.SendMorse{UserId: "a", RequestId: "1", Text: "CQ"}
+	data, err := morse.Marshal()
+	if err != nil {
+		t.Fatal(err)
+	}
+	r.queueMorseRequest(data)
+	queueRequest(t, r, sbRadio.SetState{UserId: "b", RequestId: "2", Md: &sbRadio.MetaData{HasPtt: true}})
+	run(r)
+
+	if r.morse != nil {
+		t.Error("CW message sent after the request to unkey the transmitter")
+	}
+	cancelled := false
+	for _, msg := range drain(r) {
+		if msg.Topic != r.settings.MorseProgressTopic {
+			continue
+		}
+		p := sbRadio.MorseProgress{}
+		if err := p.Unmarshal(msg.Data); err != nil {
+			t.Fatal(err)
+		}
+		cancelled = p.GetDone() && p.GetError() != ""
+	}
+	if !cancelled {
+		t.Error("cancellation of the CW message not published")
+	}
+}
+
+// TestSafetyRequestFields verifies that only the safety fields of a
+// request jump the queue. The other fields are applied after the older
+// requests and the request is acknowledged once.
+func TestSafetyRequestFields(t *testing.T) {
+	r := newTestRadio(t, RadioSettings{})
+	setState(t, r, sbRadio.SetState{UserId: "a", Md: &sbRadio.MetaData{HasPtt: true}, Ptt: true})
+	drain(r)
+
+	queueRequest(t, r, sbRadio.SetState{UserId: "a", RequestId: "1",
+		Md: &sbRadio.MetaData{HasFrequency: true, HasMode: true}, Vfo: &sbRadio.Vfo{Frequency: 14100000, Mode: "CW"}})
+	queueRequest(t, r, sbRadio.SetState{UserId: "a", RequestId: "2",
+		Md: &sbRadio.MetaData{HasFrequency: true, HasPtt: true}, Vfo: &sbRadio.Vfo{Frequency: 14200000}})
+
+	// the transmitter is unkeyed first
+	j, _ := r.sched.pop()
+	r.sched.exec(j)
+	if r.state.Ptt {
+		t.Fatal("transmitter not unkeyed first")
+	}
+	if r.state.Vfo.Frequency == 14200000 {
+		t.Fatal("frequency jumped the queue")
+	}
+
+	run(r)
+
+	if r.state.Vfo.Frequency != 14200000 || r.state.Vfo.Mode != "CW" {
+		t.Errorf("vfo = %.0f %s, want 14200000 CW", r.state.Vfo.Frequency, r.state.Vfo.Mode)
+	}
+
+	res := acks(t, r)
+	if len(res["2"]) != 1 {
+		t.Fatalf("%d acks for the safety request, want 1", len(res["2"]))
+	}
+	if applied := res["2"][0].Applied; len(applied) != 2 {
+		t.Errorf("applied = %v, want ptt, frequency", applied)
+	}
+}
@@ -0,0 +1,971 @@
+package radio
+
+import (
+	"errors"
+	"math"
+	"math/rand"
+	"time"
+
+	sbRadio "github.com/dh1tw/remoteRadio/sb_radio"
+	"github.com/dh1tw/remoteRadio/utils"
+)
+
+// SimulatorSettings contains the configuration of the simulated
+// transceiver. Empty values are replaced by reasonable defaults.
+type SimulatorSettings struct {
+	Vfos        []string
+	Modes       []string
+	Filters     map[string][]int
+	MaxRit      int
+	MaxXit      int
+	Latency     time.Duration // added to every call
+	Timeout     time.Duration // duration of a simulated timeout
+	TimeoutRate float64       // probability [0..1] that a call times out
+	ErrorRate   float64       // probability [0..1] that a call fails
+}
+
+var errSimTimeout = errors.New("simulator: communication timed out")
+var errSimError = errors.New("simulator: invalid parameter")
+var errSimRigOff = errors.New("simulator: rig is turned off")
+
+var simDefaultFilters = map[string][]int{
+	"USB":    {2400, 1800, 3000},
+	"LSB":    {2400, 1800, 3000},
+	"CW":     {500, 250, 1200},
+	"CWR":    {500, 250, 1200},
+	"RTTY":   {500, 250, 1200},
+	"PKTUSB": {2400, 500, 3000},
+	"AM":     {6000, 3000, 9000},
+	"FM":     {12000, 9000},
+}
+
+var simTuningSteps = map[string][]int{
+	"USB":    {10, 100, 1000},
+	"LSB":    {10, 100, 1000},
+	"CW":     {10, 100, 1000},
+	"CWR":    {10, 100, 1000},
+	"RTTY":   {10, 100, 1000},
+	"PKTUSB": {10, 100, 1000},
+	"AM":     {100, 1000, 5000},
+	"FM":     {1000, 5000, 12500},
+}
+
+// lower band edges [Hz] used for the BAND_UP / BAND_DOWN vfo operations
+var simBands = []float64{1810000, 3500000, 7000000, 10100000, 14000000,
+	18068000, 21000000, 24890000, 28000000, 50000000, 144000000, 430000000}
+
+// standard CTCSS tones [0.1 Hz] and DCS codes, as supported by most
+// VHF/UHF transceivers
+var simCtcssList = []uint32{670, 693, 719, 744, 770, 797, 825, 854, 885,
+	915, 948, 974, 1000, 1035, 1072, 1109, 1148, 1188, 1230, 1273, 1318,
+	1365, 1413, 1462, 1514, 1567, 1598, 1622, 1655, 1679, 1713, 1738, 1773,
+	1799, 1835, 1862, 1899, 1928, 1966, 1995, 2035, 2065, 2107, 2181, 2257,
+	2291, 2336, 2418, 2503, 2541}
+
+var simDcsList = []uint32{23, 25, 26, 31, 32, 36, 43, 47, 51, 53, 54, 65,
+	71, 72, 73, 74, 114, 115, 116, 122, 125, 131, 132, 134, 143, 145, 152,
+	155, 156, 162, 165, 172, 174, 205, 212, 223, 225, 226, 243, 244, 245,
+	246, 251, 252, 255, 261, 263, 265, 266, 271, 274, 306, 311, 315, 325,
+	331, 332, 343, 346, 351, 356, 364, 365, 371, 411, 412, 413, 423, 431,
+	432, 445, 446, 452, 454, 455, 462, 464, 465, 466, 503, 506, 516, 523,
+	526, 532, 546, 565, 606, 612, 624, 627, 631, 632, 654, 662, 664, 703,
+	712, 723, 731, 732, 734, 743, 754}
+
+// range of the simulated memory channels
+const (
+	simMemFirst = 1
+	simMemLast  = 99
+)
+
+type simVfo struct {
+	freq    float64
+	mode    string
+	pbWidth int
+	ant     int
+	rit     int
+	xit     int
+	ts      int
+	funcs   map[string]bool
+	levels  map[string]float32
+	params  map[string]float32
+
+	// CTCSS tones, DCS codes and the repeater shift
+	ctcssTone uint
+	ctcssSql  uint
+	dcsCode   uint
+	dcsSql    uint
+	rptrShift string
+	rptrOffs  int
+}
+
+func (v *simVfo) copy() *simVfo {
+	c := *v
+	c.funcs = make(map[string]bool)
+	c.levels = make(map[string]float32)
+	c.params = make(map[string]float32)
+	for name, value := range v.funcs {
+		c.funcs[name] = value
+	}
+	for name, value := range v.levels {
+		c.levels[name] = value
+	}
+	for name, value := range v.params {
+		c.params[name] = value
+	}
+	return &c
+}
+
+// simRig implements the Rig interface in pure Go. It simulates a
+// transceiver including synthetic meter values and optional faults.
+type simRig struct {
+	settings   SimulatorSettings
+	caps       sbRadio.Capabilities
+	on         bool
+	ptt        bool
+	currentVfo string
+	vfos       map[string]*simVfo
+	splitOn    bool
+	splitVfo   string
+	memCh      int
+	memories   map[int]sbRadio.Channel
+	started    time.Time
+	rand       *rand.Rand
+}
+
+func newSimRig(ss SimulatorSettings) *simRig {
+
+	if len(ss.Vfos) == 0 {
+		ss.Vfos = []string{"VFOA", "VFOB"}
+	}
+	if len(ss.Modes) == 0 {
+		ss.Modes = []string{"USB", "LSB", "CW", "CWR", "RTTY", "PKTUSB", "AM", "FM"}
+	}
+	if len(ss.Filters) == 0 {
+		ss.Filters = simDefaultFilters
+	}
+	if ss.MaxRit == 0 {
+		ss.MaxRit = 9999
+	}
+	if ss.MaxXit == 0 {
+		ss.MaxXit = 9999
+	}
+	if ss.Timeout == 0 {
+		ss.Timeout = time.Second
+	}
+
+	s := &simRig{
+		settings:   ss,
+		on:         true,
+		currentVfo: ss.Vfos[0],
+		vfos:       make(map[string]*simVfo),
+		memCh:      simMemFirst,
+		memories:   make(map[int]sbRadio.Channel),
+		started:    time.Now(),
+		rand:       rand.New(rand.NewSource(time.Now().UnixNano())),
+	}
+
+	s.caps = s.buildCaps()
+
+	for _, vfo := range ss.Vfos {
+		v := &simVfo{
+			freq:   14250000,
+			mode:   ss.Modes[0],
+			ts:     10,
+			funcs:  make(map[string]bool),
+			levels: make(map[string]float32),
+			params: make(map[string]float32),
+
+			rptrShift: "NONE",
+		}
+		if filters, ok := ss.Filters[v.mode]; ok && len(filters) > 0 {
+			v.pbWidth = filters[0]
+		}
+		for _, l := range s.caps.GetLevels {
+			v.levels[l.Name] = l.Min
+		}
+		v.levels["AF"] = 0.5
+		v.levels["RF"] = 1
+		v.levels["RFPOWER"] = 1
+		v.levels["KEYSPD"] = 24
+		v.levels["CWPITCH"] = 600
+		for _, p := range s.caps.GetParameters {
+			v.params[p.Name] = p.Min
+		}
+		s.vfos[vfo] = v
+	}
+
+	return s
+}
+
+func (s *simRig) buildCaps() sbRadio.Capabilities {
+
+	setLevels := []*sbRadio.Value{
+		{Name: "PREAMP", Min: 0, Max: 20, Step: 10},
+		{Name: "ATT", Min: 0, Max: 18, Step: 6},
+		{Name: "AF", Min: 0, Max: 1, Step: 0.01},
+		{Name: "RF", Min: 0, Max: 1, Step: 0.01},
+		{Name: "SQL", Min: 0, Max: 1, Step: 0.01},
+		{Name: "RFPOWER", Min: 0, Max: 1, Step: 0.01},
+		{Name: "MICGAIN", Min: 0, Max: 1, Step: 0.01},
+		{Name: "COMP", Min: 0, Max: 1, Step: 0.01},
+		{Name: "KEYSPD", Min: 4, Max: 60, Step: 1},
+		{Name: "CWPITCH", Min: 300, Max: 1050, Step: 50},
+	}
+
+	meters := []*sbRadio.Value{
+		{Name: "STRENGTH", Min: -54, Max: 60, Step: 1},
+		{Name: "SWR", Min: 1, Max: 10, Step: 0.1},
+		{Name: "ALC", Min: 0, Max: 1, Step: 0.01},
+		{Name: "RFPOWER_METER", Min: 0, Max: 1, Step: 0.01},
+		{Name: "COMP_METER", Min: 0, Max: 30, Step: 0.5},
+		{Name: "ID_METER", Min: 0, Max: 25, Step: 0.1},
+		{Name: "VD_METER", Min: 0, Max: 16, Step: 0.1},
+	}
+
+	getLevels := append([]*sbRadio.Value{}, setLevels...)
+	getLevels = append(getLevels, meters...)
+
+	parameters := []*sbRadio.Value{
+		{Name: "BACKLIGHT", Min: 0, Max: 1, Step: 0.1},
+	}
+
+	caps := sbRadio.Capabilities{
+		Vfos:          s.settings.Vfos,
+		Modes:         s.settings.Modes,
+		VfoOps:        []string{"CPY", "XCHG", "TOGGLE", "UP", "DOWN", "BAND_UP", "BAND_DOWN", "FROM_VFO", "TO_VFO"},
+		GetFunctions:  []string{"NB", "COMP", "VOX", "NR", "ANF", "LOCK"},
+		SetFunctions:  []string{"NB", "COMP", "VOX", "NR", "ANF", "LOCK"},
+		GetLevels:     getLevels,
+		SetLevels:     setLevels,
+		GetParameters: parameters,
+		SetParameters: parameters,
+		MaxRit:        int32(s.settings.MaxRit),
+		MaxXit:        int32(s.settings.MaxXit),
+		Filters:       hlMapToPbMap(s.settings.Filters),
+		TuningSteps:   hlMapToPbMap(simTuningSteps),
+		Preamps:       []int32{10, 20},
+		Attenuators:   []int32{6, 12, 18},
+		ModelName:     "Simulator",
+		Version:       "1.0",
+		MfgName:       "remoteRadio",
+		Status:        "Stable",
+		MemFirst:      simMemFirst,
+		MemLast:       simMemLast,
+		CtcssList:     simCtcssList,
+		DcsList:       simDcsList,
+	}
+
+	return caps
+}
+
+// fault delays the call by the configured latency and injects
+// timeouts and errors according to the configured rates.
+func (s *simRig) fault() error {
+	if s.settings.Latency > 0 {
+		time.Sleep(s.settings.Latency)
+	}
+	if s.settings.TimeoutRate > 0 && s.rand.Float64() < s.settings.TimeoutRate {
+		time.Sleep(s.settings.Timeout)
+		return errSimTimeout
+	}
+	if s.settings.ErrorRate > 0 && s.rand.Float64() < s.settings.ErrorRate {
+		return errSimError
+	}
+	return nil
+}
+
+// vfo returns the simulated VFO and makes sure that the rig is
+// turned on and not faulty
+func (s *simRig) vfo(name string) (*simVfo, error) {
+	if err := s.fault(); err != nil {
+		return nil, err
+	}
+	if !s.on {
+		return nil, errSimRigOff
+	}
+	v, ok := s.vfos[name]
+	if !ok {
+		return nil, errors.New("simulator: unknown vfo")
+	}
+	return v, nil
+}
+
+func (s *simRig) Open() error {
+	return nil
+}
+
+func (s *simRig) Close() error {
+	return nil
+}
+
+func (s *simRig) Caps() sbRadio.Capabilities {
+	return s.caps
+}
+
+func (s *simRig) SetConf(token string, value string) error {
+	return nil
+}
+
+func (s *simRig) GetPowerStat() (bool, error) {
+	if err := s.fault(); err != nil {
+		return false, err
+	}
+	return s.on, nil
+}
+
+func (s *simRig) SetPowerStat(on bool) error {
+	if err := s.fault(); err != nil {
+		return err
+	}
+	s.on = on
+	if !on {
+		s.ptt = false
+	}
+	return nil
+}
+
+func (s *simRig) GetVfo() (string, error) {
+	if _, err := s.vfo(s.currentVfo); err != nil {
+		return "", err
+	}
+	return s.currentVfo, nil
+}
+
+func (s *simRig) SetVfo(vfo string) error {
+	if _, err := s.vfo(vfo); err != nil {
+		return err
+	}
+	s.currentVfo = vfo
+	return nil
+}
+
+func (s *simRig) VfoOp(vfo string, op string) error {
+	v, err := s.vfo(vfo)
+	if err != nil {
+		return err
+	}
+
+	other := s.otherVfo(vfo)
+
+	switch op {
+	case "CPY":
+		if _, ok := s.vfos[other]; ok {
+			s.vfos[other] = v.copy()
+		}
+	case "XCHG":
+		if o, ok := s.vfos[other]; ok {
+			s.vfos[vfo], s.vfos[other] = o, v
+		}
+	case "TOGGLE":
+		s.currentVfo = other
+	case "UP":
+		v.freq += float64(v.ts)
+	case "DOWN":
+		v.freq -= float64(v.ts)
+	case "BAND_UP":
+		for _, edge := range simBands {
+			if edge > v.freq {
+				v.freq = edge
+				break
+			}
+		}
+	case "BAND_DOWN":
+		for i := len(simBands) - 1; i >= 0; i-- {
+			if simBands[i] < v.freq {
+				v.freq = simBands[i]
+				break
+			}
+		}
+	case "FROM_VFO":
+		// store the vfo in the current memory channel
+		ch := sbRadio.Channel{
+			Channel:   int32(s.memCh),
+			Frequency: v.freq,
+			Mode:      v.mode,
+			PbWidth:   int32(v.pbWidth),
+			Split:     &sbRadio.Split{},
+			CtcssTone: uint32(v.ctcssTone),
+			CtcssSql:  uint32(v.ctcssSql),
+			DcsCode:   uint32(v.dcsCode),
+			DcsSql:    uint32(v.dcsSql),
+		}
+		s.memories[s.memCh] = ch
+	case "TO_VFO":
+		// load the current memory channel into the vfo
+		ch, ok := s.memories[s.memCh]
+		if !ok {
+			return errors.New("simulator: memory channel is empty")
+		}
+		v.freq = ch.Frequency
+		v.mode = ch.Mode
+		v.pbWidth = int(ch.PbWidth)
+		v.ctcssTone = uint(ch.CtcssTone)
+		v.ctcssSql = uint(ch.CtcssSql)
+		v.dcsCode = uint(ch.DcsCode)
+		v.dcsSql = uint(ch.DcsSql)
+		s.splitOn = ch.Split.GetEnabled()
+		if s.splitOn {
+			s.splitVfo = ch.Split.GetVfo()
+			if tx, ok := s.vfos[s.splitVfo]; ok {
+				tx.freq = ch.Split.GetFrequency()
+				tx.mode = ch.Split.GetMode()
+				tx.pbWidth = int(ch.Split.GetPbWidth())
+			}
+		}
+	default:
+		return errors.New("simulator: unsupported vfo operation")
+	}
+
+	return nil
+}
+
+func (s *simRig) otherVfo(vfo string) string {
+	for _, name := range s.settings.Vfos {
+		if name != vfo {
+			return name
+		}
+	}
+	return vfo
+}
+
+func (s *simRig) GetFreq(vfo string) (float64, error) {
+	v, err := s.vfo(vfo)
+	if err != nil {
+		return 0, err
+	}
+	return v.freq, nil
+}
+
+func (s *simRig) SetFreq(vfo string, freq float64) error {
+	v, err := s.vfo(vfo)
+	if err != nil {
+		return err
+	}
+	if freq <= 0 {
+		return errSimError
+	}
+	v.freq = freq
+	return nil
+}
+
+func (s *simRig) GetMode(vfo string) (string, int, error) {
+	v, err := s.vfo(vfo)
+	if err != nil {
+		return "", 0, err
+	}
+	return v.mode, v.pbWidth, nil
+}
+
+func (s *simRig) SetMode(vfo string, mode string, pbWidth int) error {
+	v, err := s.vfo(vfo)
+	if err != nil {
+		return err
+	}
+	if !utils.StringInSlice(mode, s.settings.Modes) {
+		return errors.New("simulator: unsupported mode")
+	}
+	filters := s.settings.Filters[mode]
+	if pbWidth > 0 && len(filters) > 0 && !intInSlice(pbWidth, filters) {
+		return errors.New("simulator: unsupported passband width")
+	}
+	if pbWidth <= 0 && len(filters) > 0 {
+		pbWidth = filters[0]
+	}
+	v.mode = mode
+	v.pbWidth = pbWidth
+	return nil
+}
+
+func (s *simRig) GetPbNormal(mode string) (int, error) {
+	if err := s.fault(); err != nil {
+		return 0, err
+	}
+	filters, ok := s.settings.Filters[mode]
+	if !ok || len(filters) == 0 {
+		return 0, errors.New("simulator: no filters for this mode")
+	}
+	return filters[0], nil
+}
+
+func (s *simRig) GetAnt(vfo string) (int, error) {
+	v, err := s.vfo(vfo)
+	if err != nil {
+		return 0, err
+	}
+	return v.ant, nil
+}
+
+func (s *simRig) SetAnt(vfo string, ant int) error {
+	v, err := s.vfo(vfo)
+	if err != nil {
+		return err
+	}
+	v.ant = ant
+	return nil
+}
+
+func (s *simRig) GetRit(vfo string) (int, error) {
+	v, err := s.vfo(vfo)
+	if err != nil {
+		return 0, err
+	}
+	return v.rit, nil
+}
+
+func (s *simRig) SetRit(vfo string, rit int) error {
+	v, err := s.vfo(vfo)
+	if err != nil {
+		return err
+	}
+	if rit > s.settings.MaxRit || rit < -s.settings.MaxRit {
+		return errors.New("simulator: rit out of range")
+	}
+	v.rit = rit
+	return nil
+}
+
+func (s *simRig) GetXit(vfo string) (int, error) {
+	v, err := s.vfo(vfo)
+	if err != nil {
+		return 0, err
+	}
+	return v.xit, nil
+}
+
+func (s *simRig) SetXit(vfo string, xit int) error {
+	v, err := s.vfo(vfo)
+	if err != nil {
+		return err
+	}
+	if xit > s.settings.MaxXit || xit < -s.settings.MaxXit {
+		return errors.New("simulator: xit out of range")
+	}
+	v.xit = xit
+	return nil
+}
+
+func (s *simRig) GetCtcssTone(vfo string) (uint, error) {
+	v, err := s.vfo(vfo)
+	if err != nil {
+		return 0, err
+	}
+	return v.ctcssTone, nil
+}
+
+func (s *simRig) SetCtcssTone(vfo string, tone uint) error {
+	v, err := s.vfo(vfo)
+	if err != nil {
+		return err
+	}
+	if tone != 0 && !utils.Uint32InSlice(uint32(tone), simCtcssList) {
+		return errors.New("simulator: unsupported CTCSS tone")
+	}
+	v.ctcssTone = tone
+	return nil
+}
+
+func (s *simRig) GetCtcssSql(vfo string) (uint, error) {
+	v, err := s.vfo(vfo)
+	if err != nil {
+		return 0, err
+	}
+	return v.ctcssSql, nil
+}
+
+func (s *simRig) SetCtcssSql(vfo string, tone uint) error {
+	v, err := s.vfo(vfo)
+	if err != nil {
+		return err
+	}
+	if tone != 0 && !utils.Uint32InSlice(uint32(tone), simCtcssList) {
+		return errors.New("simulator: unsupported CTCSS tone")
+	}
+	v.ctcssSql = tone
+	return nil
+}
+
+func (s *simRig) GetDcsCode(vfo string) (uint, error) {
+	v, err := s.vfo(vfo)
+	if err != nil {
+		return 0, err
+	}
+	return v.dcsCode, nil
+}
+
+func (s *simRig) SetDcsCode(vfo string, code uint) error {
+	v, err := s.vfo(vfo)
+	if err != nil {
+		return err
+	}
+	if code != 0 && !utils.Uint32InSlice(uint32(code), simDcsList) {
+		return errors.New("simulator: unsupported DCS code")
+	}
+	v.dcsCode = code
+	return nil
+}
+
+func (s *simRig) GetDcsSql(vfo string) (uint, error) {
+	v, err := s.vfo(vfo)
+	if err != nil {
+		return 0, err
+	}
+	return v.dcsSql, nil
+}
+
+func (s *simRig) SetDcsSql(vfo string, code uint) error {
+	v, err := s.vfo(vfo)
+	if err != nil {
+		return err
+	}
+	if code != 0 && !utils.Uint32InSlice(uint32(code), simDcsList) {
+		return errors.New("simulator: unsupported DCS code")
+	}
+	v.dcsSql = code
+	return nil
+}
+
+func (s *simRig) GetRptrShift(vfo string) (string, error) {
+	v, err := s.vfo(vfo)
+	if err != nil {
+		return "", err
+	}
+	return v.rptrShift, nil
+}
+
+func (s *simRig) SetRptrShift(vfo string, shift string) error {
+	v, err := s.vfo(vfo)
+	if err != nil {
+		return err
+	}
+	switch shift {
+	case "NONE", "+", "-":
+	default:
+		return errors.New("simulator: unknown repeater shift")
+	}
+	v.rptrShift = shift
+	return nil
+}
+
+func (s *simRig) GetRptrOffs(vfo string) (int, error) {
+	v, err := s.vfo(vfo)
+	if err != nil {
+		return 0, err
+	}
+	return v.rptrOffs, nil
+}
+
+func (s *simRig) SetRptrOffs(vfo string, offs int) error {
+	v, err := s.vfo(vfo)
+	if err != nil {
+		return err
+	}
+	if offs < 0 {
+		return errors.New("simulator: invalid repeater offset")
+	}
+	v.rptrOffs = offs
+	return nil
+}
+
+func (s *simRig) GetTs(vfo string) (int, error) {
+	v, err := s.vfo(vfo)
+	if err != nil {
+		return 0, err
+	}
+	return v.ts, nil
+}
+
+func (s *simRig) SetTs(vfo string, ts int) error {
+	v, err := s.vfo(vfo)
+	if err != nil {
+		return err
+	}
+	if ts <= 0 {
+		return errSimError
+	}
+	v.ts = ts
+	return nil
+}
+
+func (s *simRig) GetSplit(vfo string) (bool, string, error) {
+	if _, err := s.vfo(vfo); err != nil {
+		return false, "", err
+	}
+	if !s.splitOn {
+		return false, "", nil
+	}
+	return true, s.splitVfo, nil
+}
+
+func (s *simRig) SetSplit(vfo string, enabled bool) error {
+	if _, err := s.vfo(vfo); err != nil {
+		return err
+	}
+	s.splitOn = enabled
+	if enabled && s.splitVfo == "" {
+		s.splitVfo = s.otherVfo(vfo)
+	}
+	return nil
+}
+
+func (s *simRig) SetSplitVfo(vfo string, enabled bool, txVfo string) error {
+	if _, err := s.vfo(vfo); err != nil {
+		return err
+	}
+	if _, ok := s.vfos[txVfo]; !ok {
+		return errors.New("simulator: unknown split tx vfo")
+	}
+	s.splitOn = enabled
+	s.splitVfo = txVfo
+	return nil
+}
+
+func (s *simRig) GetSplitFreq(txVfo string) (float64, error) {
+	return s.GetFreq(txVfo)
+}
+
+func (s *simRig) SetSplitFreq(txVfo string, freq float64) error {
+	return s.SetFreq(txVfo, freq)
+}
+
+func (s *simRig) GetSplitMode(txVfo string) (string, int, error) {
+	return s.GetMode(txVfo)
+}
+
+func (s *simRig) SetSplitMode(txVfo string, mode string, pbWidth int) error {
+	return s.SetMode(txVfo, mode, pbWidth)
+}
+
+func (s *simRig) GetFunc(vfo string, function string) (bool, error) {
+	v, err := s.vfo(vfo)
+	if err != nil {
+		return false, err
+	}
+	if !utils.StringInSlice(function, s.caps.GetFunctions) {
+		return false, errors.New("simulator: unsupported function")
+	}
+	return v.funcs[function], nil
+}
+
+func (s *simRig) SetFunc(vfo string, function string, on bool) error {
+	v, err := s.vfo(vfo)
+	if err != nil {
+		return err
+	}
+	if !utils.StringInSlice(function, s.caps.SetFunctions) {
+		return errors.New("simulator: unsupported function")
+	}
+	v.funcs[function] = on
+	return nil
+}
+
+func (s *simRig) GetLevel(vfo string, level string) (float32, error) {
+	v, err := s.vfo(vfo)
+	if err != nil {
+		return 0, err
+	}
+
+	switch level {
+	case "STRENGTH", "SWR", "ALC", "RFPOWER_METER", "COMP_METER", "ID_METER", "VD_METER":
+		return s.meter(v, level), nil
+	}
+
+	value, ok := v.levels[level]
+	if !ok {
+		return 0, errors.New("simulator: unsupported level")
+	}
+	return value, nil
+}
+
+func (s *simRig) SetLevel(vfo string, level string, value float32) error {
+	v, err := s.vfo(vfo)
+	if err != nil {
+		return err
+	}
+	for _, l := range s.caps.SetLevels {
+		if l.Name == level {
+			if value < l.Min || value > l.Max {
+				return errors.New("simulator: level out of range")
+			}
+			v.levels[level] = value
+			return nil
+		}
+	}
+	return errors.New("simulator: unsupported level")
+}
+
+func (s *simRig) GetParm(vfo string, parm string) (float32, error) {
+	v, err := s.vfo(vfo)
+	if err != nil {
+		return 0, err
+	}
+	value, ok := v.params[parm]
+	if !ok {
+		return 0, errors.New("simulator: unsupported parameter")
+	}
+	return value, nil
+}
+
+func (s *simRig) SetParm(vfo string, parm string, value float32) error {
+	v, err := s.vfo(vfo)
+	if err != nil {
+		return err
+	}
+	if _, ok := v.params[parm]; !ok {
+		return errors.New("simulator: unsupported parameter")
+	}
+	v.params[parm] = value
+	return nil
+}
+
+func (s *simRig) GetPtt(vfo string) (bool, error) {
+	if _, err := s.vfo(vfo); err != nil {
+		return false, err
+	}
+	return s.ptt, nil
+}
+
+func (s *simRig) SetPtt(vfo string, on bool) error {
+	if _, err := s.vfo(vfo); err != nil {
+		return err
+	}
+	s.ptt = on
+	return nil
+}
+
+func (s *simRig) GetMem(vfo string) (int, error) {
+	if _, err := s.vfo(vfo); err != nil {
+		return 0, err
+	}
+	return s.memCh, nil
+}
+
+func (s *simRig) SetMem(vfo string, ch int) error {
+	if _, err := s.vfo(vfo); err != nil {
+		return err
+	}
+	if ch < simMemFirst || ch > simMemLast {
+		return errors.New("simulator: invalid memory channel")
+	}
+	s.memCh = ch
+	return nil
+}
+
+func (s *simRig) GetChannel(vfo string, ch int) (sbRadio.Channel, error) {
+	if _, err := s.vfo(vfo); err != nil {
+		return sbRadio.Channel{}, err
+	}
+	if ch < simMemFirst || ch > simMemLast {
+		return sbRadio.Channel{}, errors.New("simulator: invalid memory channel")
+	}
+	c, ok := s.memories[ch]
+	if !ok {
+		return sbRadio.Channel{Channel: int32(ch), Split: &sbRadio.Split{}}, nil
+	}
+	return c, nil
+}
+
+func (s *simRig) SetChannel(vfo string, ch sbRadio.Channel) error {
+	if _, err := s.vfo(vfo); err != nil {
+		return err
+	}
+	if ch.Channel < simMemFirst || ch.Channel > simMemLast {
+		return errors.New("simulator: invalid memory channel")
+	}
+	if !utils.StringInSlice(ch.Mode, s.settings.Modes) {
+		return errors.New("simulator: unsupported mode")
+	}
+	if ch.Split == nil {
+		ch.Split = &sbRadio.Split{}
+	}
+	s.memories[int(ch.Channel)] = ch
+	return nil
+}
+
+func (s *simRig) ClearChannel(vfo string, ch int) error {
+	if _, err := s.vfo(vfo); err != nil {
+		return err
+	}
+	if ch < simMemFirst || ch > simMemLast {
+		return errors.New("simulator: invalid memory channel")
+	}
+	delete(s.memories, ch)
+	return nil
+}
+
+// SendMorse accepts the text immediately, like the keyer buffer of
+// most rigs.
+func (s *simRig) SendMorse(vfo string, text string) error {
+	v, err := s.vfo(vfo)
+	if err != nil {
+		return err
+	}
+	if v.mode != "CW" && v.mode != "CWR" {
+		return errors.New("simulator: keyer only available in CW")
+	}
+	return nil
+}
+
+func (s *simRig) StopMorse(vfo string) error {
+	_, err := s.vfo(vfo)
+	return err
+}
+
+// meter returns synthetic meter readings which slowly vary over time.
+// The S-Meter is only active while receiving, the other meters (except
+// for the supply voltage) while transmitting.
+func (s *simRig) meter(v *simVfo, level string) float32 {
+
+	t := time.Since(s.started).Seconds()
+	noise := s.rand.Float64() - 0.5
+
+	switch level {
+	case "STRENGTH":
+		if s.ptt {
+			return -54
+		}
+		// fading signal between S3 and S9+20dB
+		return float32(math.Floor(-13 + 25*math.Sin(t/4) + 3*noise))
+	case "SWR":
+		if !s.ptt {
+			return 1
+		}
+		return float32(1.3 + 0.2*math.Sin(t) + 0.05*noise)
+	case "ALC":
+		if !s.ptt {
+			return 0
+		}
+		alc := 0.5 + 0.4*math.Sin(t*3) + 0.1*noise
+		return float32(math.Max(0, math.Min(1, alc*float64(v.levels["RFPOWER"]))))
+	case "RFPOWER_METER":
+		if !s.ptt {
+			return 0
+		}
+		return float32(math.Max(0, float64(v.levels["RFPOWER"])*(0.95+0.05*noise)))
+	case "COMP_METER":
+		if !s.ptt {
+			return 0
+		}
+		return float32(math.Floor(30 * float64(v.levels["COMP"]) * (0.8 + 0.2*math.Sin(t*2))))
+	case "ID_METER":
+		if !s.ptt {
+			return 1.2
+		}
+		return float32(1.2 + 20*float64(v.levels["RFPOWER"]) + noise)
+	case "VD_METER":
+		if s.ptt {
+			return float32(13.2 + 0.1*noise)
+		}
+		return 13.8
+	}
+
+	return 0
+}
+
+func intInSlice(a int, list []int) bool {
+	for _, b := range list {
+		if b == a {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,205 @@
+package radio
+
+import (
+	"errors"
+	"fmt"
+	"log"
+
+	"github.com/dh1tw/remoteRadio/utils"
+)
+
+var errRptrUnsupported = errors.New("rig doesn't support repeater operation")
+
+// hasCtcss returns true if the rig supports CTCSS tones.
+func (r *radio) hasCtcss() bool {
+	return len(r.caps.CtcssList) > 0
+}
+
+// hasDcs returns true if the rig supports DCS codes.
+func (r *radio) hasDcs() bool {
+	return len(r.caps.DcsList) > 0
+}
+
+// hasRptr returns true if the repeater shift & offset should be
+// available. Hamlib doesn't advertise repeater support in the
+// capabilities, but rigs which can't generate sub-audible tones are
+// in practice not used on FM repeaters.
+func (r *radio) hasRptr() bool {
+	return r.hasCtcss() || r.hasDcs()
+}
+
+// queryTones reads the CTCSS, DCS and repeater settings of the vfo.
+// Not every rig which announces tones can read all of these settings;
+// fields which can't be read are logged and skipped.
+func (r *radio) queryTones(vfo string) {
+
+	if r.hasCtcss() {
+		if tone, err := r.rig.GetCtcssTone(vfo); err != nil {
+			log.Println("unable to read the CTCSS tone:", err)
+		} else {
+			r.state.Vfo.CtcssTone = uint32(tone)
+		}
+
+		if sql, err := r.rig.GetCtcssSql(vfo); err != nil {
+			log.Println("unable to read the CTCSS squelch:", err)
+		} else {
+			r.state.Vfo.CtcssSql = uint32(sql)
+		}
+	}
+
+	if r.hasDcs() {
+		if code, err := r.rig.GetDcsCode(vfo); err != nil {
+			log.Println("unable to read the DCS code:", err)
+		} else {
+			r.state.Vfo.DcsCode = uint32(code)
+		}
+
+		if sql, err := r.rig.GetDcsSql(vfo); err != nil {
+			log.Println("unable to read the DCS squelch:", err)
+		} else {
+			r.state.Vfo.DcsSql = uint32(sql)
+		}
+	}
+
+	if r.hasRptr() {
+		if shift, err := r.rig.GetRptrShift(vfo); err != nil {
+			log.Println("unable to read the repeater shift:", err)
+		} else {
+			r.state.Vfo.RptrShift = shift
+		}
+
+		if offs, err := r.rig.GetRptrOffs(vfo); err != nil {
+			log.Println("unable to read the repeater offset:", err)
+		} else {
+			r.state.Vfo.RptrOffset = int32(offs)
+		}
+	}
+}
+
+// checkCtcssTone returns an error if the tone [0.1 Hz] is not supported
+// by the rig. 0 (off) is always valid.
+func (r *radio) checkCtcssTone(tone uint32) error {
+	if !r.hasCtcss() {
+		return errors.New("rig doesn't support CTCSS")
+	}
+	if tone == 0 || utils.Uint32InSlice(tone, r.caps.CtcssList) {
+		return nil
+	}
+	return fmt.Errorf("unsupported CTCSS tone %.1fHz", float32(tone)/10)
+}
+
+// checkDcsCode returns an error if the DCS code is not supported by the
+// rig. 0 (off) is always valid.
+func (r *radio) checkDcsCode(code uint32) error {
+	if !r.hasDcs() {
+		return errors.New("rig doesn't support DCS")
+	}
+	if code == 0 || utils.Uint32InSlice(code, r.caps.DcsList) {
+		return nil
+	}
+	return fmt.Errorf("unsupported DCS code %03d", code)
+}
+
+func (r *radio) updateCtcssTone(newTone uint32) error {
+	if err := r.checkCtcssTone(newTone); err != nil {
+		return err
+	}
+	vfo := r.state.CurrentVfo
+	if err := r.rig.SetCtcssTone(vfo, uint(newTone)); err != nil {
+		return err
+	}
+	tone, err := r.rig.GetCtcssTone(vfo)
+	if err != nil {
+		return err
+	}
+	r.state.Vfo.CtcssTone = uint32(tone)
+	return nil
+}
+
+func (r *radio) updateCtcssSql(newTone uint32) error {
+	if err := r.checkCtcssTone(newTone); err != nil {
+		return err
+	}
+	vfo := r.state.CurrentVfo
+	if err := r.rig.SetCtcssSql(vfo, uint(newTone)); err != nil {
+		return err
+	}
+	tone, err := r.rig.GetCtcssSql(vfo)
+	if err != nil {
+		return err
+	}
+	r.state.Vfo.CtcssSql = uint32(tone)
+	return nil
+}
+
+func (r *radio) updateDcsCode(newCode uint32) error {
+	if err := r.checkDcsCode(newCode); err != nil {
+		return err
+	}
+	vfo := r.state.CurrentVfo
+	if err := r.rig.SetDcsCode(vfo, uint(newCode)); err != nil {
+		return err
+	}
+	code, err := r.rig.GetDcsCode(vfo)
+	if err != nil {
+		return err
+	}
+	r.state.Vfo.DcsCode = uint32(code)
+	return nil
+}
+
+func (r *radio) updateDcsSql(newCode uint32) error {
+	if err := r.checkDcsCode(newCode); err != nil {
+		return err
+	}
+	vfo := r.state.CurrentVfo
+	if err := r.rig.SetDcsSql(vfo, uint(newCode)); err != nil {
+		return err
+	}
+	code, err := r.rig.GetDcsSql(vfo)
+	if err != nil {
+		return err
+	}
+	r.state.Vfo.DcsSql = uint32(code)
+	return nil
+}
+
+func (r *radio) updateRptrShift(newShift string) error {
+	if !r.hasRptr() {
+		return errRptrUnsupported
+	}
+	switch newShift {
+	case "NONE", "+", "-":
+	default:
+		return fmt.Errorf("unknown repeater shift '%s'", newShift)
+	}
+	vfo := r.state.CurrentVfo
+	if err := r.rig.SetRptrShift(vfo, newShift); err != nil {
+		return err
+	}
+	shift, err := r.rig.GetRptrShift(vfo)
+	if err != nil {
+		return err
+	}
+	r.state.Vfo.RptrShift = shift
+	return nil
+}
+
+func (r *radio) updateRptrOffset(newOffs int32) error {
+	if !r.hasRptr() {
+		return errRptrUnsupported
+	}
+	if newOffs < 0 {
+		return errors.New("the repeater offset must not be negative")
+	}
+	vfo := r.state.CurrentVfo
+	if err := r.rig.SetRptrOffs(vfo, int(newOffs)); err != nil {
+		return err
+	}
+	offs, err := r.rig.GetRptrOffs(vfo)
+	if err != nil {
+		return err
+	}
+	r.state.Vfo.RptrOffset = int32(offs)
+	return nil
+}
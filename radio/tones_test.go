@@ -0,0 +1,39 @@
+package radio
+
+import (
+	"errors"
+	"testing"
+)
+
+// noCtcssSql is a rig which announces CTCSS tones but can't read the
+// CTCSS squelch.
+type noCtcssSql struct {
+	Rig
+}
+
+func (n noCtcssSql) GetCtcssSql(vfo string) (uint, error) {
+	return 0, errors.New("Feature not available")
+}
+
+// TestQueryTonesErrors verifies that a tone setting which can't be read
+// doesn't abort the query of the vfo.
+func TestQueryTonesErrors(t *testing.T) {
+	r := newTestRadio(t, RadioSettings{})
+
+	if err := r.rig.SetCtcssTone("VFOA", 885); err != nil {
+		t.Fatal(err)
+	}
+	r.rig = noCtcssSql{r.rig}
+	r.state.Vfo.CtcssTone = 0
+	r.state.Vfo.Levels = nil
+
+	if err := r.queryVfo(); err != nil {
+		t.Fatalf("queryVfo() error = %v", err)
+	}
+	if r.state.Vfo.CtcssTone != 885 {
+		t.Errorf("CtcssTone = %d, want 885", r.state.Vfo.CtcssTone)
+	}
+	if r.state.Vfo.Levels == nil {
+		t.Error("levels not queried")
+	}
+}
@@ -0,0 +1,132 @@
+package radio
+
+import (
+	"fmt"
+	"log"
+	"math"
+	"sort"
+
+	sbRadio "github.com/dh1tw/remoteRadio/sb_radio"
+)
+
+// findValue returns the capabilities of a level or parameter or nil
+// if it is not contained in the list.
+func findValue(name string, list []*sbRadio.Value) *sbRadio.Value {
+	for _, v := range list {
+		if v.Name == name {
+			return v
+		}
+	}
+	return nil
+}
+
+// fitValue snaps the value to the step of the level / parameter and
+// clamps it to its range. If reject is true, values outside of the range
+// result in an error instead. Hamlib leaves the range and the step of
+// some levels unspecified (0); they are not checked.
+func fitValue(spec *sbRadio.Value, value float32, reject bool) (float32, error) {
+
+	if math.IsNaN(float64(value)) || math.IsInf(float64(value), 0) {
+		return 0, fmt.Errorf("invalid value %v", value)
+	}
+
+	hasRange := spec.Max > spec.Min
+
+	if hasRange && (value < spec.Min || value > spec.Max) {
+		if reject {
+			return 0, fmt.Errorf("value %v out of range (%v..%v)", value, spec.Min, spec.Max)
+		}
+		value = float32(math.Max(float64(spec.Min), math.Min(float64(spec.Max), float64(value))))
+	}
+
+	if spec.Step > 0 {
+		min, step := float64(spec.Min), float64(spec.Step)
+		steps := math.Floor((float64(value)-min)/step + 0.5)
+		snapped := min + steps*step
+		// rounding errors must not push the value out of range
+		if hasRange && snapped > float64(spec.Max) {
+			snapped = float64(spec.Max)
+		}
+		value = float32(snapped)
+	}
+
+	return value, nil
+}
+
+// validateValues checks the requested levels or parameters against the
+// capabilities of the rig. Unknown and read-only names as well as
+// invalid values are rejected individually (field "<kind>.<name>").
+// The remaining values are returned, fitted to the range and step.
+func (r *radio) validateValues(ack *sbRadio.Ack, kind string,
+	values map[string]float32, getCaps, setCaps []*sbRadio.Value) map[string]float32 {
+
+	valid := make(map[string]float32, len(values))
+
+	// sorted, so that the rejections are reported in a stable order
+	names := make([]string, 0, len(values))
+	for name := range values {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		field := kind + "." + name
+
+		spec := findValue(name, setCaps)
+		if spec == nil {
+			if findValue(name, getCaps) != nil {
+				r.addResult(ack, field, fmt.Errorf("%s is read-only", name))
+			} else {
+				r.addResult(ack, field, fmt.Errorf("unsupported %s for this rig", name))
+			}
+			continue
+		}
+
+		value, err := fitValue(spec, values[name], r.settings.RejectOutOfRange)
+		if err != nil {
+			r.addResult(ack, field, err)
+			continue
+		}
+		if value != values[name] {
+			log.Printf("%s: %s adjusted from %v to %v (requested by %s)\n",
+				kind, name, values[name], value, ack.GetUserId())
+		}
+		valid[name] = value
+	}
+
+	return valid
+}
+
+func (r *radio) validateLevels(ack *sbRadio.Ack, levels map[string]float32) map[string]float32 {
+	return r.validateValues(ack, "levels", levels, r.caps.GetLevels, r.caps.SetLevels)
+}
+
+func (r *radio) validateParams(ack *sbRadio.Ack, params map[string]float32) map[string]float32 {
+	return r.validateValues(ack, "parameters", params, r.caps.GetParameters, r.caps.SetParameters)
+}
+
+func hlMapToPbMap(hlMap map[string][]int) map[string]*sbRadio.Int32List {
+
+	pbMap := make(map[string]*sbRadio.Int32List)
+
+	for k, v := range hlMap {
+		mv := sbRadio.Int32List{}
+		mv.Value = intListToint32List(v)
+		pbMap[k] = &mv
+	}
+
+	return pbMap
+}
+
+func intListToint32List(intList []int) []int32 {
+
+	int32List := make([]int32, 0, len(intList))
+
+	for _, i := range intList {
+		var v int32
+		v = int32(i)
+		int32List = append(int32List, v)
+	}
+
+	return int32List
+}
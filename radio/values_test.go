@@ -0,0 +1,50 @@
+package radio
+
+import (
+	"math"
+	"testing"
+
+	sbRadio "github.com/dh1tw/remoteRadio/sb_radio"
+)
+
+func TestFitValue(t *testing.T) {
+
+	percent := &sbRadio.Value{Name: "RFPOWER", Min: 0, Max: 1, Step: 0.01}
+	gain := &sbRadio.Value{Name: "PREAMP", Min: 10, Max: 20, Step: 10}
+	unspecified := &sbRadio.Value{Name: "KEYSPD"}
+
+	tests := []struct {
+		name    string
+		spec    *sbRadio.Value
+		value   float32
+		reject  bool
+		want    float32
+		wantErr bool
+	}{
+		{"within range", percent, 0.5, false, 0.5, false},
+		{"snapped to step", percent, 0.256, false, 0.26, false},
+		{"clamped to max", percent, 1.5, false, 1, false},
+		{"clamped to min", percent, -0.5, false, 0, false},
+		{"rejected above max", percent, 1.5, true, 0, true},
+		{"rejected below min", percent, -0.5, true, 0, true},
+		{"range edge accepted", percent, 1, true, 1, false},
+		{"step relative to min", gain, 14, false, 10, false},
+		{"step rounds half up", gain, 15, false, 20, false},
+		{"snapped value within range", gain, 19.9, true, 20, false},
+		{"no range nor step", unspecified, 42.3, true, 42.3, false},
+		{"NaN", percent, float32(math.NaN()), false, 0, true},
+		{"infinite", unspecified, float32(math.Inf(1)), false, 0, true},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := fitValue(tc.spec, tc.value, tc.reject)
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("fitValue() error = %v, wantErr %v", err, tc.wantErr)
+			}
+			if got != tc.want {
+				t.Errorf("fitValue() = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
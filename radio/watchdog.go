@@ -0,0 +1,65 @@
+package radio
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"time"
+)
+
+// The TX watchdog makes sure that the transmitter doesn't stay keyed
+// forever. PTT is forced off if the transmitter has been keyed for
+// longer than RadioSettings.MaxTxTime or if the client which keyed the
+// transmitter has stopped sending pings for RadioSettings.PingTimeout.
+
+// keyedBy records who keyed the transmitter and when.
+func (r *radio) keyedBy(userID string) {
+	r.pttOwner = userID
+	r.pttSince = time.Now()
+	// clients which don't ping at all are treated as if they had
+	// just pinged; they will be unkeyed after the ping timeout
+	r.lastPing[userID] = r.pttSince
+	r.state.PttOffReason = ""
+}
+
+// checkTxWatchdog is called periodically and forces PTT off when one
+// of the watchdog conditions is met.
+func (r *radio) checkTxWatchdog() {
+
+	if !r.conn.Connected() || !r.state.Ptt {
+		return
+	}
+
+	reason := ""
+	maxTx := r.settings.MaxTxTime
+	pingTimeout := r.settings.PingTimeout
+
+	if maxTx > 0 && time.Since(r.pttSince) > maxTx {
+		reason = fmt.Sprintf("TX timeout: transmitter keyed longer than %v", maxTx)
+	} else if pingTimeout > 0 && time.Since(r.lastPing[r.pttOwner]) > pingTimeout {
+		reason = fmt.Sprintf("lost connection to %s: no ping received for %v",
+			r.pttOwner, pingTimeout)
+	}
+
+	if reason == "" {
+		return
+	}
+
+	if err := r.updatePtt(false); err != nil {
+		// we will try again on the next tick
+		log.Println("TX watchdog: unable to unkey the transmitter:", err)
+		return
+	}
+
+	r.state.PttOffReason = reason
+
+	e := r.newError("ptt", errors.New(reason), r.pttOwner, "")
+	if err := r.sendError(e); err != nil {
+		log.Println(err)
+	}
+	if err := r.sendState(); err != nil {
+		log.Println(err)
+	}
+
+	r.pttOwner = ""
+}
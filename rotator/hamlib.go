@@ -0,0 +1,67 @@
+package rotator
+
+import (
+	hl "github.com/dh1tw/goHamlib"
+	sbRotator "github.com/dh1tw/remoteRadio/sb_rotator"
+)
+
+// hamlibRot implements the Rotator interface through goHamlib.
+type hamlibRot struct {
+	rot hl.Rotator
+}
+
+func newHamlibRot(s Settings) (*hamlibRot, error) {
+
+	h := &hamlibRot{
+		rot: hl.Rotator{},
+	}
+
+	if err := h.rot.Init(s.RotModel); err != nil {
+		return nil, err
+	}
+
+	if err := h.rot.SetPort(s.Port); err != nil {
+		return nil, err
+	}
+
+	return h, nil
+}
+
+func (h *hamlibRot) Open() error {
+	return h.rot.Open()
+}
+
+func (h *hamlibRot) Close() error {
+	h.rot.Close()
+	return h.rot.Cleanup()
+}
+
+func (h *hamlibRot) Caps() sbRotator.Capabilities {
+	return sbRotator.Capabilities{
+		RotModel:     int32(h.rot.Caps.RotModel),
+		ModelName:    h.rot.Caps.ModelName,
+		MfgName:      h.rot.Caps.MfgName,
+		Version:      h.rot.Caps.Version,
+		Status:       h.rot.Caps.Status,
+		MinAzimuth:   h.rot.Caps.MinAz,
+		MaxAzimuth:   h.rot.Caps.MaxAz,
+		MinElevation: h.rot.Caps.MinEl,
+		MaxElevation: h.rot.Caps.MaxEl,
+	}
+}
+
+func (h *hamlibRot) GetPosition() (float32, float32, error) {
+	return h.rot.GetPosition()
+}
+
+func (h *hamlibRot) SetPosition(azimuth, elevation float32) error {
+	return h.rot.SetPosition(azimuth, elevation)
+}
+
+func (h *hamlibRot) Stop() error {
+	return h.rot.Stop()
+}
+
+func (h *hamlibRot) Park() error {
+	return h.rot.Park()
+}
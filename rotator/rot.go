@@ -0,0 +1,49 @@
+package rotator
+
+import (
+	"fmt"
+
+	sbRotator "github.com/dh1tw/remoteRadio/sb_rotator"
+)
+
+// Rotator backends which can be selected through Settings.Backend
+const (
+	HamlibBackend    = "hamlib"
+	SimulatorBackend = "simulator"
+)
+
+// Rotator is the interface which has to be implemented by a rotator
+// backend so that it can be controlled by HandleRotator. Positions are
+// in degrees.
+type Rotator interface {
+	Open() error
+	Close() error
+	Caps() sbRotator.Capabilities
+
+	GetPosition() (azimuth, elevation float32, err error)
+	SetPosition(azimuth, elevation float32) error
+	Stop() error
+	Park() error
+}
+
+// checkBackend returns an error if the backend is unknown. If no backend
+// has been set, Hamlib will be used.
+func checkBackend(backend string) error {
+	switch backend {
+	case HamlibBackend, SimulatorBackend, "":
+		return nil
+	default:
+		return fmt.Errorf("unknown rotator backend: %s", backend)
+	}
+}
+
+// newRotator returns the Rotator backend selected in the Settings.
+func newRotator(s Settings) (Rotator, error) {
+	if err := checkBackend(s.Backend); err != nil {
+		return nil, err
+	}
+	if s.Backend == SimulatorBackend {
+		return newSimRot(), nil
+	}
+	return newHamlibRot(s)
+}
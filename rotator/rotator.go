@@ -0,0 +1,287 @@
+package rotator
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/cskr/pubsub"
+	hl "github.com/dh1tw/goHamlib"
+	"github.com/dh1tw/remoteRadio/comms"
+	"github.com/dh1tw/remoteRadio/connection"
+	"github.com/dh1tw/remoteRadio/events"
+	sbRotator "github.com/dh1tw/remoteRadio/sb_rotator"
+)
+
+var errRotDisconnected = errors.New("rotator disconnected")
+
+type Settings struct {
+	ID              string // used in the logs
+	Backend         string
+	RotModel        int
+	Port            hl.Port
+	PollingInterval time.Duration
+	RequestCh       chan []byte
+	ToWireCh        chan comms.IOMsg
+	CapsTopic       string
+	PositionTopic   string
+	AckTopic        string
+	WaitGroup       *sync.WaitGroup
+	Events          *pubsub.PubSub
+}
+
+type rotator struct {
+	rot      Rotator
+	caps     sbRotator.Capabilities
+	position sbRotator.Position
+	settings *Settings
+	conn     *connection.Monitor
+}
+
+// HandleRotator serves a rotator until the application shuts down. The
+// rotator is only accessed from this goroutine.
+func HandleRotator(rs Settings) {
+
+	defer rs.WaitGroup.Done()
+
+	shutdownCh := rs.Events.Sub(events.Shutdown)
+
+	r := rotator{
+		settings: &rs,
+		conn:     connection.NewMonitor("rotator", rs.ID, rs.Events, events.RotatorStatus),
+	}
+
+	// an invalid configuration can not be fixed by reconnecting
+	if err := checkBackend(rs.Backend); err != nil {
+		log.Println(err)
+		r.settings.Events.Pub(true, events.Shutdown)
+		return
+	}
+
+	pollingTicker := time.NewTicker(rs.PollingInterval)
+	defer pollingTicker.Stop()
+
+	if err := r.connect(); err != nil {
+		r.disconnect(err)
+	}
+
+	for {
+		select {
+		case <-shutdownCh:
+			log.Println("Disconnecting from Rotator")
+			if r.conn.Connected() {
+				r.rot.Close()
+			}
+			return
+
+		case msg := <-rs.RequestCh:
+			r.handleRequest(msg)
+
+		case <-pollingTicker.C:
+			if !r.conn.Connected() {
+				continue
+			}
+			r.conn.Check(r.updatePosition())
+			if r.conn.Lost() {
+				r.disconnect(r.conn.LastError())
+			}
+
+		// only armed while the rotator is disconnected
+		case <-r.conn.Reconnect():
+			if err := r.connect(); err != nil {
+				r.disconnect(err)
+			}
+		}
+	}
+}
+
+// connect opens the rotator and publishes its capabilities and
+// position.
+func (r *rotator) connect() error {
+
+	rot, err := newRotator(*r.settings)
+	if err != nil {
+		return err
+	}
+
+	if err := rot.Open(); err != nil {
+		rot.Close()
+		return err
+	}
+
+	r.rot = rot
+	r.conn.SetConnected()
+
+	r.caps = r.rot.Caps()
+	if err := r.sendCaps(); err != nil {
+		log.Println(err)
+	}
+
+	if err := r.updatePosition(); err != nil {
+		log.Println(err)
+	}
+
+	return nil
+}
+
+// disconnect closes the rotator after it has been lost or could not be
+// opened.
+func (r *rotator) disconnect(err error) {
+
+	if r.conn.Connected() {
+		r.rot.Close()
+	}
+	r.conn.SetDisconnected(err)
+}
+
+// updatePosition reads the position and publishes it if it has
+// changed.
+func (r *rotator) updatePosition() error {
+
+	az, el, err := r.rot.GetPosition()
+	if err != nil {
+		return err
+	}
+
+	pos := r.position
+	pos.Moving = az != r.position.Azimuth || el != r.position.Elevation
+	pos.Azimuth = az
+	pos.Elevation = el
+
+	// the target has been reached
+	if pos.HasTarget && !pos.Moving &&
+		az == pos.TargetAzimuth && el == pos.TargetElevation {
+		pos.HasTarget = false
+	}
+
+	if pos == r.position {
+		return nil
+	}
+
+	r.position = pos
+
+	return r.sendPosition()
+}
+
+func (r *rotator) handleRequest(msg []byte) {
+
+	req := sbRotator.Request{}
+	if err := req.Unmarshal(msg); err != nil {
+		log.Println(err)
+		return
+	}
+
+	ack := sbRotator.Ack{
+		UserId:    req.GetUserId(),
+		RequestId: req.GetRequestId(),
+	}
+
+	if err := r.execRequest(&req); err != nil {
+		ack.Error = err.Error()
+	}
+
+	if err := r.sendPosition(); err != nil {
+		log.Println(err)
+	}
+
+	if err := r.sendAck(ack); err != nil {
+		log.Println(err)
+	}
+}
+
+func (r *rotator) execRequest(req *sbRotator.Request) error {
+
+	if !r.conn.Connected() {
+		return errRotDisconnected
+	}
+
+	switch req.GetOperation() {
+	case "set_position":
+		az, el := req.GetAzimuth(), req.GetElevation()
+		if az < r.caps.MinAzimuth || az > r.caps.MaxAzimuth {
+			return fmt.Errorf("azimuth %.1f out of range (%.1f - %.1f)",
+				az, r.caps.MinAzimuth, r.caps.MaxAzimuth)
+		}
+		if el < r.caps.MinElevation || el > r.caps.MaxElevation {
+			return fmt.Errorf("elevation %.1f out of range (%.1f - %.1f)",
+				el, r.caps.MinElevation, r.caps.MaxElevation)
+		}
+		if err := r.rot.SetPosition(az, el); err != nil {
+			return err
+		}
+		r.position.HasTarget = true
+		r.position.TargetAzimuth = az
+		r.position.TargetElevation = el
+
+	case "stop":
+		if err := r.rot.Stop(); err != nil {
+			return err
+		}
+		r.position.HasTarget = false
+
+	case "park":
+		if err := r.rot.Park(); err != nil {
+			return err
+		}
+		r.position.HasTarget = false
+
+	default:
+		return fmt.Errorf("unknown operation '%s'", req.GetOperation())
+	}
+
+	log.Printf("%s: rotator %s %s\n", req.GetUserId(), r.settings.ID, req.GetOperation())
+
+	return nil
+}
+
+// sendCaps publishes the capabilities (retained).
+func (r *rotator) sendCaps() error {
+
+	data, err := r.caps.Marshal()
+	if err != nil {
+		return err
+	}
+
+	r.settings.ToWireCh <- comms.IOMsg{
+		Topic:  r.settings.CapsTopic,
+		Data:   data,
+		Retain: true,
+	}
+
+	return nil
+}
+
+// sendPosition publishes the position (retained), so that clients
+// joining late show the current position.
+func (r *rotator) sendPosition() error {
+
+	data, err := r.position.Marshal()
+	if err != nil {
+		return err
+	}
+
+	r.settings.ToWireCh <- comms.IOMsg{
+		Topic:  r.settings.PositionTopic,
+		Data:   data,
+		Retain: true,
+	}
+
+	return nil
+}
+
+func (r *rotator) sendAck(ack sbRotator.Ack) error {
+
+	data, err := ack.Marshal()
+	if err != nil {
+		return err
+	}
+
+	r.settings.ToWireCh <- comms.IOMsg{
+		Topic: r.settings.AckTopic,
+		Data:  data,
+	}
+
+	return nil
+}
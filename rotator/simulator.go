@@ -0,0 +1,97 @@
+package rotator
+
+import (
+	"math"
+	"time"
+
+	sbRotator "github.com/dh1tw/remoteRadio/sb_rotator"
+)
+
+const (
+	simSpeed  = 6.0 // degrees per second
+	simMaxAz  = 360
+	simMaxEl  = 90
+	simParkAz = 0
+	simParkEl = 0
+)
+
+// simRot is a rotator which turns with a constant speed towards the
+// requested position. It allows to run the server without hardware.
+type simRot struct {
+	az, el             float64
+	targetAz, targetEl float64
+	lastUpdate         time.Time
+}
+
+func newSimRot() *simRot {
+	// starts in the park position
+	return &simRot{
+		az:         simParkAz,
+		el:         simParkEl,
+		targetAz:   simParkAz,
+		targetEl:   simParkEl,
+		lastUpdate: time.Now(),
+	}
+}
+
+func (s *simRot) Open() error {
+	return nil
+}
+
+func (s *simRot) Close() error {
+	return nil
+}
+
+func (s *simRot) Caps() sbRotator.Capabilities {
+	return sbRotator.Capabilities{
+		ModelName:    "Simulator",
+		MfgName:      "remoteRadio",
+		Status:       "Stable",
+		MaxAzimuth:   simMaxAz,
+		MaxElevation: simMaxEl,
+	}
+}
+
+// move turns the rotator by the distance it has covered since the
+// last call.
+func (s *simRot) move() {
+	now := time.Now()
+	step := now.Sub(s.lastUpdate).Seconds() * simSpeed
+	s.lastUpdate = now
+
+	s.az = approach(s.az, s.targetAz, step)
+	s.el = approach(s.el, s.targetEl, step)
+}
+
+func approach(pos, target, step float64) float64 {
+	if math.Abs(target-pos) <= step {
+		return target
+	}
+	if target > pos {
+		return pos + step
+	}
+	return pos - step
+}
+
+func (s *simRot) GetPosition() (float32, float32, error) {
+	s.move()
+	return float32(s.az), float32(s.el), nil
+}
+
+func (s *simRot) SetPosition(azimuth, elevation float32) error {
+	s.move()
+	s.targetAz = float64(azimuth)
+	s.targetEl = float64(elevation)
+	return nil
+}
+
+func (s *simRot) Stop() error {
+	s.move()
+	s.targetAz = s.az
+	s.targetEl = s.el
+	return nil
+}
+
+func (s *simRot) Park() error {
+	return s.SetPosition(simParkAz, simParkEl)
+}
@@ -10,13 +10,25 @@
 
 	It has these top-level messages:
 		State
+		StateDelta
+		Meters
+		ResyncRequest
+		LogLine
+		SetLogLevel
+		SendMorse
+		MorseProgress
 		SetState
+		Error
+		Alarm
+		Ack
+		Lock
 		Capabilities
 		Int32List
-		Status
 		Vfo
 		MetaData
 		Channel
+		MemoryRequest
+		MemoryResponse
 		Value
 		Function
 		Level
@@ -49,6 +61,10 @@ type State struct {
 	RadioOn         bool     `protobuf:"varint,4,opt,name=radio_on,json=radioOn,proto3" json:"radio_on,omitempty"`
 	Ptt             bool     `protobuf:"varint,5,opt,name=ptt,proto3" json:"ptt,omitempty"`
 	PollingInterval int32    `protobuf:"varint,6,opt,name=polling_interval,json=pollingInterval,proto3" json:"polling_interval,omitempty"`
+	Version         uint64   `protobuf:"varint,7,opt,name=version,proto3" json:"version,omitempty"`
+	PttOffReason    string   `protobuf:"bytes,8,opt,name=ptt_off_reason,json=pttOffReason,proto3" json:"ptt_off_reason,omitempty"`
+	LockHolder      string   `protobuf:"bytes,9,opt,name=lock_holder,json=lockHolder,proto3" json:"lock_holder,omitempty"`
+	LockExpires     int64    `protobuf:"varint,10,opt,name=lock_expires,json=lockExpires,proto3" json:"lock_expires,omitempty"`
 }
 
 func (m *State) Reset()                    { *m = State{} }
@@ -98,2190 +114,7295 @@ func (m *State) GetPollingInterval() int32 {
 	return 0
 }
 
-type SetState struct {
-	CurrentVfo      string    `protobuf:"bytes,1,opt,name=current_vfo,json=currentVfo,proto3" json:"current_vfo,omitempty"`
-	Vfo             *Vfo      `protobuf:"bytes,2,opt,name=vfo" json:"vfo,omitempty"`
-	Channel         *Channel  `protobuf:"bytes,3,opt,name=channel" json:"channel,omitempty"`
-	VfoOperations   []string  `protobuf:"bytes,4,rep,name=vfo_operations,json=vfoOperations" json:"vfo_operations,omitempty"`
-	RadioOn         bool      `protobuf:"varint,5,opt,name=radio_on,json=radioOn,proto3" json:"radio_on,omitempty"`
-	Ptt             bool      `protobuf:"varint,6,opt,name=ptt,proto3" json:"ptt,omitempty"`
-	Md              *MetaData `protobuf:"bytes,7,opt,name=md" json:"md,omitempty"`
-	PollingInterval int32     `protobuf:"varint,8,opt,name=polling_interval,json=pollingInterval,proto3" json:"polling_interval,omitempty"`
-	UserId          string    `protobuf:"bytes,9,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-}
-
-func (m *SetState) Reset()                    { *m = SetState{} }
-func (m *SetState) String() string            { return proto.CompactTextString(m) }
-func (*SetState) ProtoMessage()               {}
-func (*SetState) Descriptor() ([]byte, []int) { return fileDescriptorRadio, []int{1} }
-
-func (m *SetState) GetCurrentVfo() string {
-	if m != nil {
-		return m.CurrentVfo
-	}
-	return ""
-}
-
-func (m *SetState) GetVfo() *Vfo {
+func (m *State) GetVersion() uint64 {
 	if m != nil {
-		return m.Vfo
+		return m.Version
 	}
-	return nil
+	return 0
 }
 
-func (m *SetState) GetChannel() *Channel {
+func (m *State) GetPttOffReason() string {
 	if m != nil {
-		return m.Channel
+		return m.PttOffReason
 	}
-	return nil
+	return ""
 }
 
-func (m *SetState) GetVfoOperations() []string {
+func (m *State) GetLockHolder() string {
 	if m != nil {
-		return m.VfoOperations
+		return m.LockHolder
 	}
-	return nil
+	return ""
 }
 
-func (m *SetState) GetRadioOn() bool {
+func (m *State) GetLockExpires() int64 {
 	if m != nil {
-		return m.RadioOn
+		return m.LockExpires
 	}
-	return false
+	return 0
 }
 
-func (m *SetState) GetPtt() bool {
-	if m != nil {
-		return m.Ptt
-	}
-	return false
+type StateDelta struct {
+	Version     uint64   `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	BaseVersion uint64   `protobuf:"varint,2,opt,name=base_version,json=baseVersion,proto3" json:"base_version,omitempty"`
+	Changed     []string `protobuf:"bytes,3,rep,name=changed" json:"changed,omitempty"`
+	State       *State   `protobuf:"bytes,4,opt,name=state" json:"state,omitempty"`
+	Removed     []string `protobuf:"bytes,5,rep,name=removed" json:"removed,omitempty"`
 }
 
-func (m *SetState) GetMd() *MetaData {
-	if m != nil {
-		return m.Md
-	}
-	return nil
-}
+func (m *StateDelta) Reset()                    { *m = StateDelta{} }
+func (m *StateDelta) String() string            { return proto.CompactTextString(m) }
+func (*StateDelta) ProtoMessage()               {}
+func (*StateDelta) Descriptor() ([]byte, []int) { return fileDescriptorRadio, []int{1} }
 
-func (m *SetState) GetPollingInterval() int32 {
+func (m *StateDelta) GetVersion() uint64 {
 	if m != nil {
-		return m.PollingInterval
+		return m.Version
 	}
 	return 0
 }
 
-func (m *SetState) GetUserId() string {
+func (m *StateDelta) GetBaseVersion() uint64 {
 	if m != nil {
-		return m.UserId
+		return m.BaseVersion
 	}
-	return ""
-}
-
-type Capabilities struct {
-	Vfos          []string              `protobuf:"bytes,1,rep,name=vfos" json:"vfos,omitempty"`
-	Modes         []string              `protobuf:"bytes,2,rep,name=modes" json:"modes,omitempty"`
-	VfoOps        []string              `protobuf:"bytes,3,rep,name=vfo_ops,json=vfoOps" json:"vfo_ops,omitempty"`
-	GetFunctions  []string              `protobuf:"bytes,4,rep,name=get_functions,json=getFunctions" json:"get_functions,omitempty"`
-	SetFunctions  []string              `protobuf:"bytes,5,rep,name=set_functions,json=setFunctions" json:"set_functions,omitempty"`
-	GetLevels     []*Value              `protobuf:"bytes,6,rep,name=get_levels,json=getLevels" json:"get_levels,omitempty"`
-	SetLevels     []*Value              `protobuf:"bytes,7,rep,name=set_levels,json=setLevels" json:"set_levels,omitempty"`
-	GetParameters []*Value              `protobuf:"bytes,8,rep,name=get_parameters,json=getParameters" json:"get_parameters,omitempty"`
-	SetParameters []*Value              `protobuf:"bytes,9,rep,name=set_parameters,json=setParameters" json:"set_parameters,omitempty"`
-	MaxRit        int32                 `protobuf:"varint,10,opt,name=max_rit,json=maxRit,proto3" json:"max_rit,omitempty"`
-	MaxXit        int32                 `protobuf:"varint,11,opt,name=max_xit,json=maxXit,proto3" json:"max_xit,omitempty"`
-	MaxIfShift    int32                 `protobuf:"varint,12,opt,name=max_if_shift,json=maxIfShift,proto3" json:"max_if_shift,omitempty"`
-	Filters       map[string]*Int32List `protobuf:"bytes,13,rep,name=filters" json:"filters,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value"`
-	TuningSteps   map[string]*Int32List `protobuf:"bytes,14,rep,name=tuning_steps,json=tuningSteps" json:"tuning_steps,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value"`
-	Preamps       []int32               `protobuf:"varint,15,rep,packed,name=preamps" json:"preamps,omitempty"`
-	Attenuators   []int32               `protobuf:"varint,16,rep,packed,name=attenuators" json:"attenuators,omitempty"`
-	RigModel      int32                 `protobuf:"varint,17,opt,name=rig_model,json=rigModel,proto3" json:"rig_model,omitempty"`
-	ModelName     string                `protobuf:"bytes,18,opt,name=model_name,json=modelName,proto3" json:"model_name,omitempty"`
-	Version       string                `protobuf:"bytes,19,opt,name=version,proto3" json:"version,omitempty"`
-	MfgName       string                `protobuf:"bytes,20,opt,name=mfg_name,json=mfgName,proto3" json:"mfg_name,omitempty"`
-	Status        string                `protobuf:"bytes,21,opt,name=status,proto3" json:"status,omitempty"`
+	return 0
 }
 
-func (m *Capabilities) Reset()                    { *m = Capabilities{} }
-func (m *Capabilities) String() string            { return proto.CompactTextString(m) }
-func (*Capabilities) ProtoMessage()               {}
-func (*Capabilities) Descriptor() ([]byte, []int) { return fileDescriptorRadio, []int{2} }
-
-func (m *Capabilities) GetVfos() []string {
+func (m *StateDelta) GetChanged() []string {
 	if m != nil {
-		return m.Vfos
+		return m.Changed
 	}
 	return nil
 }
 
-func (m *Capabilities) GetModes() []string {
+func (m *StateDelta) GetState() *State {
 	if m != nil {
-		return m.Modes
+		return m.State
 	}
 	return nil
 }
 
-func (m *Capabilities) GetVfoOps() []string {
+func (m *StateDelta) GetRemoved() []string {
 	if m != nil {
-		return m.VfoOps
+		return m.Removed
 	}
 	return nil
 }
 
-func (m *Capabilities) GetGetFunctions() []string {
-	if m != nil {
-		return m.GetFunctions
-	}
-	return nil
+type Meters struct {
+	Timestamp int64              `protobuf:"varint,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Ptt       bool               `protobuf:"varint,2,opt,name=ptt,proto3" json:"ptt,omitempty"`
+	Values    map[string]float32 `protobuf:"bytes,3,rep,name=values" json:"values,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"fixed32,2,opt,name=value,proto3"`
 }
 
-func (m *Capabilities) GetSetFunctions() []string {
+func (m *Meters) Reset()                    { *m = Meters{} }
+func (m *Meters) String() string            { return proto.CompactTextString(m) }
+func (*Meters) ProtoMessage()               {}
+func (*Meters) Descriptor() ([]byte, []int) { return fileDescriptorRadio, []int{2} }
+
+func (m *Meters) GetTimestamp() int64 {
 	if m != nil {
-		return m.SetFunctions
+		return m.Timestamp
 	}
-	return nil
+	return 0
 }
 
-func (m *Capabilities) GetGetLevels() []*Value {
+func (m *Meters) GetPtt() bool {
 	if m != nil {
-		return m.GetLevels
+		return m.Ptt
 	}
-	return nil
+	return false
 }
 
-func (m *Capabilities) GetSetLevels() []*Value {
+func (m *Meters) GetValues() map[string]float32 {
 	if m != nil {
-		return m.SetLevels
+		return m.Values
 	}
 	return nil
 }
 
-func (m *Capabilities) GetGetParameters() []*Value {
-	if m != nil {
-		return m.GetParameters
-	}
-	return nil
+type ResyncRequest struct {
+	UserId  string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Version uint64 `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
 }
 
-func (m *Capabilities) GetSetParameters() []*Value {
+func (m *ResyncRequest) Reset()                    { *m = ResyncRequest{} }
+func (m *ResyncRequest) String() string            { return proto.CompactTextString(m) }
+func (*ResyncRequest) ProtoMessage()               {}
+func (*ResyncRequest) Descriptor() ([]byte, []int) { return fileDescriptorRadio, []int{3} }
+
+func (m *ResyncRequest) GetUserId() string {
 	if m != nil {
-		return m.SetParameters
+		return m.UserId
 	}
-	return nil
+	return ""
 }
 
-func (m *Capabilities) GetMaxRit() int32 {
+func (m *ResyncRequest) GetVersion() uint64 {
 	if m != nil {
-		return m.MaxRit
+		return m.Version
 	}
 	return 0
 }
 
-func (m *Capabilities) GetMaxXit() int32 {
-	if m != nil {
-		return m.MaxXit
-	}
-	return 0
+type LogLine struct {
+	Timestamp int64  `protobuf:"varint,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Level     int32  `protobuf:"varint,2,opt,name=level,proto3" json:"level,omitempty"`
+	Msg       string `protobuf:"bytes,3,opt,name=msg,proto3" json:"msg,omitempty"`
 }
 
-func (m *Capabilities) GetMaxIfShift() int32 {
+func (m *LogLine) Reset()                    { *m = LogLine{} }
+func (m *LogLine) String() string            { return proto.CompactTextString(m) }
+func (*LogLine) ProtoMessage()               {}
+func (*LogLine) Descriptor() ([]byte, []int) { return fileDescriptorRadio, []int{4} }
+
+func (m *LogLine) GetTimestamp() int64 {
 	if m != nil {
-		return m.MaxIfShift
+		return m.Timestamp
 	}
 	return 0
 }
 
-func (m *Capabilities) GetFilters() map[string]*Int32List {
+func (m *LogLine) GetLevel() int32 {
 	if m != nil {
-		return m.Filters
+		return m.Level
 	}
-	return nil
+	return 0
 }
 
-func (m *Capabilities) GetTuningSteps() map[string]*Int32List {
+func (m *LogLine) GetMsg() string {
 	if m != nil {
-		return m.TuningSteps
+		return m.Msg
 	}
-	return nil
+	return ""
 }
 
-func (m *Capabilities) GetPreamps() []int32 {
-	if m != nil {
-		return m.Preamps
-	}
-	return nil
+type SetLogLevel struct {
+	UserId    string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Level     int32  `protobuf:"varint,2,opt,name=level,proto3" json:"level,omitempty"`
+	RequestId string `protobuf:"bytes,3,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
 }
 
-func (m *Capabilities) GetAttenuators() []int32 {
+func (m *SetLogLevel) Reset()                    { *m = SetLogLevel{} }
+func (m *SetLogLevel) String() string            { return proto.CompactTextString(m) }
+func (*SetLogLevel) ProtoMessage()               {}
+func (*SetLogLevel) Descriptor() ([]byte, []int) { return fileDescriptorRadio, []int{5} }
+
+func (m *SetLogLevel) GetUserId() string {
 	if m != nil {
-		return m.Attenuators
+		return m.UserId
 	}
-	return nil
+	return ""
 }
 
-func (m *Capabilities) GetRigModel() int32 {
+func (m *SetLogLevel) GetLevel() int32 {
 	if m != nil {
-		return m.RigModel
+		return m.Level
 	}
 	return 0
 }
 
-func (m *Capabilities) GetModelName() string {
+func (m *SetLogLevel) GetRequestId() string {
 	if m != nil {
-		return m.ModelName
+		return m.RequestId
 	}
 	return ""
 }
 
-func (m *Capabilities) GetVersion() string {
+type SendMorse struct {
+	UserId    string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	RequestId string `protobuf:"bytes,2,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	Text      string `protobuf:"bytes,3,opt,name=text,proto3" json:"text,omitempty"`
+	Speed     int32  `protobuf:"varint,4,opt,name=speed,proto3" json:"speed,omitempty"`
+	Abort     bool   `protobuf:"varint,5,opt,name=abort,proto3" json:"abort,omitempty"`
+}
+
+func (m *SendMorse) Reset()                    { *m = SendMorse{} }
+func (m *SendMorse) String() string            { return proto.CompactTextString(m) }
+func (*SendMorse) ProtoMessage()               {}
+func (*SendMorse) Descriptor() ([]byte, []int) { return fileDescriptorRadio, []int{6} }
+
+func (m *SendMorse) GetUserId() string {
 	if m != nil {
-		return m.Version
+		return m.UserId
 	}
 	return ""
 }
 
-func (m *Capabilities) GetMfgName() string {
+func (m *SendMorse) GetRequestId() string {
 	if m != nil {
-		return m.MfgName
+		return m.RequestId
 	}
 	return ""
 }
 
-func (m *Capabilities) GetStatus() string {
+func (m *SendMorse) GetText() string {
 	if m != nil {
-		return m.Status
+		return m.Text
 	}
 	return ""
 }
 
-type Int32List struct {
-	Value []int32 `protobuf:"varint,14,rep,packed,name=value" json:"value,omitempty"`
-}
-
-func (m *Int32List) Reset()                    { *m = Int32List{} }
-func (m *Int32List) String() string            { return proto.CompactTextString(m) }
-func (*Int32List) ProtoMessage()               {}
-func (*Int32List) Descriptor() ([]byte, []int) { return fileDescriptorRadio, []int{3} }
-
-func (m *Int32List) GetValue() []int32 {
+func (m *SendMorse) GetSpeed() int32 {
 	if m != nil {
-		return m.Value
+		return m.Speed
 	}
-	return nil
-}
-
-type Status struct {
-	Online bool `protobuf:"varint,1,opt,name=online,proto3" json:"online,omitempty"`
+	return 0
 }
 
-func (m *Status) Reset()                    { *m = Status{} }
-func (m *Status) String() string            { return proto.CompactTextString(m) }
-func (*Status) ProtoMessage()               {}
-func (*Status) Descriptor() ([]byte, []int) { return fileDescriptorRadio, []int{4} }
-
-func (m *Status) GetOnline() bool {
+func (m *SendMorse) GetAbort() bool {
 	if m != nil {
-		return m.Online
+		return m.Abort
 	}
 	return false
 }
 
-type Vfo struct {
-	Frequency  float64            `protobuf:"fixed64,2,opt,name=frequency,proto3" json:"frequency,omitempty"`
-	Mode       string             `protobuf:"bytes,3,opt,name=mode,proto3" json:"mode,omitempty"`
-	PbWidth    int32              `protobuf:"varint,4,opt,name=pb_width,json=pbWidth,proto3" json:"pb_width,omitempty"`
-	Ant        int32              `protobuf:"varint,5,opt,name=ant,proto3" json:"ant,omitempty"`
-	Rit        int32              `protobuf:"varint,9,opt,name=rit,proto3" json:"rit,omitempty"`
-	Xit        int32              `protobuf:"varint,10,opt,name=xit,proto3" json:"xit,omitempty"`
-	Split      *Split             `protobuf:"bytes,11,opt,name=split" json:"split,omitempty"`
-	TuningStep int32              `protobuf:"varint,12,opt,name=tuning_step,json=tuningStep,proto3" json:"tuning_step,omitempty"`
-	Functions  []string           `protobuf:"bytes,13,rep,name=functions" json:"functions,omitempty"`
-	Levels     map[string]float32 `protobuf:"bytes,14,rep,name=levels" json:"levels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"fixed32,2,opt,name=value,proto3"`
-	Parameters map[string]float32 `protobuf:"bytes,15,rep,name=parameters" json:"parameters,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"fixed32,2,opt,name=value,proto3"`
+type MorseProgress struct {
+	RequestId string `protobuf:"bytes,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	UserId    string `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Sent      int32  `protobuf:"varint,3,opt,name=sent,proto3" json:"sent,omitempty"`
+	Total     int32  `protobuf:"varint,4,opt,name=total,proto3" json:"total,omitempty"`
+	Done      bool   `protobuf:"varint,5,opt,name=done,proto3" json:"done,omitempty"`
+	Aborted   bool   `protobuf:"varint,6,opt,name=aborted,proto3" json:"aborted,omitempty"`
+	Error     string `protobuf:"bytes,7,opt,name=error,proto3" json:"error,omitempty"`
 }
 
-func (m *Vfo) Reset()                    { *m = Vfo{} }
-func (m *Vfo) String() string            { return proto.CompactTextString(m) }
-func (*Vfo) ProtoMessage()               {}
-func (*Vfo) Descriptor() ([]byte, []int) { return fileDescriptorRadio, []int{5} }
+func (m *MorseProgress) Reset()                    { *m = MorseProgress{} }
+func (m *MorseProgress) String() string            { return proto.CompactTextString(m) }
+func (*MorseProgress) ProtoMessage()               {}
+func (*MorseProgress) Descriptor() ([]byte, []int) { return fileDescriptorRadio, []int{7} }
 
-func (m *Vfo) GetFrequency() float64 {
+func (m *MorseProgress) GetRequestId() string {
 	if m != nil {
-		return m.Frequency
+		return m.RequestId
 	}
-	return 0
+	return ""
 }
 
-func (m *Vfo) GetMode() string {
+func (m *MorseProgress) GetUserId() string {
 	if m != nil {
-		return m.Mode
+		return m.UserId
 	}
 	return ""
 }
 
-func (m *Vfo) GetPbWidth() int32 {
+func (m *MorseProgress) GetSent() int32 {
 	if m != nil {
-		return m.PbWidth
+		return m.Sent
 	}
 	return 0
 }
 
-func (m *Vfo) GetAnt() int32 {
+func (m *MorseProgress) GetTotal() int32 {
 	if m != nil {
-		return m.Ant
+		return m.Total
 	}
 	return 0
 }
 
-func (m *Vfo) GetRit() int32 {
+func (m *MorseProgress) GetDone() bool {
 	if m != nil {
-		return m.Rit
+		return m.Done
 	}
-	return 0
+	return false
 }
 
-func (m *Vfo) GetXit() int32 {
+func (m *MorseProgress) GetAborted() bool {
 	if m != nil {
-		return m.Xit
+		return m.Aborted
 	}
-	return 0
+	return false
 }
 
-func (m *Vfo) GetSplit() *Split {
+func (m *MorseProgress) GetError() string {
 	if m != nil {
-		return m.Split
+		return m.Error
 	}
-	return nil
+	return ""
 }
 
-func (m *Vfo) GetTuningStep() int32 {
+type SetState struct {
+	CurrentVfo      string    `protobuf:"bytes,1,opt,name=current_vfo,json=currentVfo,proto3" json:"current_vfo,omitempty"`
+	Vfo             *Vfo      `protobuf:"bytes,2,opt,name=vfo" json:"vfo,omitempty"`
+	Channel         *Channel  `protobuf:"bytes,3,opt,name=channel" json:"channel,omitempty"`
+	VfoOperations   []string  `protobuf:"bytes,4,rep,name=vfo_operations,json=vfoOperations" json:"vfo_operations,omitempty"`
+	RadioOn         bool      `protobuf:"varint,5,opt,name=radio_on,json=radioOn,proto3" json:"radio_on,omitempty"`
+	Ptt             bool      `protobuf:"varint,6,opt,name=ptt,proto3" json:"ptt,omitempty"`
+	Md              *MetaData `protobuf:"bytes,7,opt,name=md" json:"md,omitempty"`
+	PollingInterval int32     `protobuf:"varint,8,opt,name=polling_interval,json=pollingInterval,proto3" json:"polling_interval,omitempty"`
+	UserId          string    `protobuf:"bytes,9,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	RequestId       string    `protobuf:"bytes,10,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	Atomic          bool      `protobuf:"varint,11,opt,name=atomic,proto3" json:"atomic,omitempty"`
+}
+
+func (m *SetState) Reset()                    { *m = SetState{} }
+func (m *SetState) String() string            { return proto.CompactTextString(m) }
+func (*SetState) ProtoMessage()               {}
+func (*SetState) Descriptor() ([]byte, []int) { return fileDescriptorRadio, []int{8} }
+
+func (m *SetState) GetCurrentVfo() string {
 	if m != nil {
-		return m.TuningStep
+		return m.CurrentVfo
 	}
-	return 0
+	return ""
 }
 
-func (m *Vfo) GetFunctions() []string {
+func (m *SetState) GetVfo() *Vfo {
 	if m != nil {
-		return m.Functions
+		return m.Vfo
 	}
 	return nil
 }
 
-func (m *Vfo) GetLevels() map[string]float32 {
+func (m *SetState) GetChannel() *Channel {
 	if m != nil {
-		return m.Levels
+		return m.Channel
 	}
 	return nil
 }
 
-func (m *Vfo) GetParameters() map[string]float32 {
+func (m *SetState) GetVfoOperations() []string {
 	if m != nil {
-		return m.Parameters
+		return m.VfoOperations
 	}
 	return nil
 }
 
-type MetaData struct {
-	HasFrequency       bool `protobuf:"varint,1,opt,name=has_frequency,json=hasFrequency,proto3" json:"has_frequency,omitempty"`
-	HasMode            bool `protobuf:"varint,2,opt,name=has_mode,json=hasMode,proto3" json:"has_mode,omitempty"`
-	HasPbWidth         bool `protobuf:"varint,3,opt,name=has_pb_width,json=hasPbWidth,proto3" json:"has_pb_width,omitempty"`
-	HasAnt             bool `protobuf:"varint,4,opt,name=has_ant,json=hasAnt,proto3" json:"has_ant,omitempty"`
-	HasRit             bool `protobuf:"varint,5,opt,name=has_rit,json=hasRit,proto3" json:"has_rit,omitempty"`
-	HasXit             bool `protobuf:"varint,6,opt,name=has_xit,json=hasXit,proto3" json:"has_xit,omitempty"`
-	HasSplit           bool `protobuf:"varint,7,opt,name=has_split,json=hasSplit,proto3" json:"has_split,omitempty"`
-	HasTuningStep      bool `protobuf:"varint,8,opt,name=has_tuning_step,json=hasTuningStep,proto3" json:"has_tuning_step,omitempty"`
-	HasFunctions       bool `protobuf:"varint,9,opt,name=has_functions,json=hasFunctions,proto3" json:"has_functions,omitempty"`
-	HasLevels          bool `protobuf:"varint,10,opt,name=has_levels,json=hasLevels,proto3" json:"has_levels,omitempty"`
-	HasParameters      bool `protobuf:"varint,11,opt,name=has_parameters,json=hasParameters,proto3" json:"has_parameters,omitempty"`
-	HasPtt             bool `protobuf:"varint,12,opt,name=has_ptt,json=hasPtt,proto3" json:"has_ptt,omitempty"`
-	HasRadioOn         bool `protobuf:"varint,13,opt,name=has_radio_on,json=hasRadioOn,proto3" json:"has_radio_on,omitempty"`
-	HasPollingInterval bool `protobuf:"varint,14,opt,name=has_polling_interval,json=hasPollingInterval,proto3" json:"has_polling_interval,omitempty"`
-}
-
-func (m *MetaData) Reset()                    { *m = MetaData{} }
-func (m *MetaData) String() string            { return proto.CompactTextString(m) }
-func (*MetaData) ProtoMessage()               {}
-func (*MetaData) Descriptor() ([]byte, []int) { return fileDescriptorRadio, []int{6} }
-
-func (m *MetaData) GetHasFrequency() bool {
+func (m *SetState) GetRadioOn() bool {
 	if m != nil {
-		return m.HasFrequency
+		return m.RadioOn
 	}
 	return false
 }
 
-func (m *MetaData) GetHasMode() bool {
+func (m *SetState) GetPtt() bool {
 	if m != nil {
-		return m.HasMode
+		return m.Ptt
 	}
 	return false
 }
 
-func (m *MetaData) GetHasPbWidth() bool {
+func (m *SetState) GetMd() *MetaData {
 	if m != nil {
-		return m.HasPbWidth
+		return m.Md
 	}
-	return false
+	return nil
 }
 
-func (m *MetaData) GetHasAnt() bool {
+func (m *SetState) GetPollingInterval() int32 {
 	if m != nil {
-		return m.HasAnt
+		return m.PollingInterval
 	}
-	return false
+	return 0
 }
 
-func (m *MetaData) GetHasRit() bool {
+func (m *SetState) GetUserId() string {
 	if m != nil {
-		return m.HasRit
+		return m.UserId
 	}
-	return false
+	return ""
 }
 
-func (m *MetaData) GetHasXit() bool {
+func (m *SetState) GetRequestId() string {
 	if m != nil {
-		return m.HasXit
+		return m.RequestId
 	}
-	return false
+	return ""
 }
 
-func (m *MetaData) GetHasSplit() bool {
+func (m *SetState) GetAtomic() bool {
 	if m != nil {
-		return m.HasSplit
+		return m.Atomic
 	}
 	return false
 }
 
-func (m *MetaData) GetHasTuningStep() bool {
+type Error struct {
+	Field     string `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"`
+	Error     string `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	UserId    string `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Timestamp int64  `protobuf:"varint,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	RequestId string `protobuf:"bytes,5,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+}
+
+func (m *Error) Reset()                    { *m = Error{} }
+func (m *Error) String() string            { return proto.CompactTextString(m) }
+func (*Error) ProtoMessage()               {}
+func (*Error) Descriptor() ([]byte, []int) { return fileDescriptorRadio, []int{9} }
+
+func (m *Error) GetField() string {
 	if m != nil {
-		return m.HasTuningStep
+		return m.Field
 	}
-	return false
+	return ""
 }
 
-func (m *MetaData) GetHasFunctions() bool {
+func (m *Error) GetError() string {
 	if m != nil {
-		return m.HasFunctions
+		return m.Error
 	}
-	return false
+	return ""
 }
 
-func (m *MetaData) GetHasLevels() bool {
+func (m *Error) GetUserId() string {
 	if m != nil {
-		return m.HasLevels
+		return m.UserId
 	}
-	return false
+	return ""
 }
 
-func (m *MetaData) GetHasParameters() bool {
+func (m *Error) GetTimestamp() int64 {
 	if m != nil {
-		return m.HasParameters
+		return m.Timestamp
 	}
-	return false
+	return 0
 }
 
-func (m *MetaData) GetHasPtt() bool {
+func (m *Error) GetRequestId() string {
 	if m != nil {
-		return m.HasPtt
+		return m.RequestId
 	}
-	return false
+	return ""
 }
 
-func (m *MetaData) GetHasRadioOn() bool {
+type Alarm struct {
+	Meter     string             `protobuf:"bytes,1,opt,name=meter,proto3" json:"meter,omitempty"`
+	Value     float32            `protobuf:"fixed32,2,opt,name=value,proto3" json:"value,omitempty"`
+	Limit     float32            `protobuf:"fixed32,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	Duration  int64              `protobuf:"varint,4,opt,name=duration,proto3" json:"duration,omitempty"`
+	Frequency float64            `protobuf:"fixed64,5,opt,name=frequency,proto3" json:"frequency,omitempty"`
+	Band      string             `protobuf:"bytes,6,opt,name=band,proto3" json:"band,omitempty"`
+	UserId    string             `protobuf:"bytes,7,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Meters    map[string]float32 `protobuf:"bytes,8,rep,name=meters" json:"meters,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"fixed32,2,opt,name=value,proto3"`
+	Rfpower   float32            `protobuf:"fixed32,9,opt,name=rfpower,proto3" json:"rfpower,omitempty"`
+	Timestamp int64              `protobuf:"varint,10,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+}
+
+func (m *Alarm) Reset()                    { *m = Alarm{} }
+func (m *Alarm) String() string            { return proto.CompactTextString(m) }
+func (*Alarm) ProtoMessage()               {}
+func (*Alarm) Descriptor() ([]byte, []int) { return fileDescriptorRadio, []int{10} }
+
+func (m *Alarm) GetMeter() string {
 	if m != nil {
-		return m.HasRadioOn
+		return m.Meter
 	}
-	return false
+	return ""
 }
 
-func (m *MetaData) GetHasPollingInterval() bool {
+func (m *Alarm) GetValue() float32 {
 	if m != nil {
-		return m.HasPollingInterval
+		return m.Value
 	}
-	return false
+	return 0
 }
 
-type Channel struct {
-	Channel int32 `protobuf:"varint,1,opt,name=channel,proto3" json:"channel,omitempty"`
+func (m *Alarm) GetLimit() float32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
 }
 
-func (m *Channel) Reset()                    { *m = Channel{} }
-func (m *Channel) String() string            { return proto.CompactTextString(m) }
-func (*Channel) ProtoMessage()               {}
-func (*Channel) Descriptor() ([]byte, []int) { return fileDescriptorRadio, []int{7} }
-
-func (m *Channel) GetChannel() int32 {
+func (m *Alarm) GetDuration() int64 {
 	if m != nil {
-		return m.Channel
+		return m.Duration
 	}
 	return 0
 }
 
-type Value struct {
-	Name string  `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Step float32 `protobuf:"fixed32,2,opt,name=step,proto3" json:"step,omitempty"`
-	Min  float32 `protobuf:"fixed32,3,opt,name=min,proto3" json:"min,omitempty"`
-	Max  float32 `protobuf:"fixed32,4,opt,name=max,proto3" json:"max,omitempty"`
+func (m *Alarm) GetFrequency() float64 {
+	if m != nil {
+		return m.Frequency
+	}
+	return 0
 }
 
-func (m *Value) Reset()                    { *m = Value{} }
-func (m *Value) String() string            { return proto.CompactTextString(m) }
-func (*Value) ProtoMessage()               {}
-func (*Value) Descriptor() ([]byte, []int) { return fileDescriptorRadio, []int{8} }
+func (m *Alarm) GetBand() string {
+	if m != nil {
+		return m.Band
+	}
+	return ""
+}
 
-func (m *Value) GetName() string {
+func (m *Alarm) GetUserId() string {
 	if m != nil {
-		return m.Name
+		return m.UserId
 	}
 	return ""
 }
 
-func (m *Value) GetStep() float32 {
+func (m *Alarm) GetMeters() map[string]float32 {
 	if m != nil {
-		return m.Step
+		return m.Meters
 	}
-	return 0
+	return nil
 }
 
-func (m *Value) GetMin() float32 {
+func (m *Alarm) GetRfpower() float32 {
 	if m != nil {
-		return m.Min
+		return m.Rfpower
 	}
 	return 0
 }
 
-func (m *Value) GetMax() float32 {
+func (m *Alarm) GetTimestamp() int64 {
 	if m != nil {
-		return m.Max
+		return m.Timestamp
 	}
 	return 0
 }
 
-type Function struct {
-	Func  string `protobuf:"bytes,1,opt,name=func,proto3" json:"func,omitempty"`
-	Value bool   `protobuf:"varint,2,opt,name=value,proto3" json:"value,omitempty"`
+type Ack struct {
+	RequestId     string   `protobuf:"bytes,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	UserId        string   `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Applied       []string `protobuf:"bytes,3,rep,name=applied" json:"applied,omitempty"`
+	Rejected      []*Error `protobuf:"bytes,4,rep,name=rejected" json:"rejected,omitempty"`
+	StateVersion  uint64   `protobuf:"varint,5,opt,name=state_version,json=stateVersion,proto3" json:"state_version,omitempty"`
+	RolledBack    bool     `protobuf:"varint,6,opt,name=rolled_back,json=rolledBack,proto3" json:"rolled_back,omitempty"`
+	RollbackError string   `protobuf:"bytes,7,opt,name=rollback_error,json=rollbackError,proto3" json:"rollback_error,omitempty"`
 }
 
-func (m *Function) Reset()                    { *m = Function{} }
-func (m *Function) String() string            { return proto.CompactTextString(m) }
-func (*Function) ProtoMessage()               {}
-func (*Function) Descriptor() ([]byte, []int) { return fileDescriptorRadio, []int{9} }
+func (m *Ack) Reset()                    { *m = Ack{} }
+func (m *Ack) String() string            { return proto.CompactTextString(m) }
+func (*Ack) ProtoMessage()               {}
+func (*Ack) Descriptor() ([]byte, []int) { return fileDescriptorRadio, []int{11} }
 
-func (m *Function) GetFunc() string {
+func (m *Ack) GetRequestId() string {
 	if m != nil {
-		return m.Func
+		return m.RequestId
 	}
 	return ""
 }
 
-func (m *Function) GetValue() bool {
+func (m *Ack) GetUserId() string {
 	if m != nil {
-		return m.Value
+		return m.UserId
 	}
-	return false
+	return ""
 }
 
-type Level struct {
-	Func  string `protobuf:"bytes,1,opt,name=func,proto3" json:"func,omitempty"`
-	Value bool   `protobuf:"varint,2,opt,name=value,proto3" json:"value,omitempty"`
+func (m *Ack) GetApplied() []string {
+	if m != nil {
+		return m.Applied
+	}
+	return nil
 }
 
-func (m *Level) Reset()                    { *m = Level{} }
-func (m *Level) String() string            { return proto.CompactTextString(m) }
-func (*Level) ProtoMessage()               {}
-func (*Level) Descriptor() ([]byte, []int) { return fileDescriptorRadio, []int{10} }
+func (m *Ack) GetRejected() []*Error {
+	if m != nil {
+		return m.Rejected
+	}
+	return nil
+}
 
-func (m *Level) GetFunc() string {
+func (m *Ack) GetStateVersion() uint64 {
 	if m != nil {
-		return m.Func
+		return m.StateVersion
 	}
-	return ""
+	return 0
 }
 
-func (m *Level) GetValue() bool {
+func (m *Ack) GetRolledBack() bool {
 	if m != nil {
-		return m.Value
+		return m.RolledBack
 	}
 	return false
 }
 
-type Parameter struct {
-	Param string `protobuf:"bytes,1,opt,name=param,proto3" json:"param,omitempty"`
-	Value bool   `protobuf:"varint,2,opt,name=value,proto3" json:"value,omitempty"`
+func (m *Ack) GetRollbackError() string {
+	if m != nil {
+		return m.RollbackError
+	}
+	return ""
 }
 
-func (m *Parameter) Reset()                    { *m = Parameter{} }
-func (m *Parameter) String() string            { return proto.CompactTextString(m) }
-func (*Parameter) ProtoMessage()               {}
-func (*Parameter) Descriptor() ([]byte, []int) { return fileDescriptorRadio, []int{11} }
+type Lock struct {
+	UserId    string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Acquire   bool   `protobuf:"varint,2,opt,name=acquire,proto3" json:"acquire,omitempty"`
+	Takeover  bool   `protobuf:"varint,3,opt,name=takeover,proto3" json:"takeover,omitempty"`
+	RequestId string `protobuf:"bytes,4,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+}
 
-func (m *Parameter) GetParam() string {
+func (m *Lock) Reset()                    { *m = Lock{} }
+func (m *Lock) String() string            { return proto.CompactTextString(m) }
+func (*Lock) ProtoMessage()               {}
+func (*Lock) Descriptor() ([]byte, []int) { return fileDescriptorRadio, []int{12} }
+
+func (m *Lock) GetUserId() string {
 	if m != nil {
-		return m.Param
+		return m.UserId
 	}
 	return ""
 }
 
-func (m *Parameter) GetValue() bool {
+func (m *Lock) GetAcquire() bool {
 	if m != nil {
-		return m.Value
+		return m.Acquire
 	}
 	return false
 }
 
-type Split struct {
-	Enabled   bool    `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
-	Vfo       string  `protobuf:"bytes,2,opt,name=vfo,proto3" json:"vfo,omitempty"`
-	Frequency float64 `protobuf:"fixed64,3,opt,name=frequency,proto3" json:"frequency,omitempty"`
-	Mode      string  `protobuf:"bytes,4,opt,name=mode,proto3" json:"mode,omitempty"`
-	PbWidth   int32   `protobuf:"varint,5,opt,name=pbWidth,proto3" json:"pbWidth,omitempty"`
-}
-
-func (m *Split) Reset()                    { *m = Split{} }
-func (m *Split) String() string            { return proto.CompactTextString(m) }
-func (*Split) ProtoMessage()               {}
-func (*Split) Descriptor() ([]byte, []int) { return fileDescriptorRadio, []int{12} }
-
-func (m *Split) GetEnabled() bool {
+func (m *Lock) GetTakeover() bool {
 	if m != nil {
-		return m.Enabled
+		return m.Takeover
 	}
 	return false
 }
 
-func (m *Split) GetVfo() string {
+func (m *Lock) GetRequestId() string {
 	if m != nil {
-		return m.Vfo
+		return m.RequestId
 	}
 	return ""
 }
 
-func (m *Split) GetFrequency() float64 {
+type Capabilities struct {
+	Vfos          []string              `protobuf:"bytes,1,rep,name=vfos" json:"vfos,omitempty"`
+	Modes         []string              `protobuf:"bytes,2,rep,name=modes" json:"modes,omitempty"`
+	VfoOps        []string              `protobuf:"bytes,3,rep,name=vfo_ops,json=vfoOps" json:"vfo_ops,omitempty"`
+	GetFunctions  []string              `protobuf:"bytes,4,rep,name=get_functions,json=getFunctions" json:"get_functions,omitempty"`
+	SetFunctions  []string              `protobuf:"bytes,5,rep,name=set_functions,json=setFunctions" json:"set_functions,omitempty"`
+	GetLevels     []*Value              `protobuf:"bytes,6,rep,name=get_levels,json=getLevels" json:"get_levels,omitempty"`
+	SetLevels     []*Value              `protobuf:"bytes,7,rep,name=set_levels,json=setLevels" json:"set_levels,omitempty"`
+	GetParameters []*Value              `protobuf:"bytes,8,rep,name=get_parameters,json=getParameters" json:"get_parameters,omitempty"`
+	SetParameters []*Value              `protobuf:"bytes,9,rep,name=set_parameters,json=setParameters" json:"set_parameters,omitempty"`
+	MaxRit        int32                 `protobuf:"varint,10,opt,name=max_rit,json=maxRit,proto3" json:"max_rit,omitempty"`
+	MaxXit        int32                 `protobuf:"varint,11,opt,name=max_xit,json=maxXit,proto3" json:"max_xit,omitempty"`
+	MaxIfShift    int32                 `protobuf:"varint,12,opt,name=max_if_shift,json=maxIfShift,proto3" json:"max_if_shift,omitempty"`
+	Filters       map[string]*Int32List `protobuf:"bytes,13,rep,name=filters" json:"filters,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value"`
+	TuningSteps   map[string]*Int32List `protobuf:"bytes,14,rep,name=tuning_steps,json=tuningSteps" json:"tuning_steps,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value"`
+	Preamps       []int32               `protobuf:"varint,15,rep,packed,name=preamps" json:"preamps,omitempty"`
+	Attenuators   []int32               `protobuf:"varint,16,rep,packed,name=attenuators" json:"attenuators,omitempty"`
+	RigModel      int32                 `protobuf:"varint,17,opt,name=rig_model,json=rigModel,proto3" json:"rig_model,omitempty"`
+	ModelName     string                `protobuf:"bytes,18,opt,name=model_name,json=modelName,proto3" json:"model_name,omitempty"`
+	Version       string                `protobuf:"bytes,19,opt,name=version,proto3" json:"version,omitempty"`
+	MfgName       string                `protobuf:"bytes,20,opt,name=mfg_name,json=mfgName,proto3" json:"mfg_name,omitempty"`
+	Status        string                `protobuf:"bytes,21,opt,name=status,proto3" json:"status,omitempty"`
+	MemFirst      int32                 `protobuf:"varint,22,opt,name=mem_first,json=memFirst,proto3" json:"mem_first,omitempty"`
+	MemLast       int32                 `protobuf:"varint,23,opt,name=mem_last,json=memLast,proto3" json:"mem_last,omitempty"`
+	CtcssList     []uint32              `protobuf:"varint,24,rep,packed,name=ctcss_list,json=ctcssList" json:"ctcss_list,omitempty"`
+	DcsList       []uint32              `protobuf:"varint,25,rep,packed,name=dcs_list,json=dcsList" json:"dcs_list,omitempty"`
+}
+
+func (m *Capabilities) Reset()                    { *m = Capabilities{} }
+func (m *Capabilities) String() string            { return proto.CompactTextString(m) }
+func (*Capabilities) ProtoMessage()               {}
+func (*Capabilities) Descriptor() ([]byte, []int) { return fileDescriptorRadio, []int{13} }
+
+func (m *Capabilities) GetVfos() []string {
 	if m != nil {
-		return m.Frequency
+		return m.Vfos
 	}
-	return 0
+	return nil
 }
 
-func (m *Split) GetMode() string {
+func (m *Capabilities) GetModes() []string {
 	if m != nil {
-		return m.Mode
+		return m.Modes
 	}
-	return ""
+	return nil
 }
 
-func (m *Split) GetPbWidth() int32 {
+func (m *Capabilities) GetVfoOps() []string {
 	if m != nil {
-		return m.PbWidth
+		return m.VfoOps
 	}
-	return 0
+	return nil
 }
 
-func init() {
-	proto.RegisterType((*State)(nil), "shackbus.radio.State")
-	proto.RegisterType((*SetState)(nil), "shackbus.radio.SetState")
-	proto.RegisterType((*Capabilities)(nil), "shackbus.radio.Capabilities")
-	proto.RegisterType((*Int32List)(nil), "shackbus.radio.Int32List")
-	proto.RegisterType((*Status)(nil), "shackbus.radio.Status")
-	proto.RegisterType((*Vfo)(nil), "shackbus.radio.Vfo")
-	proto.RegisterType((*MetaData)(nil), "shackbus.radio.MetaData")
-	proto.RegisterType((*Channel)(nil), "shackbus.radio.Channel")
-	proto.RegisterType((*Value)(nil), "shackbus.radio.Value")
-	proto.RegisterType((*Function)(nil), "shackbus.radio.Function")
-	proto.RegisterType((*Level)(nil), "shackbus.radio.Level")
-	proto.RegisterType((*Parameter)(nil), "shackbus.radio.Parameter")
-	proto.RegisterType((*Split)(nil), "shackbus.radio.Split")
-}
-func (m *State) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (m *Capabilities) GetGetFunctions() []string {
+	if m != nil {
+		return m.GetFunctions
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *State) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.CurrentVfo) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintRadio(dAtA, i, uint64(len(m.CurrentVfo)))
-		i += copy(dAtA[i:], m.CurrentVfo)
+func (m *Capabilities) GetSetFunctions() []string {
+	if m != nil {
+		return m.SetFunctions
 	}
-	if m.Vfo != nil {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintRadio(dAtA, i, uint64(m.Vfo.Size()))
-		n1, err := m.Vfo.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n1
+	return nil
+}
+
+func (m *Capabilities) GetGetLevels() []*Value {
+	if m != nil {
+		return m.GetLevels
 	}
-	if m.Channel != nil {
-		dAtA[i] = 0x1a
-		i++
-		i = encodeVarintRadio(dAtA, i, uint64(m.Channel.Size()))
-		n2, err := m.Channel.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n2
+	return nil
+}
+
+func (m *Capabilities) GetSetLevels() []*Value {
+	if m != nil {
+		return m.SetLevels
 	}
-	if m.RadioOn {
-		dAtA[i] = 0x20
-		i++
-		if m.RadioOn {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i++
+	return nil
+}
+
+func (m *Capabilities) GetGetParameters() []*Value {
+	if m != nil {
+		return m.GetParameters
 	}
-	if m.Ptt {
-		dAtA[i] = 0x28
-		i++
-		if m.Ptt {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i++
+	return nil
+}
+
+func (m *Capabilities) GetSetParameters() []*Value {
+	if m != nil {
+		return m.SetParameters
 	}
-	if m.PollingInterval != 0 {
-		dAtA[i] = 0x30
-		i++
-		i = encodeVarintRadio(dAtA, i, uint64(m.PollingInterval))
+	return nil
+}
+
+func (m *Capabilities) GetMaxRit() int32 {
+	if m != nil {
+		return m.MaxRit
 	}
-	return i, nil
+	return 0
 }
 
-func (m *SetState) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (m *Capabilities) GetMaxXit() int32 {
+	if m != nil {
+		return m.MaxXit
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *SetState) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.CurrentVfo) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintRadio(dAtA, i, uint64(len(m.CurrentVfo)))
-		i += copy(dAtA[i:], m.CurrentVfo)
+func (m *Capabilities) GetMaxIfShift() int32 {
+	if m != nil {
+		return m.MaxIfShift
 	}
-	if m.Vfo != nil {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintRadio(dAtA, i, uint64(m.Vfo.Size()))
-		n3, err := m.Vfo.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n3
+	return 0
+}
+
+func (m *Capabilities) GetFilters() map[string]*Int32List {
+	if m != nil {
+		return m.Filters
 	}
-	if m.Channel != nil {
-		dAtA[i] = 0x1a
-		i++
-		i = encodeVarintRadio(dAtA, i, uint64(m.Channel.Size()))
-		n4, err := m.Channel.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n4
+	return nil
+}
+
+func (m *Capabilities) GetTuningSteps() map[string]*Int32List {
+	if m != nil {
+		return m.TuningSteps
 	}
-	if len(m.VfoOperations) > 0 {
-		for _, s := range m.VfoOperations {
-			dAtA[i] = 0x22
-			i++
-			l = len(s)
-			for l >= 1<<7 {
-				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
-				l >>= 7
-				i++
-			}
-			dAtA[i] = uint8(l)
-			i++
-			i += copy(dAtA[i:], s)
-		}
+	return nil
+}
+
+func (m *Capabilities) GetPreamps() []int32 {
+	if m != nil {
+		return m.Preamps
 	}
-	if m.RadioOn {
-		dAtA[i] = 0x28
-		i++
-		if m.RadioOn {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i++
+	return nil
+}
+
+func (m *Capabilities) GetAttenuators() []int32 {
+	if m != nil {
+		return m.Attenuators
 	}
-	if m.Ptt {
-		dAtA[i] = 0x30
-		i++
-		if m.Ptt {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i++
+	return nil
+}
+
+func (m *Capabilities) GetRigModel() int32 {
+	if m != nil {
+		return m.RigModel
 	}
-	if m.Md != nil {
-		dAtA[i] = 0x3a
-		i++
-		i = encodeVarintRadio(dAtA, i, uint64(m.Md.Size()))
-		n5, err := m.Md.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n5
+	return 0
+}
+
+func (m *Capabilities) GetModelName() string {
+	if m != nil {
+		return m.ModelName
 	}
-	if m.PollingInterval != 0 {
-		dAtA[i] = 0x40
-		i++
-		i = encodeVarintRadio(dAtA, i, uint64(m.PollingInterval))
+	return ""
+}
+
+func (m *Capabilities) GetVersion() string {
+	if m != nil {
+		return m.Version
 	}
-	if len(m.UserId) > 0 {
-		dAtA[i] = 0x4a
-		i++
-		i = encodeVarintRadio(dAtA, i, uint64(len(m.UserId)))
-		i += copy(dAtA[i:], m.UserId)
+	return ""
+}
+
+func (m *Capabilities) GetMfgName() string {
+	if m != nil {
+		return m.MfgName
 	}
-	return i, nil
+	return ""
 }
 
-func (m *Capabilities) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (m *Capabilities) GetStatus() string {
+	if m != nil {
+		return m.Status
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *Capabilities) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Vfos) > 0 {
-		for _, s := range m.Vfos {
-			dAtA[i] = 0xa
-			i++
-			l = len(s)
-			for l >= 1<<7 {
-				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
-				l >>= 7
-				i++
-			}
-			dAtA[i] = uint8(l)
-			i++
-			i += copy(dAtA[i:], s)
-		}
+func (m *Capabilities) GetMemFirst() int32 {
+	if m != nil {
+		return m.MemFirst
 	}
-	if len(m.Modes) > 0 {
-		for _, s := range m.Modes {
-			dAtA[i] = 0x12
-			i++
-			l = len(s)
-			for l >= 1<<7 {
-				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
-				l >>= 7
-				i++
-			}
-			dAtA[i] = uint8(l)
-			i++
-			i += copy(dAtA[i:], s)
-		}
-	}
-	if len(m.VfoOps) > 0 {
-		for _, s := range m.VfoOps {
-			dAtA[i] = 0x1a
-			i++
-			l = len(s)
-			for l >= 1<<7 {
-				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
-				l >>= 7
-				i++
-			}
-			dAtA[i] = uint8(l)
-			i++
-			i += copy(dAtA[i:], s)
-		}
+	return 0
+}
+
+func (m *Capabilities) GetMemLast() int32 {
+	if m != nil {
+		return m.MemLast
 	}
-	if len(m.GetFunctions) > 0 {
-		for _, s := range m.GetFunctions {
-			dAtA[i] = 0x22
-			i++
-			l = len(s)
-			for l >= 1<<7 {
-				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
-				l >>= 7
-				i++
-			}
-			dAtA[i] = uint8(l)
-			i++
-			i += copy(dAtA[i:], s)
-		}
+	return 0
+}
+
+func (m *Capabilities) GetCtcssList() []uint32 {
+	if m != nil {
+		return m.CtcssList
 	}
-	if len(m.SetFunctions) > 0 {
-		for _, s := range m.SetFunctions {
-			dAtA[i] = 0x2a
-			i++
-			l = len(s)
-			for l >= 1<<7 {
-				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
-				l >>= 7
-				i++
-			}
-			dAtA[i] = uint8(l)
-			i++
-			i += copy(dAtA[i:], s)
-		}
+	return nil
+}
+
+func (m *Capabilities) GetDcsList() []uint32 {
+	if m != nil {
+		return m.DcsList
 	}
-	if len(m.GetLevels) > 0 {
-		for _, msg := range m.GetLevels {
-			dAtA[i] = 0x32
-			i++
-			i = encodeVarintRadio(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
-		}
+	return nil
+}
+
+type Int32List struct {
+	Value []int32 `protobuf:"varint,14,rep,packed,name=value" json:"value,omitempty"`
+}
+
+func (m *Int32List) Reset()                    { *m = Int32List{} }
+func (m *Int32List) String() string            { return proto.CompactTextString(m) }
+func (*Int32List) ProtoMessage()               {}
+func (*Int32List) Descriptor() ([]byte, []int) { return fileDescriptorRadio, []int{14} }
+
+func (m *Int32List) GetValue() []int32 {
+	if m != nil {
+		return m.Value
 	}
-	if len(m.SetLevels) > 0 {
-		for _, msg := range m.SetLevels {
-			dAtA[i] = 0x3a
-			i++
-			i = encodeVarintRadio(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
-		}
+	return nil
+}
+
+type Vfo struct {
+	Frequency  float64            `protobuf:"fixed64,2,opt,name=frequency,proto3" json:"frequency,omitempty"`
+	Mode       string             `protobuf:"bytes,3,opt,name=mode,proto3" json:"mode,omitempty"`
+	PbWidth    int32              `protobuf:"varint,4,opt,name=pb_width,json=pbWidth,proto3" json:"pb_width,omitempty"`
+	Ant        int32              `protobuf:"varint,5,opt,name=ant,proto3" json:"ant,omitempty"`
+	Rit        int32              `protobuf:"varint,9,opt,name=rit,proto3" json:"rit,omitempty"`
+	Xit        int32              `protobuf:"varint,10,opt,name=xit,proto3" json:"xit,omitempty"`
+	Split      *Split             `protobuf:"bytes,11,opt,name=split" json:"split,omitempty"`
+	TuningStep int32              `protobuf:"varint,12,opt,name=tuning_step,json=tuningStep,proto3" json:"tuning_step,omitempty"`
+	Functions  []string           `protobuf:"bytes,13,rep,name=functions" json:"functions,omitempty"`
+	Levels     map[string]float32 `protobuf:"bytes,14,rep,name=levels" json:"levels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"fixed32,2,opt,name=value,proto3"`
+	Parameters map[string]float32 `protobuf:"bytes,15,rep,name=parameters" json:"parameters,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"fixed32,2,opt,name=value,proto3"`
+	CtcssTone  uint32             `protobuf:"varint,16,opt,name=ctcss_tone,json=ctcssTone,proto3" json:"ctcss_tone,omitempty"`
+	CtcssSql   uint32             `protobuf:"varint,17,opt,name=ctcss_sql,json=ctcssSql,proto3" json:"ctcss_sql,omitempty"`
+	DcsCode    uint32             `protobuf:"varint,18,opt,name=dcs_code,json=dcsCode,proto3" json:"dcs_code,omitempty"`
+	DcsSql     uint32             `protobuf:"varint,19,opt,name=dcs_sql,json=dcsSql,proto3" json:"dcs_sql,omitempty"`
+	RptrShift  string             `protobuf:"bytes,20,opt,name=rptr_shift,json=rptrShift,proto3" json:"rptr_shift,omitempty"`
+	RptrOffset int32              `protobuf:"varint,21,opt,name=rptr_offset,json=rptrOffset,proto3" json:"rptr_offset,omitempty"`
+}
+
+func (m *Vfo) Reset()                    { *m = Vfo{} }
+func (m *Vfo) String() string            { return proto.CompactTextString(m) }
+func (*Vfo) ProtoMessage()               {}
+func (*Vfo) Descriptor() ([]byte, []int) { return fileDescriptorRadio, []int{15} }
+
+func (m *Vfo) GetFrequency() float64 {
+	if m != nil {
+		return m.Frequency
 	}
-	if len(m.GetParameters) > 0 {
-		for _, msg := range m.GetParameters {
-			dAtA[i] = 0x42
-			i++
-			i = encodeVarintRadio(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
-		}
+	return 0
+}
+
+func (m *Vfo) GetMode() string {
+	if m != nil {
+		return m.Mode
 	}
-	if len(m.SetParameters) > 0 {
-		for _, msg := range m.SetParameters {
-			dAtA[i] = 0x4a
-			i++
-			i = encodeVarintRadio(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
-		}
+	return ""
+}
+
+func (m *Vfo) GetPbWidth() int32 {
+	if m != nil {
+		return m.PbWidth
 	}
-	if m.MaxRit != 0 {
-		dAtA[i] = 0x50
-		i++
-		i = encodeVarintRadio(dAtA, i, uint64(m.MaxRit))
+	return 0
+}
+
+func (m *Vfo) GetAnt() int32 {
+	if m != nil {
+		return m.Ant
 	}
-	if m.MaxXit != 0 {
-		dAtA[i] = 0x58
-		i++
-		i = encodeVarintRadio(dAtA, i, uint64(m.MaxXit))
+	return 0
+}
+
+func (m *Vfo) GetRit() int32 {
+	if m != nil {
+		return m.Rit
 	}
-	if m.MaxIfShift != 0 {
-		dAtA[i] = 0x60
-		i++
-		i = encodeVarintRadio(dAtA, i, uint64(m.MaxIfShift))
+	return 0
+}
+
+func (m *Vfo) GetXit() int32 {
+	if m != nil {
+		return m.Xit
 	}
-	if len(m.Filters) > 0 {
-		for k, _ := range m.Filters {
-			dAtA[i] = 0x6a
-			i++
-			v := m.Filters[k]
-			msgSize := 0
-			if v != nil {
-				msgSize = v.Size()
-				msgSize += 1 + sovRadio(uint64(msgSize))
-			}
-			mapSize := 1 + len(k) + sovRadio(uint64(len(k))) + msgSize
-			i = encodeVarintRadio(dAtA, i, uint64(mapSize))
-			dAtA[i] = 0xa
-			i++
-			i = encodeVarintRadio(dAtA, i, uint64(len(k)))
-			i += copy(dAtA[i:], k)
-			if v != nil {
-				dAtA[i] = 0x12
-				i++
-				i = encodeVarintRadio(dAtA, i, uint64(v.Size()))
-				n6, err := v.MarshalTo(dAtA[i:])
-				if err != nil {
-					return 0, err
-				}
-				i += n6
-			}
-		}
+	return 0
+}
+
+func (m *Vfo) GetSplit() *Split {
+	if m != nil {
+		return m.Split
 	}
-	if len(m.TuningSteps) > 0 {
-		for k, _ := range m.TuningSteps {
-			dAtA[i] = 0x72
-			i++
-			v := m.TuningSteps[k]
-			msgSize := 0
-			if v != nil {
-				msgSize = v.Size()
-				msgSize += 1 + sovRadio(uint64(msgSize))
-			}
-			mapSize := 1 + len(k) + sovRadio(uint64(len(k))) + msgSize
-			i = encodeVarintRadio(dAtA, i, uint64(mapSize))
-			dAtA[i] = 0xa
-			i++
-			i = encodeVarintRadio(dAtA, i, uint64(len(k)))
-			i += copy(dAtA[i:], k)
-			if v != nil {
-				dAtA[i] = 0x12
-				i++
-				i = encodeVarintRadio(dAtA, i, uint64(v.Size()))
-				n7, err := v.MarshalTo(dAtA[i:])
-				if err != nil {
-					return 0, err
-				}
-				i += n7
-			}
-		}
+	return nil
+}
+
+func (m *Vfo) GetTuningStep() int32 {
+	if m != nil {
+		return m.TuningStep
 	}
-	if len(m.Preamps) > 0 {
-		dAtA9 := make([]byte, len(m.Preamps)*10)
-		var j8 int
-		for _, num1 := range m.Preamps {
-			num := uint64(num1)
-			for num >= 1<<7 {
-				dAtA9[j8] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j8++
-			}
-			dAtA9[j8] = uint8(num)
-			j8++
-		}
-		dAtA[i] = 0x7a
-		i++
-		i = encodeVarintRadio(dAtA, i, uint64(j8))
-		i += copy(dAtA[i:], dAtA9[:j8])
-	}
-	if len(m.Attenuators) > 0 {
-		dAtA11 := make([]byte, len(m.Attenuators)*10)
-		var j10 int
-		for _, num1 := range m.Attenuators {
-			num := uint64(num1)
-			for num >= 1<<7 {
-				dAtA11[j10] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j10++
-			}
-			dAtA11[j10] = uint8(num)
-			j10++
-		}
-		dAtA[i] = 0x82
-		i++
-		dAtA[i] = 0x1
-		i++
-		i = encodeVarintRadio(dAtA, i, uint64(j10))
-		i += copy(dAtA[i:], dAtA11[:j10])
+	return 0
+}
+
+func (m *Vfo) GetFunctions() []string {
+	if m != nil {
+		return m.Functions
 	}
-	if m.RigModel != 0 {
-		dAtA[i] = 0x88
-		i++
-		dAtA[i] = 0x1
-		i++
-		i = encodeVarintRadio(dAtA, i, uint64(m.RigModel))
+	return nil
+}
+
+func (m *Vfo) GetLevels() map[string]float32 {
+	if m != nil {
+		return m.Levels
 	}
-	if len(m.ModelName) > 0 {
-		dAtA[i] = 0x92
-		i++
-		dAtA[i] = 0x1
-		i++
-		i = encodeVarintRadio(dAtA, i, uint64(len(m.ModelName)))
-		i += copy(dAtA[i:], m.ModelName)
+	return nil
+}
+
+func (m *Vfo) GetParameters() map[string]float32 {
+	if m != nil {
+		return m.Parameters
 	}
-	if len(m.Version) > 0 {
-		dAtA[i] = 0x9a
-		i++
-		dAtA[i] = 0x1
-		i++
-		i = encodeVarintRadio(dAtA, i, uint64(len(m.Version)))
-		i += copy(dAtA[i:], m.Version)
+	return nil
+}
+
+func (m *Vfo) GetCtcssTone() uint32 {
+	if m != nil {
+		return m.CtcssTone
 	}
-	if len(m.MfgName) > 0 {
-		dAtA[i] = 0xa2
-		i++
-		dAtA[i] = 0x1
-		i++
-		i = encodeVarintRadio(dAtA, i, uint64(len(m.MfgName)))
-		i += copy(dAtA[i:], m.MfgName)
+	return 0
+}
+
+func (m *Vfo) GetCtcssSql() uint32 {
+	if m != nil {
+		return m.CtcssSql
 	}
-	if len(m.Status) > 0 {
-		dAtA[i] = 0xaa
-		i++
-		dAtA[i] = 0x1
-		i++
-		i = encodeVarintRadio(dAtA, i, uint64(len(m.Status)))
-		i += copy(dAtA[i:], m.Status)
+	return 0
+}
+
+func (m *Vfo) GetDcsCode() uint32 {
+	if m != nil {
+		return m.DcsCode
 	}
-	return i, nil
+	return 0
 }
 
-func (m *Int32List) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (m *Vfo) GetDcsSql() uint32 {
+	if m != nil {
+		return m.DcsSql
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *Int32List) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Value) > 0 {
-		dAtA13 := make([]byte, len(m.Value)*10)
-		var j12 int
-		for _, num1 := range m.Value {
-			num := uint64(num1)
-			for num >= 1<<7 {
-				dAtA13[j12] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j12++
-			}
-			dAtA13[j12] = uint8(num)
-			j12++
-		}
-		dAtA[i] = 0x72
-		i++
-		i = encodeVarintRadio(dAtA, i, uint64(j12))
-		i += copy(dAtA[i:], dAtA13[:j12])
+func (m *Vfo) GetRptrShift() string {
+	if m != nil {
+		return m.RptrShift
 	}
-	return i, nil
+	return ""
 }
 
-func (m *Status) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (m *Vfo) GetRptrOffset() int32 {
+	if m != nil {
+		return m.RptrOffset
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *Status) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Online {
-		dAtA[i] = 0x8
-		i++
-		if m.Online {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i++
+type MetaData struct {
+	HasFrequency       bool `protobuf:"varint,1,opt,name=has_frequency,json=hasFrequency,proto3" json:"has_frequency,omitempty"`
+	HasMode            bool `protobuf:"varint,2,opt,name=has_mode,json=hasMode,proto3" json:"has_mode,omitempty"`
+	HasPbWidth         bool `protobuf:"varint,3,opt,name=has_pb_width,json=hasPbWidth,proto3" json:"has_pb_width,omitempty"`
+	HasAnt             bool `protobuf:"varint,4,opt,name=has_ant,json=hasAnt,proto3" json:"has_ant,omitempty"`
+	HasRit             bool `protobuf:"varint,5,opt,name=has_rit,json=hasRit,proto3" json:"has_rit,omitempty"`
+	HasXit             bool `protobuf:"varint,6,opt,name=has_xit,json=hasXit,proto3" json:"has_xit,omitempty"`
+	HasSplit           bool `protobuf:"varint,7,opt,name=has_split,json=hasSplit,proto3" json:"has_split,omitempty"`
+	HasTuningStep      bool `protobuf:"varint,8,opt,name=has_tuning_step,json=hasTuningStep,proto3" json:"has_tuning_step,omitempty"`
+	HasFunctions       bool `protobuf:"varint,9,opt,name=has_functions,json=hasFunctions,proto3" json:"has_functions,omitempty"`
+	HasLevels          bool `protobuf:"varint,10,opt,name=has_levels,json=hasLevels,proto3" json:"has_levels,omitempty"`
+	HasParameters      bool `protobuf:"varint,11,opt,name=has_parameters,json=hasParameters,proto3" json:"has_parameters,omitempty"`
+	HasPtt             bool `protobuf:"varint,12,opt,name=has_ptt,json=hasPtt,proto3" json:"has_ptt,omitempty"`
+	HasRadioOn         bool `protobuf:"varint,13,opt,name=has_radio_on,json=hasRadioOn,proto3" json:"has_radio_on,omitempty"`
+	HasPollingInterval bool `protobuf:"varint,14,opt,name=has_polling_interval,json=hasPollingInterval,proto3" json:"has_polling_interval,omitempty"`
+	HasCtcssTone       bool `protobuf:"varint,15,opt,name=has_ctcss_tone,json=hasCtcssTone,proto3" json:"has_ctcss_tone,omitempty"`
+	HasCtcssSql        bool `protobuf:"varint,16,opt,name=has_ctcss_sql,json=hasCtcssSql,proto3" json:"has_ctcss_sql,omitempty"`
+	HasDcsCode         bool `protobuf:"varint,17,opt,name=has_dcs_code,json=hasDcsCode,proto3" json:"has_dcs_code,omitempty"`
+	HasDcsSql          bool `protobuf:"varint,18,opt,name=has_dcs_sql,json=hasDcsSql,proto3" json:"has_dcs_sql,omitempty"`
+	HasRptrShift       bool `protobuf:"varint,19,opt,name=has_rptr_shift,json=hasRptrShift,proto3" json:"has_rptr_shift,omitempty"`
+	HasRptrOffset      bool `protobuf:"varint,20,opt,name=has_rptr_offset,json=hasRptrOffset,proto3" json:"has_rptr_offset,omitempty"`
+}
+
+func (m *MetaData) Reset()                    { *m = MetaData{} }
+func (m *MetaData) String() string            { return proto.CompactTextString(m) }
+func (*MetaData) ProtoMessage()               {}
+func (*MetaData) Descriptor() ([]byte, []int) { return fileDescriptorRadio, []int{16} }
+
+func (m *MetaData) GetHasFrequency() bool {
+	if m != nil {
+		return m.HasFrequency
 	}
-	return i, nil
+	return false
 }
 
-func (m *Vfo) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (m *MetaData) GetHasMode() bool {
+	if m != nil {
+		return m.HasMode
 	}
-	return dAtA[:n], nil
+	return false
 }
 
-func (m *Vfo) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Frequency != 0 {
-		dAtA[i] = 0x11
-		i++
-		i = encodeFixed64Radio(dAtA, i, uint64(math.Float64bits(float64(m.Frequency))))
+func (m *MetaData) GetHasPbWidth() bool {
+	if m != nil {
+		return m.HasPbWidth
 	}
-	if len(m.Mode) > 0 {
-		dAtA[i] = 0x1a
-		i++
-		i = encodeVarintRadio(dAtA, i, uint64(len(m.Mode)))
-		i += copy(dAtA[i:], m.Mode)
+	return false
+}
+
+func (m *MetaData) GetHasAnt() bool {
+	if m != nil {
+		return m.HasAnt
 	}
-	if m.PbWidth != 0 {
-		dAtA[i] = 0x20
-		i++
-		i = encodeVarintRadio(dAtA, i, uint64(m.PbWidth))
+	return false
+}
+
+func (m *MetaData) GetHasRit() bool {
+	if m != nil {
+		return m.HasRit
 	}
-	if m.Ant != 0 {
-		dAtA[i] = 0x28
-		i++
-		i = encodeVarintRadio(dAtA, i, uint64(m.Ant))
+	return false
+}
+
+func (m *MetaData) GetHasXit() bool {
+	if m != nil {
+		return m.HasXit
 	}
-	if m.Rit != 0 {
-		dAtA[i] = 0x48
-		i++
-		i = encodeVarintRadio(dAtA, i, uint64(m.Rit))
+	return false
+}
+
+func (m *MetaData) GetHasSplit() bool {
+	if m != nil {
+		return m.HasSplit
 	}
-	if m.Xit != 0 {
-		dAtA[i] = 0x50
-		i++
-		i = encodeVarintRadio(dAtA, i, uint64(m.Xit))
+	return false
+}
+
+func (m *MetaData) GetHasTuningStep() bool {
+	if m != nil {
+		return m.HasTuningStep
 	}
-	if m.Split != nil {
-		dAtA[i] = 0x5a
-		i++
-		i = encodeVarintRadio(dAtA, i, uint64(m.Split.Size()))
-		n14, err := m.Split.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n14
+	return false
+}
+
+func (m *MetaData) GetHasFunctions() bool {
+	if m != nil {
+		return m.HasFunctions
 	}
-	if m.TuningStep != 0 {
-		dAtA[i] = 0x60
-		i++
-		i = encodeVarintRadio(dAtA, i, uint64(m.TuningStep))
+	return false
+}
+
+func (m *MetaData) GetHasLevels() bool {
+	if m != nil {
+		return m.HasLevels
 	}
-	if len(m.Functions) > 0 {
-		for _, s := range m.Functions {
-			dAtA[i] = 0x6a
-			i++
-			l = len(s)
-			for l >= 1<<7 {
-				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
-				l >>= 7
-				i++
-			}
-			dAtA[i] = uint8(l)
-			i++
-			i += copy(dAtA[i:], s)
-		}
-	}
-	if len(m.Levels) > 0 {
-		for k, _ := range m.Levels {
-			dAtA[i] = 0x72
-			i++
-			v := m.Levels[k]
-			mapSize := 1 + len(k) + sovRadio(uint64(len(k))) + 1 + 4
-			i = encodeVarintRadio(dAtA, i, uint64(mapSize))
-			dAtA[i] = 0xa
-			i++
-			i = encodeVarintRadio(dAtA, i, uint64(len(k)))
-			i += copy(dAtA[i:], k)
-			dAtA[i] = 0x15
-			i++
-			i = encodeFixed32Radio(dAtA, i, uint32(math.Float32bits(float32(v))))
-		}
-	}
-	if len(m.Parameters) > 0 {
-		for k, _ := range m.Parameters {
-			dAtA[i] = 0x7a
-			i++
-			v := m.Parameters[k]
-			mapSize := 1 + len(k) + sovRadio(uint64(len(k))) + 1 + 4
-			i = encodeVarintRadio(dAtA, i, uint64(mapSize))
-			dAtA[i] = 0xa
-			i++
-			i = encodeVarintRadio(dAtA, i, uint64(len(k)))
-			i += copy(dAtA[i:], k)
-			dAtA[i] = 0x15
-			i++
-			i = encodeFixed32Radio(dAtA, i, uint32(math.Float32bits(float32(v))))
-		}
-	}
-	return i, nil
+	return false
 }
 
-func (m *MetaData) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (m *MetaData) GetHasParameters() bool {
+	if m != nil {
+		return m.HasParameters
 	}
-	return dAtA[:n], nil
+	return false
 }
 
-func (m *MetaData) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.HasFrequency {
-		dAtA[i] = 0x8
-		i++
-		if m.HasFrequency {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i++
-	}
-	if m.HasMode {
-		dAtA[i] = 0x10
-		i++
-		if m.HasMode {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i++
-	}
-	if m.HasPbWidth {
-		dAtA[i] = 0x18
-		i++
-		if m.HasPbWidth {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i++
-	}
-	if m.HasAnt {
-		dAtA[i] = 0x20
-		i++
-		if m.HasAnt {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i++
-	}
-	if m.HasRit {
-		dAtA[i] = 0x28
-		i++
-		if m.HasRit {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i++
-	}
-	if m.HasXit {
-		dAtA[i] = 0x30
-		i++
-		if m.HasXit {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i++
-	}
-	if m.HasSplit {
-		dAtA[i] = 0x38
-		i++
-		if m.HasSplit {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i++
-	}
-	if m.HasTuningStep {
-		dAtA[i] = 0x40
-		i++
-		if m.HasTuningStep {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i++
-	}
-	if m.HasFunctions {
-		dAtA[i] = 0x48
-		i++
-		if m.HasFunctions {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i++
-	}
-	if m.HasLevels {
-		dAtA[i] = 0x50
-		i++
-		if m.HasLevels {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i++
+func (m *MetaData) GetHasPtt() bool {
+	if m != nil {
+		return m.HasPtt
 	}
-	if m.HasParameters {
-		dAtA[i] = 0x58
-		i++
-		if m.HasParameters {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i++
+	return false
+}
+
+func (m *MetaData) GetHasRadioOn() bool {
+	if m != nil {
+		return m.HasRadioOn
 	}
-	if m.HasPtt {
-		dAtA[i] = 0x60
-		i++
-		if m.HasPtt {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i++
+	return false
+}
+
+func (m *MetaData) GetHasPollingInterval() bool {
+	if m != nil {
+		return m.HasPollingInterval
 	}
-	if m.HasRadioOn {
-		dAtA[i] = 0x68
-		i++
-		if m.HasRadioOn {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i++
+	return false
+}
+
+func (m *MetaData) GetHasCtcssTone() bool {
+	if m != nil {
+		return m.HasCtcssTone
 	}
-	if m.HasPollingInterval {
-		dAtA[i] = 0x70
-		i++
-		if m.HasPollingInterval {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i++
+	return false
+}
+
+func (m *MetaData) GetHasCtcssSql() bool {
+	if m != nil {
+		return m.HasCtcssSql
 	}
-	return i, nil
+	return false
 }
 
-func (m *Channel) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (m *MetaData) GetHasDcsCode() bool {
+	if m != nil {
+		return m.HasDcsCode
 	}
-	return dAtA[:n], nil
+	return false
 }
 
-func (m *Channel) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Channel != 0 {
-		dAtA[i] = 0x8
-		i++
-		i = encodeVarintRadio(dAtA, i, uint64(m.Channel))
+func (m *MetaData) GetHasDcsSql() bool {
+	if m != nil {
+		return m.HasDcsSql
 	}
-	return i, nil
+	return false
 }
 
-func (m *Value) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (m *MetaData) GetHasRptrShift() bool {
+	if m != nil {
+		return m.HasRptrShift
 	}
-	return dAtA[:n], nil
+	return false
 }
 
-func (m *Value) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Name) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintRadio(dAtA, i, uint64(len(m.Name)))
-		i += copy(dAtA[i:], m.Name)
-	}
-	if m.Step != 0 {
-		dAtA[i] = 0x15
-		i++
-		i = encodeFixed32Radio(dAtA, i, uint32(math.Float32bits(float32(m.Step))))
-	}
-	if m.Min != 0 {
-		dAtA[i] = 0x1d
-		i++
-		i = encodeFixed32Radio(dAtA, i, uint32(math.Float32bits(float32(m.Min))))
-	}
-	if m.Max != 0 {
-		dAtA[i] = 0x25
-		i++
-		i = encodeFixed32Radio(dAtA, i, uint32(math.Float32bits(float32(m.Max))))
+func (m *MetaData) GetHasRptrOffset() bool {
+	if m != nil {
+		return m.HasRptrOffset
 	}
-	return i, nil
+	return false
 }
 
-func (m *Function) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+type Channel struct {
+	Channel   int32   `protobuf:"varint,1,opt,name=channel,proto3" json:"channel,omitempty"`
+	Name      string  `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Frequency float64 `protobuf:"fixed64,3,opt,name=frequency,proto3" json:"frequency,omitempty"`
+	Mode      string  `protobuf:"bytes,4,opt,name=mode,proto3" json:"mode,omitempty"`
+	PbWidth   int32   `protobuf:"varint,5,opt,name=pb_width,json=pbWidth,proto3" json:"pb_width,omitempty"`
+	Split     *Split  `protobuf:"bytes,6,opt,name=split" json:"split,omitempty"`
+	CtcssTone uint32  `protobuf:"varint,7,opt,name=ctcss_tone,json=ctcssTone,proto3" json:"ctcss_tone,omitempty"`
+	CtcssSql  uint32  `protobuf:"varint,8,opt,name=ctcss_sql,json=ctcssSql,proto3" json:"ctcss_sql,omitempty"`
+	DcsCode   uint32  `protobuf:"varint,9,opt,name=dcs_code,json=dcsCode,proto3" json:"dcs_code,omitempty"`
+	DcsSql    uint32  `protobuf:"varint,10,opt,name=dcs_sql,json=dcsSql,proto3" json:"dcs_sql,omitempty"`
 }
 
-func (m *Function) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Func) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintRadio(dAtA, i, uint64(len(m.Func)))
-		i += copy(dAtA[i:], m.Func)
+func (m *Channel) Reset()                    { *m = Channel{} }
+func (m *Channel) String() string            { return proto.CompactTextString(m) }
+func (*Channel) ProtoMessage()               {}
+func (*Channel) Descriptor() ([]byte, []int) { return fileDescriptorRadio, []int{17} }
+
+func (m *Channel) GetChannel() int32 {
+	if m != nil {
+		return m.Channel
 	}
-	if m.Value {
-		dAtA[i] = 0x10
-		i++
-		if m.Value {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i++
+	return 0
+}
+
+func (m *Channel) GetName() string {
+	if m != nil {
+		return m.Name
 	}
-	return i, nil
+	return ""
 }
 
-func (m *Level) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (m *Channel) GetFrequency() float64 {
+	if m != nil {
+		return m.Frequency
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *Level) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Func) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintRadio(dAtA, i, uint64(len(m.Func)))
-		i += copy(dAtA[i:], m.Func)
+func (m *Channel) GetMode() string {
+	if m != nil {
+		return m.Mode
 	}
-	if m.Value {
-		dAtA[i] = 0x10
-		i++
-		if m.Value {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i++
+	return ""
+}
+
+func (m *Channel) GetPbWidth() int32 {
+	if m != nil {
+		return m.PbWidth
 	}
-	return i, nil
+	return 0
 }
 
-func (m *Parameter) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (m *Channel) GetSplit() *Split {
+	if m != nil {
+		return m.Split
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *Parameter) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Param) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintRadio(dAtA, i, uint64(len(m.Param)))
-		i += copy(dAtA[i:], m.Param)
+func (m *Channel) GetCtcssTone() uint32 {
+	if m != nil {
+		return m.CtcssTone
 	}
-	if m.Value {
-		dAtA[i] = 0x10
-		i++
-		if m.Value {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i++
+	return 0
+}
+
+func (m *Channel) GetCtcssSql() uint32 {
+	if m != nil {
+		return m.CtcssSql
 	}
-	return i, nil
+	return 0
 }
 
-func (m *Split) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (m *Channel) GetDcsCode() uint32 {
+	if m != nil {
+		return m.DcsCode
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *Split) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Enabled {
-		dAtA[i] = 0x8
-		i++
-		if m.Enabled {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i++
+func (m *Channel) GetDcsSql() uint32 {
+	if m != nil {
+		return m.DcsSql
 	}
-	if len(m.Vfo) > 0 {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintRadio(dAtA, i, uint64(len(m.Vfo)))
-		i += copy(dAtA[i:], m.Vfo)
+	return 0
+}
+
+type MemoryRequest struct {
+	UserId    string   `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	RequestId string   `protobuf:"bytes,2,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	Operation string   `protobuf:"bytes,3,opt,name=operation,proto3" json:"operation,omitempty"`
+	Channel   int32    `protobuf:"varint,4,opt,name=channel,proto3" json:"channel,omitempty"`
+	Data      *Channel `protobuf:"bytes,5,opt,name=data" json:"data,omitempty"`
+}
+
+func (m *MemoryRequest) Reset()                    { *m = MemoryRequest{} }
+func (m *MemoryRequest) String() string            { return proto.CompactTextString(m) }
+func (*MemoryRequest) ProtoMessage()               {}
+func (*MemoryRequest) Descriptor() ([]byte, []int) { return fileDescriptorRadio, []int{18} }
+
+func (m *MemoryRequest) GetUserId() string {
+	if m != nil {
+		return m.UserId
 	}
-	if m.Frequency != 0 {
-		dAtA[i] = 0x19
-		i++
-		i = encodeFixed64Radio(dAtA, i, uint64(math.Float64bits(float64(m.Frequency))))
+	return ""
+}
+
+func (m *MemoryRequest) GetRequestId() string {
+	if m != nil {
+		return m.RequestId
 	}
-	if len(m.Mode) > 0 {
-		dAtA[i] = 0x22
-		i++
-		i = encodeVarintRadio(dAtA, i, uint64(len(m.Mode)))
-		i += copy(dAtA[i:], m.Mode)
+	return ""
+}
+
+func (m *MemoryRequest) GetOperation() string {
+	if m != nil {
+		return m.Operation
 	}
-	if m.PbWidth != 0 {
-		dAtA[i] = 0x28
-		i++
-		i = encodeVarintRadio(dAtA, i, uint64(m.PbWidth))
+	return ""
+}
+
+func (m *MemoryRequest) GetChannel() int32 {
+	if m != nil {
+		return m.Channel
 	}
-	return i, nil
+	return 0
 }
 
-func encodeFixed64Radio(dAtA []byte, offset int, v uint64) int {
-	dAtA[offset] = uint8(v)
-	dAtA[offset+1] = uint8(v >> 8)
-	dAtA[offset+2] = uint8(v >> 16)
-	dAtA[offset+3] = uint8(v >> 24)
-	dAtA[offset+4] = uint8(v >> 32)
-	dAtA[offset+5] = uint8(v >> 40)
-	dAtA[offset+6] = uint8(v >> 48)
-	dAtA[offset+7] = uint8(v >> 56)
-	return offset + 8
+func (m *MemoryRequest) GetData() *Channel {
+	if m != nil {
+		return m.Data
+	}
+	return nil
 }
-func encodeFixed32Radio(dAtA []byte, offset int, v uint32) int {
-	dAtA[offset] = uint8(v)
-	dAtA[offset+1] = uint8(v >> 8)
-	dAtA[offset+2] = uint8(v >> 16)
-	dAtA[offset+3] = uint8(v >> 24)
-	return offset + 4
+
+type MemoryResponse struct {
+	UserId    string     `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	RequestId string     `protobuf:"bytes,2,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	Channels  []*Channel `protobuf:"bytes,3,rep,name=channels" json:"channels,omitempty"`
 }
-func encodeVarintRadio(dAtA []byte, offset int, v uint64) int {
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+
+func (m *MemoryResponse) Reset()                    { *m = MemoryResponse{} }
+func (m *MemoryResponse) String() string            { return proto.CompactTextString(m) }
+func (*MemoryResponse) ProtoMessage()               {}
+func (*MemoryResponse) Descriptor() ([]byte, []int) { return fileDescriptorRadio, []int{19} }
+
+func (m *MemoryResponse) GetUserId() string {
+	if m != nil {
+		return m.UserId
 	}
-	dAtA[offset] = uint8(v)
-	return offset + 1
+	return ""
 }
-func (m *State) Size() (n int) {
-	var l int
-	_ = l
-	l = len(m.CurrentVfo)
-	if l > 0 {
-		n += 1 + l + sovRadio(uint64(l))
+
+func (m *MemoryResponse) GetRequestId() string {
+	if m != nil {
+		return m.RequestId
 	}
-	if m.Vfo != nil {
-		l = m.Vfo.Size()
-		n += 1 + l + sovRadio(uint64(l))
+	return ""
+}
+
+func (m *MemoryResponse) GetChannels() []*Channel {
+	if m != nil {
+		return m.Channels
 	}
-	if m.Channel != nil {
-		l = m.Channel.Size()
-		n += 1 + l + sovRadio(uint64(l))
+	return nil
+}
+
+type Value struct {
+	Name string  `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Step float32 `protobuf:"fixed32,2,opt,name=step,proto3" json:"step,omitempty"`
+	Min  float32 `protobuf:"fixed32,3,opt,name=min,proto3" json:"min,omitempty"`
+	Max  float32 `protobuf:"fixed32,4,opt,name=max,proto3" json:"max,omitempty"`
+}
+
+func (m *Value) Reset()                    { *m = Value{} }
+func (m *Value) String() string            { return proto.CompactTextString(m) }
+func (*Value) ProtoMessage()               {}
+func (*Value) Descriptor() ([]byte, []int) { return fileDescriptorRadio, []int{20} }
+
+func (m *Value) GetName() string {
+	if m != nil {
+		return m.Name
 	}
-	if m.RadioOn {
-		n += 2
+	return ""
+}
+
+func (m *Value) GetStep() float32 {
+	if m != nil {
+		return m.Step
 	}
-	if m.Ptt {
-		n += 2
+	return 0
+}
+
+func (m *Value) GetMin() float32 {
+	if m != nil {
+		return m.Min
 	}
-	if m.PollingInterval != 0 {
-		n += 1 + sovRadio(uint64(m.PollingInterval))
+	return 0
+}
+
+func (m *Value) GetMax() float32 {
+	if m != nil {
+		return m.Max
 	}
-	return n
+	return 0
 }
 
-func (m *SetState) Size() (n int) {
-	var l int
-	_ = l
-	l = len(m.CurrentVfo)
-	if l > 0 {
-		n += 1 + l + sovRadio(uint64(l))
+type Function struct {
+	Func  string `protobuf:"bytes,1,opt,name=func,proto3" json:"func,omitempty"`
+	Value bool   `protobuf:"varint,2,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (m *Function) Reset()                    { *m = Function{} }
+func (m *Function) String() string            { return proto.CompactTextString(m) }
+func (*Function) ProtoMessage()               {}
+func (*Function) Descriptor() ([]byte, []int) { return fileDescriptorRadio, []int{21} }
+
+func (m *Function) GetFunc() string {
+	if m != nil {
+		return m.Func
 	}
-	if m.Vfo != nil {
-		l = m.Vfo.Size()
-		n += 1 + l + sovRadio(uint64(l))
+	return ""
+}
+
+func (m *Function) GetValue() bool {
+	if m != nil {
+		return m.Value
 	}
-	if m.Channel != nil {
-		l = m.Channel.Size()
-		n += 1 + l + sovRadio(uint64(l))
+	return false
+}
+
+type Level struct {
+	Func  string `protobuf:"bytes,1,opt,name=func,proto3" json:"func,omitempty"`
+	Value bool   `protobuf:"varint,2,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (m *Level) Reset()                    { *m = Level{} }
+func (m *Level) String() string            { return proto.CompactTextString(m) }
+func (*Level) ProtoMessage()               {}
+func (*Level) Descriptor() ([]byte, []int) { return fileDescriptorRadio, []int{22} }
+
+func (m *Level) GetFunc() string {
+	if m != nil {
+		return m.Func
 	}
-	if len(m.VfoOperations) > 0 {
-		for _, s := range m.VfoOperations {
-			l = len(s)
-			n += 1 + l + sovRadio(uint64(l))
-		}
+	return ""
+}
+
+func (m *Level) GetValue() bool {
+	if m != nil {
+		return m.Value
 	}
-	if m.RadioOn {
-		n += 2
+	return false
+}
+
+type Parameter struct {
+	Param string `protobuf:"bytes,1,opt,name=param,proto3" json:"param,omitempty"`
+	Value bool   `protobuf:"varint,2,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (m *Parameter) Reset()                    { *m = Parameter{} }
+func (m *Parameter) String() string            { return proto.CompactTextString(m) }
+func (*Parameter) ProtoMessage()               {}
+func (*Parameter) Descriptor() ([]byte, []int) { return fileDescriptorRadio, []int{23} }
+
+func (m *Parameter) GetParam() string {
+	if m != nil {
+		return m.Param
 	}
-	if m.Ptt {
-		n += 2
+	return ""
+}
+
+func (m *Parameter) GetValue() bool {
+	if m != nil {
+		return m.Value
 	}
-	if m.Md != nil {
-		l = m.Md.Size()
-		n += 1 + l + sovRadio(uint64(l))
+	return false
+}
+
+type Split struct {
+	Enabled   bool    `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	Vfo       string  `protobuf:"bytes,2,opt,name=vfo,proto3" json:"vfo,omitempty"`
+	Frequency float64 `protobuf:"fixed64,3,opt,name=frequency,proto3" json:"frequency,omitempty"`
+	Mode      string  `protobuf:"bytes,4,opt,name=mode,proto3" json:"mode,omitempty"`
+	PbWidth   int32   `protobuf:"varint,5,opt,name=pbWidth,proto3" json:"pbWidth,omitempty"`
+}
+
+func (m *Split) Reset()                    { *m = Split{} }
+func (m *Split) String() string            { return proto.CompactTextString(m) }
+func (*Split) ProtoMessage()               {}
+func (*Split) Descriptor() ([]byte, []int) { return fileDescriptorRadio, []int{24} }
+
+func (m *Split) GetEnabled() bool {
+	if m != nil {
+		return m.Enabled
 	}
-	if m.PollingInterval != 0 {
-		n += 1 + sovRadio(uint64(m.PollingInterval))
+	return false
+}
+
+func (m *Split) GetVfo() string {
+	if m != nil {
+		return m.Vfo
 	}
-	l = len(m.UserId)
-	if l > 0 {
-		n += 1 + l + sovRadio(uint64(l))
+	return ""
+}
+
+func (m *Split) GetFrequency() float64 {
+	if m != nil {
+		return m.Frequency
 	}
-	return n
+	return 0
 }
 
-func (m *Capabilities) Size() (n int) {
+func (m *Split) GetMode() string {
+	if m != nil {
+		return m.Mode
+	}
+	return ""
+}
+
+func (m *Split) GetPbWidth() int32 {
+	if m != nil {
+		return m.PbWidth
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*State)(nil), "shackbus.radio.State")
+	proto.RegisterType((*StateDelta)(nil), "shackbus.radio.StateDelta")
+	proto.RegisterType((*Meters)(nil), "shackbus.radio.Meters")
+	proto.RegisterType((*ResyncRequest)(nil), "shackbus.radio.ResyncRequest")
+	proto.RegisterType((*LogLine)(nil), "shackbus.radio.LogLine")
+	proto.RegisterType((*SetLogLevel)(nil), "shackbus.radio.SetLogLevel")
+	proto.RegisterType((*SendMorse)(nil), "shackbus.radio.SendMorse")
+	proto.RegisterType((*MorseProgress)(nil), "shackbus.radio.MorseProgress")
+	proto.RegisterType((*SetState)(nil), "shackbus.radio.SetState")
+	proto.RegisterType((*Error)(nil), "shackbus.radio.Error")
+	proto.RegisterType((*Alarm)(nil), "shackbus.radio.Alarm")
+	proto.RegisterType((*Ack)(nil), "shackbus.radio.Ack")
+	proto.RegisterType((*Lock)(nil), "shackbus.radio.Lock")
+	proto.RegisterType((*Capabilities)(nil), "shackbus.radio.Capabilities")
+	proto.RegisterType((*Int32List)(nil), "shackbus.radio.Int32List")
+	proto.RegisterType((*Vfo)(nil), "shackbus.radio.Vfo")
+	proto.RegisterType((*MetaData)(nil), "shackbus.radio.MetaData")
+	proto.RegisterType((*Channel)(nil), "shackbus.radio.Channel")
+	proto.RegisterType((*MemoryRequest)(nil), "shackbus.radio.MemoryRequest")
+	proto.RegisterType((*MemoryResponse)(nil), "shackbus.radio.MemoryResponse")
+	proto.RegisterType((*Value)(nil), "shackbus.radio.Value")
+	proto.RegisterType((*Function)(nil), "shackbus.radio.Function")
+	proto.RegisterType((*Level)(nil), "shackbus.radio.Level")
+	proto.RegisterType((*Parameter)(nil), "shackbus.radio.Parameter")
+	proto.RegisterType((*Split)(nil), "shackbus.radio.Split")
+}
+func (m *State) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *State) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if len(m.Vfos) > 0 {
-		for _, s := range m.Vfos {
-			l = len(s)
-			n += 1 + l + sovRadio(uint64(l))
-		}
+	if len(m.CurrentVfo) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintRadio(dAtA, i, uint64(len(m.CurrentVfo)))
+		i += copy(dAtA[i:], m.CurrentVfo)
 	}
-	if len(m.Modes) > 0 {
-		for _, s := range m.Modes {
-			l = len(s)
-			n += 1 + l + sovRadio(uint64(l))
+	if m.Vfo != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintRadio(dAtA, i, uint64(m.Vfo.Size()))
+		n1, err := m.Vfo.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
 		}
+		i += n1
 	}
-	if len(m.VfoOps) > 0 {
-		for _, s := range m.VfoOps {
-			l = len(s)
-			n += 1 + l + sovRadio(uint64(l))
+	if m.Channel != nil {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintRadio(dAtA, i, uint64(m.Channel.Size()))
+		n2, err := m.Channel.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
 		}
+		i += n2
 	}
-	if len(m.GetFunctions) > 0 {
-		for _, s := range m.GetFunctions {
-			l = len(s)
-			n += 1 + l + sovRadio(uint64(l))
+	if m.RadioOn {
+		dAtA[i] = 0x20
+		i++
+		if m.RadioOn {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
+		i++
 	}
-	if len(m.SetFunctions) > 0 {
-		for _, s := range m.SetFunctions {
-			l = len(s)
-			n += 1 + l + sovRadio(uint64(l))
+	if m.Ptt {
+		dAtA[i] = 0x28
+		i++
+		if m.Ptt {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
+		i++
 	}
-	if len(m.GetLevels) > 0 {
-		for _, e := range m.GetLevels {
-			l = e.Size()
-			n += 1 + l + sovRadio(uint64(l))
-		}
+	if m.PollingInterval != 0 {
+		dAtA[i] = 0x30
+		i++
+		i = encodeVarintRadio(dAtA, i, uint64(m.PollingInterval))
 	}
-	if len(m.SetLevels) > 0 {
-		for _, e := range m.SetLevels {
-			l = e.Size()
-			n += 1 + l + sovRadio(uint64(l))
-		}
+	if m.Version != 0 {
+		dAtA[i] = 0x38
+		i++
+		i = encodeVarintRadio(dAtA, i, uint64(m.Version))
 	}
-	if len(m.GetParameters) > 0 {
-		for _, e := range m.GetParameters {
-			l = e.Size()
-			n += 1 + l + sovRadio(uint64(l))
-		}
+	if len(m.PttOffReason) > 0 {
+		dAtA[i] = 0x42
+		i++
+		i = encodeVarintRadio(dAtA, i, uint64(len(m.PttOffReason)))
+		i += copy(dAtA[i:], m.PttOffReason)
 	}
-	if len(m.SetParameters) > 0 {
-		for _, e := range m.SetParameters {
-			l = e.Size()
-			n += 1 + l + sovRadio(uint64(l))
-		}
+	if len(m.LockHolder) > 0 {
+		dAtA[i] = 0x4a
+		i++
+		i = encodeVarintRadio(dAtA, i, uint64(len(m.LockHolder)))
+		i += copy(dAtA[i:], m.LockHolder)
 	}
-	if m.MaxRit != 0 {
-		n += 1 + sovRadio(uint64(m.MaxRit))
+	if m.LockExpires != 0 {
+		dAtA[i] = 0x50
+		i++
+		i = encodeVarintRadio(dAtA, i, uint64(m.LockExpires))
 	}
-	if m.MaxXit != 0 {
-		n += 1 + sovRadio(uint64(m.MaxXit))
+	return i, nil
+}
+
+func (m *StateDelta) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	if m.MaxIfShift != 0 {
-		n += 1 + sovRadio(uint64(m.MaxIfShift))
+	return dAtA[:n], nil
+}
+
+func (m *StateDelta) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Version != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintRadio(dAtA, i, uint64(m.Version))
 	}
-	if len(m.Filters) > 0 {
-		for k, v := range m.Filters {
-			_ = k
-			_ = v
-			l = 0
-			if v != nil {
-				l = v.Size()
-				l += 1 + sovRadio(uint64(l))
-			}
-			mapEntrySize := 1 + len(k) + sovRadio(uint64(len(k))) + l
-			n += mapEntrySize + 1 + sovRadio(uint64(mapEntrySize))
-		}
+	if m.BaseVersion != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintRadio(dAtA, i, uint64(m.BaseVersion))
 	}
-	if len(m.TuningSteps) > 0 {
-		for k, v := range m.TuningSteps {
-			_ = k
-			_ = v
-			l = 0
-			if v != nil {
-				l = v.Size()
-				l += 1 + sovRadio(uint64(l))
+	if len(m.Changed) > 0 {
+		for _, s := range m.Changed {
+			dAtA[i] = 0x1a
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
 			}
-			mapEntrySize := 1 + len(k) + sovRadio(uint64(len(k))) + l
-			n += mapEntrySize + 1 + sovRadio(uint64(mapEntrySize))
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
 		}
 	}
-	if len(m.Preamps) > 0 {
-		l = 0
-		for _, e := range m.Preamps {
-			l += sovRadio(uint64(e))
+	if m.State != nil {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintRadio(dAtA, i, uint64(m.State.Size()))
+		n3, err := m.State.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
 		}
-		n += 1 + sovRadio(uint64(l)) + l
+		i += n3
 	}
-	if len(m.Attenuators) > 0 {
-		l = 0
-		for _, e := range m.Attenuators {
-			l += sovRadio(uint64(e))
+	if len(m.Removed) > 0 {
+		for _, s := range m.Removed {
+			dAtA[i] = 0x2a
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
 		}
-		n += 2 + sovRadio(uint64(l)) + l
 	}
-	if m.RigModel != 0 {
-		n += 2 + sovRadio(uint64(m.RigModel))
+	return i, nil
+}
+
+func (m *Meters) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.ModelName)
-	if l > 0 {
-		n += 2 + l + sovRadio(uint64(l))
+	return dAtA[:n], nil
+}
+
+func (m *Meters) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Timestamp != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintRadio(dAtA, i, uint64(m.Timestamp))
 	}
-	l = len(m.Version)
-	if l > 0 {
-		n += 2 + l + sovRadio(uint64(l))
+	if m.Ptt {
+		dAtA[i] = 0x10
+		i++
+		if m.Ptt {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
 	}
-	l = len(m.MfgName)
-	if l > 0 {
-		n += 2 + l + sovRadio(uint64(l))
+	if len(m.Values) > 0 {
+		for k, _ := range m.Values {
+			dAtA[i] = 0x1a
+			i++
+			v := m.Values[k]
+			mapSize := 1 + len(k) + sovRadio(uint64(len(k))) + 1 + 4
+			i = encodeVarintRadio(dAtA, i, uint64(mapSize))
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintRadio(dAtA, i, uint64(len(k)))
+			i += copy(dAtA[i:], k)
+			dAtA[i] = 0x15
+			i++
+			i = encodeFixed32Radio(dAtA, i, uint32(math.Float32bits(float32(v))))
+		}
 	}
-	l = len(m.Status)
-	if l > 0 {
-		n += 2 + l + sovRadio(uint64(l))
+	return i, nil
+}
+
+func (m *ResyncRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *Int32List) Size() (n int) {
+func (m *ResyncRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if len(m.Value) > 0 {
-		l = 0
-		for _, e := range m.Value {
-			l += sovRadio(uint64(e))
-		}
-		n += 1 + sovRadio(uint64(l)) + l
+	if len(m.UserId) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintRadio(dAtA, i, uint64(len(m.UserId)))
+		i += copy(dAtA[i:], m.UserId)
 	}
-	return n
+	if m.Version != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintRadio(dAtA, i, uint64(m.Version))
+	}
+	return i, nil
 }
 
-func (m *Status) Size() (n int) {
-	var l int
-	_ = l
-	if m.Online {
-		n += 2
+func (m *LogLine) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *Vfo) Size() (n int) {
+func (m *LogLine) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if m.Frequency != 0 {
-		n += 9
+	if m.Timestamp != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintRadio(dAtA, i, uint64(m.Timestamp))
 	}
-	l = len(m.Mode)
-	if l > 0 {
-		n += 1 + l + sovRadio(uint64(l))
+	if m.Level != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintRadio(dAtA, i, uint64(m.Level))
 	}
-	if m.PbWidth != 0 {
-		n += 1 + sovRadio(uint64(m.PbWidth))
+	if len(m.Msg) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintRadio(dAtA, i, uint64(len(m.Msg)))
+		i += copy(dAtA[i:], m.Msg)
 	}
-	if m.Ant != 0 {
-		n += 1 + sovRadio(uint64(m.Ant))
+	return i, nil
+}
+
+func (m *SetLogLevel) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	if m.Rit != 0 {
-		n += 1 + sovRadio(uint64(m.Rit))
+	return dAtA[:n], nil
+}
+
+func (m *SetLogLevel) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.UserId) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintRadio(dAtA, i, uint64(len(m.UserId)))
+		i += copy(dAtA[i:], m.UserId)
 	}
-	if m.Xit != 0 {
-		n += 1 + sovRadio(uint64(m.Xit))
-	}
-	if m.Split != nil {
-		l = m.Split.Size()
-		n += 1 + l + sovRadio(uint64(l))
-	}
-	if m.TuningStep != 0 {
-		n += 1 + sovRadio(uint64(m.TuningStep))
-	}
-	if len(m.Functions) > 0 {
-		for _, s := range m.Functions {
-			l = len(s)
-			n += 1 + l + sovRadio(uint64(l))
-		}
+	if m.Level != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintRadio(dAtA, i, uint64(m.Level))
 	}
-	if len(m.Levels) > 0 {
-		for k, v := range m.Levels {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + len(k) + sovRadio(uint64(len(k))) + 1 + 4
-			n += mapEntrySize + 1 + sovRadio(uint64(mapEntrySize))
-		}
+	if len(m.RequestId) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintRadio(dAtA, i, uint64(len(m.RequestId)))
+		i += copy(dAtA[i:], m.RequestId)
 	}
-	if len(m.Parameters) > 0 {
-		for k, v := range m.Parameters {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + len(k) + sovRadio(uint64(len(k))) + 1 + 4
-			n += mapEntrySize + 1 + sovRadio(uint64(mapEntrySize))
-		}
+	return i, nil
+}
+
+func (m *SendMorse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MetaData) Size() (n int) {
+func (m *SendMorse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if m.HasFrequency {
-		n += 2
-	}
-	if m.HasMode {
-		n += 2
-	}
-	if m.HasPbWidth {
-		n += 2
-	}
-	if m.HasAnt {
-		n += 2
-	}
-	if m.HasRit {
-		n += 2
-	}
-	if m.HasXit {
-		n += 2
-	}
-	if m.HasSplit {
-		n += 2
-	}
-	if m.HasTuningStep {
-		n += 2
-	}
-	if m.HasFunctions {
-		n += 2
-	}
-	if m.HasLevels {
-		n += 2
+	if len(m.UserId) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintRadio(dAtA, i, uint64(len(m.UserId)))
+		i += copy(dAtA[i:], m.UserId)
 	}
-	if m.HasParameters {
-		n += 2
+	if len(m.RequestId) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintRadio(dAtA, i, uint64(len(m.RequestId)))
+		i += copy(dAtA[i:], m.RequestId)
 	}
-	if m.HasPtt {
-		n += 2
+	if len(m.Text) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintRadio(dAtA, i, uint64(len(m.Text)))
+		i += copy(dAtA[i:], m.Text)
 	}
-	if m.HasRadioOn {
-		n += 2
+	if m.Speed != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintRadio(dAtA, i, uint64(m.Speed))
 	}
-	if m.HasPollingInterval {
-		n += 2
+	if m.Abort {
+		dAtA[i] = 0x28
+		i++
+		if m.Abort {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
 	}
-	return n
+	return i, nil
 }
 
-func (m *Channel) Size() (n int) {
-	var l int
-	_ = l
-	if m.Channel != 0 {
-		n += 1 + sovRadio(uint64(m.Channel))
+func (m *MorseProgress) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *Value) Size() (n int) {
+func (m *MorseProgress) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovRadio(uint64(l))
-	}
-	if m.Step != 0 {
-		n += 5
+	if len(m.RequestId) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintRadio(dAtA, i, uint64(len(m.RequestId)))
+		i += copy(dAtA[i:], m.RequestId)
 	}
-	if m.Min != 0 {
-		n += 5
+	if len(m.UserId) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintRadio(dAtA, i, uint64(len(m.UserId)))
+		i += copy(dAtA[i:], m.UserId)
 	}
-	if m.Max != 0 {
-		n += 5
+	if m.Sent != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintRadio(dAtA, i, uint64(m.Sent))
 	}
-	return n
-}
-
-func (m *Function) Size() (n int) {
-	var l int
-	_ = l
-	l = len(m.Func)
-	if l > 0 {
-		n += 1 + l + sovRadio(uint64(l))
+	if m.Total != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintRadio(dAtA, i, uint64(m.Total))
 	}
-	if m.Value {
-		n += 2
+	if m.Done {
+		dAtA[i] = 0x28
+		i++
+		if m.Done {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
 	}
-	return n
-}
-
-func (m *Level) Size() (n int) {
-	var l int
-	_ = l
-	l = len(m.Func)
-	if l > 0 {
-		n += 1 + l + sovRadio(uint64(l))
+	if m.Aborted {
+		dAtA[i] = 0x30
+		i++
+		if m.Aborted {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
 	}
-	if m.Value {
-		n += 2
+	if len(m.Error) > 0 {
+		dAtA[i] = 0x3a
+		i++
+		i = encodeVarintRadio(dAtA, i, uint64(len(m.Error)))
+		i += copy(dAtA[i:], m.Error)
 	}
-	return n
+	return i, nil
 }
 
-func (m *Parameter) Size() (n int) {
-	var l int
-	_ = l
-	l = len(m.Param)
-	if l > 0 {
-		n += 1 + l + sovRadio(uint64(l))
-	}
-	if m.Value {
-		n += 2
+func (m *SetState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *Split) Size() (n int) {
+func (m *SetState) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if m.Enabled {
-		n += 2
+	if len(m.CurrentVfo) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintRadio(dAtA, i, uint64(len(m.CurrentVfo)))
+		i += copy(dAtA[i:], m.CurrentVfo)
 	}
-	l = len(m.Vfo)
-	if l > 0 {
-		n += 1 + l + sovRadio(uint64(l))
+	if m.Vfo != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintRadio(dAtA, i, uint64(m.Vfo.Size()))
+		n4, err := m.Vfo.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n4
 	}
-	if m.Frequency != 0 {
-		n += 9
+	if m.Channel != nil {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintRadio(dAtA, i, uint64(m.Channel.Size()))
+		n5, err := m.Channel.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n5
 	}
-	l = len(m.Mode)
-	if l > 0 {
-		n += 1 + l + sovRadio(uint64(l))
+	if len(m.VfoOperations) > 0 {
+		for _, s := range m.VfoOperations {
+			dAtA[i] = 0x22
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
 	}
-	if m.PbWidth != 0 {
-		n += 1 + sovRadio(uint64(m.PbWidth))
+	if m.RadioOn {
+		dAtA[i] = 0x28
+		i++
+		if m.RadioOn {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
 	}
-	return n
+	if m.Ptt {
+		dAtA[i] = 0x30
+		i++
+		if m.Ptt {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.Md != nil {
+		dAtA[i] = 0x3a
+		i++
+		i = encodeVarintRadio(dAtA, i, uint64(m.Md.Size()))
+		n6, err := m.Md.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n6
+	}
+	if m.PollingInterval != 0 {
+		dAtA[i] = 0x40
+		i++
+		i = encodeVarintRadio(dAtA, i, uint64(m.PollingInterval))
+	}
+	if len(m.UserId) > 0 {
+		dAtA[i] = 0x4a
+		i++
+		i = encodeVarintRadio(dAtA, i, uint64(len(m.UserId)))
+		i += copy(dAtA[i:], m.UserId)
+	}
+	if len(m.RequestId) > 0 {
+		dAtA[i] = 0x52
+		i++
+		i = encodeVarintRadio(dAtA, i, uint64(len(m.RequestId)))
+		i += copy(dAtA[i:], m.RequestId)
+	}
+	if m.Atomic {
+		dAtA[i] = 0x58
+		i++
+		if m.Atomic {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	return i, nil
 }
 
-func sovRadio(x uint64) (n int) {
-	for {
-		n++
-		x >>= 7
-		if x == 0 {
-			break
+func (m *Error) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Error) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Field) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintRadio(dAtA, i, uint64(len(m.Field)))
+		i += copy(dAtA[i:], m.Field)
+	}
+	if len(m.Error) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintRadio(dAtA, i, uint64(len(m.Error)))
+		i += copy(dAtA[i:], m.Error)
+	}
+	if len(m.UserId) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintRadio(dAtA, i, uint64(len(m.UserId)))
+		i += copy(dAtA[i:], m.UserId)
+	}
+	if m.Timestamp != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintRadio(dAtA, i, uint64(m.Timestamp))
+	}
+	if len(m.RequestId) > 0 {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintRadio(dAtA, i, uint64(len(m.RequestId)))
+		i += copy(dAtA[i:], m.RequestId)
+	}
+	return i, nil
+}
+
+func (m *Alarm) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Alarm) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Meter) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintRadio(dAtA, i, uint64(len(m.Meter)))
+		i += copy(dAtA[i:], m.Meter)
+	}
+	if m.Value != 0 {
+		dAtA[i] = 0x15
+		i++
+		i = encodeFixed32Radio(dAtA, i, uint32(math.Float32bits(float32(m.Value))))
+	}
+	if m.Limit != 0 {
+		dAtA[i] = 0x1d
+		i++
+		i = encodeFixed32Radio(dAtA, i, uint32(math.Float32bits(float32(m.Limit))))
+	}
+	if m.Duration != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintRadio(dAtA, i, uint64(m.Duration))
+	}
+	if m.Frequency != 0 {
+		dAtA[i] = 0x29
+		i++
+		i = encodeFixed64Radio(dAtA, i, uint64(math.Float64bits(float64(m.Frequency))))
+	}
+	if len(m.Band) > 0 {
+		dAtA[i] = 0x32
+		i++
+		i = encodeVarintRadio(dAtA, i, uint64(len(m.Band)))
+		i += copy(dAtA[i:], m.Band)
+	}
+	if len(m.UserId) > 0 {
+		dAtA[i] = 0x3a
+		i++
+		i = encodeVarintRadio(dAtA, i, uint64(len(m.UserId)))
+		i += copy(dAtA[i:], m.UserId)
+	}
+	if len(m.Meters) > 0 {
+		for k, _ := range m.Meters {
+			dAtA[i] = 0x42
+			i++
+			v := m.Meters[k]
+			mapSize := 1 + len(k) + sovRadio(uint64(len(k))) + 1 + 4
+			i = encodeVarintRadio(dAtA, i, uint64(mapSize))
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintRadio(dAtA, i, uint64(len(k)))
+			i += copy(dAtA[i:], k)
+			dAtA[i] = 0x15
+			i++
+			i = encodeFixed32Radio(dAtA, i, uint32(math.Float32bits(float32(v))))
 		}
 	}
-	return n
+	if m.Rfpower != 0 {
+		dAtA[i] = 0x4d
+		i++
+		i = encodeFixed32Radio(dAtA, i, uint32(math.Float32bits(float32(m.Rfpower))))
+	}
+	if m.Timestamp != 0 {
+		dAtA[i] = 0x50
+		i++
+		i = encodeVarintRadio(dAtA, i, uint64(m.Timestamp))
+	}
+	return i, nil
 }
-func sozRadio(x uint64) (n int) {
-	return sovRadio(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *Ack) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
 }
-func (m *State) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowRadio
+
+func (m *Ack) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.RequestId) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintRadio(dAtA, i, uint64(len(m.RequestId)))
+		i += copy(dAtA[i:], m.RequestId)
+	}
+	if len(m.UserId) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintRadio(dAtA, i, uint64(len(m.UserId)))
+		i += copy(dAtA[i:], m.UserId)
+	}
+	if len(m.Applied) > 0 {
+		for _, s := range m.Applied {
+			dAtA[i] = 0x1a
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if len(m.Rejected) > 0 {
+		for _, msg := range m.Rejected {
+			dAtA[i] = 0x22
+			i++
+			i = encodeVarintRadio(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
+			i += n
+		}
+	}
+	if m.StateVersion != 0 {
+		dAtA[i] = 0x28
+		i++
+		i = encodeVarintRadio(dAtA, i, uint64(m.StateVersion))
+	}
+	if m.RolledBack {
+		dAtA[i] = 0x30
+		i++
+		if m.RolledBack {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if len(m.RollbackError) > 0 {
+		dAtA[i] = 0x3a
+		i++
+		i = encodeVarintRadio(dAtA, i, uint64(len(m.RollbackError)))
+		i += copy(dAtA[i:], m.RollbackError)
+	}
+	return i, nil
+}
+
+func (m *Lock) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Lock) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.UserId) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintRadio(dAtA, i, uint64(len(m.UserId)))
+		i += copy(dAtA[i:], m.UserId)
+	}
+	if m.Acquire {
+		dAtA[i] = 0x10
+		i++
+		if m.Acquire {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.Takeover {
+		dAtA[i] = 0x18
+		i++
+		if m.Takeover {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if len(m.RequestId) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintRadio(dAtA, i, uint64(len(m.RequestId)))
+		i += copy(dAtA[i:], m.RequestId)
+	}
+	return i, nil
+}
+
+func (m *Capabilities) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Capabilities) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Vfos) > 0 {
+		for _, s := range m.Vfos {
+			dAtA[i] = 0xa
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if len(m.Modes) > 0 {
+		for _, s := range m.Modes {
+			dAtA[i] = 0x12
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if len(m.VfoOps) > 0 {
+		for _, s := range m.VfoOps {
+			dAtA[i] = 0x1a
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if len(m.GetFunctions) > 0 {
+		for _, s := range m.GetFunctions {
+			dAtA[i] = 0x22
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if len(m.SetFunctions) > 0 {
+		for _, s := range m.SetFunctions {
+			dAtA[i] = 0x2a
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if len(m.GetLevels) > 0 {
+		for _, msg := range m.GetLevels {
+			dAtA[i] = 0x32
+			i++
+			i = encodeVarintRadio(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if len(m.SetLevels) > 0 {
+		for _, msg := range m.SetLevels {
+			dAtA[i] = 0x3a
+			i++
+			i = encodeVarintRadio(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if len(m.GetParameters) > 0 {
+		for _, msg := range m.GetParameters {
+			dAtA[i] = 0x42
+			i++
+			i = encodeVarintRadio(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if len(m.SetParameters) > 0 {
+		for _, msg := range m.SetParameters {
+			dAtA[i] = 0x4a
+			i++
+			i = encodeVarintRadio(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if m.MaxRit != 0 {
+		dAtA[i] = 0x50
+		i++
+		i = encodeVarintRadio(dAtA, i, uint64(m.MaxRit))
+	}
+	if m.MaxXit != 0 {
+		dAtA[i] = 0x58
+		i++
+		i = encodeVarintRadio(dAtA, i, uint64(m.MaxXit))
+	}
+	if m.MaxIfShift != 0 {
+		dAtA[i] = 0x60
+		i++
+		i = encodeVarintRadio(dAtA, i, uint64(m.MaxIfShift))
+	}
+	if len(m.Filters) > 0 {
+		for k, _ := range m.Filters {
+			dAtA[i] = 0x6a
+			i++
+			v := m.Filters[k]
+			msgSize := 0
+			if v != nil {
+				msgSize = v.Size()
+				msgSize += 1 + sovRadio(uint64(msgSize))
+			}
+			mapSize := 1 + len(k) + sovRadio(uint64(len(k))) + msgSize
+			i = encodeVarintRadio(dAtA, i, uint64(mapSize))
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintRadio(dAtA, i, uint64(len(k)))
+			i += copy(dAtA[i:], k)
+			if v != nil {
+				dAtA[i] = 0x12
+				i++
+				i = encodeVarintRadio(dAtA, i, uint64(v.Size()))
+				n7, err := v.MarshalTo(dAtA[i:])
+				if err != nil {
+					return 0, err
+				}
+				i += n7
+			}
+		}
+	}
+	if len(m.TuningSteps) > 0 {
+		for k, _ := range m.TuningSteps {
+			dAtA[i] = 0x72
+			i++
+			v := m.TuningSteps[k]
+			msgSize := 0
+			if v != nil {
+				msgSize = v.Size()
+				msgSize += 1 + sovRadio(uint64(msgSize))
+			}
+			mapSize := 1 + len(k) + sovRadio(uint64(len(k))) + msgSize
+			i = encodeVarintRadio(dAtA, i, uint64(mapSize))
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintRadio(dAtA, i, uint64(len(k)))
+			i += copy(dAtA[i:], k)
+			if v != nil {
+				dAtA[i] = 0x12
+				i++
+				i = encodeVarintRadio(dAtA, i, uint64(v.Size()))
+				n8, err := v.MarshalTo(dAtA[i:])
+				if err != nil {
+					return 0, err
+				}
+				i += n8
+			}
+		}
+	}
+	if len(m.Preamps) > 0 {
+		dAtA10 := make([]byte, len(m.Preamps)*10)
+		var j9 int
+		for _, num1 := range m.Preamps {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA10[j9] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j9++
+			}
+			dAtA10[j9] = uint8(num)
+			j9++
+		}
+		dAtA[i] = 0x7a
+		i++
+		i = encodeVarintRadio(dAtA, i, uint64(j9))
+		i += copy(dAtA[i:], dAtA10[:j9])
+	}
+	if len(m.Attenuators) > 0 {
+		dAtA12 := make([]byte, len(m.Attenuators)*10)
+		var j11 int
+		for _, num1 := range m.Attenuators {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA12[j11] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j11++
+			}
+			dAtA12[j11] = uint8(num)
+			j11++
+		}
+		dAtA[i] = 0x82
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintRadio(dAtA, i, uint64(j11))
+		i += copy(dAtA[i:], dAtA12[:j11])
+	}
+	if m.RigModel != 0 {
+		dAtA[i] = 0x88
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintRadio(dAtA, i, uint64(m.RigModel))
+	}
+	if len(m.ModelName) > 0 {
+		dAtA[i] = 0x92
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintRadio(dAtA, i, uint64(len(m.ModelName)))
+		i += copy(dAtA[i:], m.ModelName)
+	}
+	if len(m.Version) > 0 {
+		dAtA[i] = 0x9a
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintRadio(dAtA, i, uint64(len(m.Version)))
+		i += copy(dAtA[i:], m.Version)
+	}
+	if len(m.MfgName) > 0 {
+		dAtA[i] = 0xa2
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintRadio(dAtA, i, uint64(len(m.MfgName)))
+		i += copy(dAtA[i:], m.MfgName)
+	}
+	if len(m.Status) > 0 {
+		dAtA[i] = 0xaa
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintRadio(dAtA, i, uint64(len(m.Status)))
+		i += copy(dAtA[i:], m.Status)
+	}
+	if m.MemFirst != 0 {
+		dAtA[i] = 0xb0
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintRadio(dAtA, i, uint64(m.MemFirst))
+	}
+	if m.MemLast != 0 {
+		dAtA[i] = 0xb8
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintRadio(dAtA, i, uint64(m.MemLast))
+	}
+	if len(m.CtcssList) > 0 {
+		dAtA14 := make([]byte, len(m.CtcssList)*10)
+		var j13 int
+		for _, num := range m.CtcssList {
+			for num >= 1<<7 {
+				dAtA14[j13] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j13++
+			}
+			dAtA14[j13] = uint8(num)
+			j13++
+		}
+		dAtA[i] = 0xc2
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintRadio(dAtA, i, uint64(j13))
+		i += copy(dAtA[i:], dAtA14[:j13])
+	}
+	if len(m.DcsList) > 0 {
+		dAtA16 := make([]byte, len(m.DcsList)*10)
+		var j15 int
+		for _, num := range m.DcsList {
+			for num >= 1<<7 {
+				dAtA16[j15] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j15++
+			}
+			dAtA16[j15] = uint8(num)
+			j15++
+		}
+		dAtA[i] = 0xca
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintRadio(dAtA, i, uint64(j15))
+		i += copy(dAtA[i:], dAtA16[:j15])
+	}
+	return i, nil
+}
+
+func (m *Int32List) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Int32List) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Value) > 0 {
+		dAtA18 := make([]byte, len(m.Value)*10)
+		var j17 int
+		for _, num1 := range m.Value {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA18[j17] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j17++
+			}
+			dAtA18[j17] = uint8(num)
+			j17++
+		}
+		dAtA[i] = 0x72
+		i++
+		i = encodeVarintRadio(dAtA, i, uint64(j17))
+		i += copy(dAtA[i:], dAtA18[:j17])
+	}
+	return i, nil
+}
+
+func (m *Vfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Vfo) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Frequency != 0 {
+		dAtA[i] = 0x11
+		i++
+		i = encodeFixed64Radio(dAtA, i, uint64(math.Float64bits(float64(m.Frequency))))
+	}
+	if len(m.Mode) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintRadio(dAtA, i, uint64(len(m.Mode)))
+		i += copy(dAtA[i:], m.Mode)
+	}
+	if m.PbWidth != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintRadio(dAtA, i, uint64(m.PbWidth))
+	}
+	if m.Ant != 0 {
+		dAtA[i] = 0x28
+		i++
+		i = encodeVarintRadio(dAtA, i, uint64(m.Ant))
+	}
+	if m.Rit != 0 {
+		dAtA[i] = 0x48
+		i++
+		i = encodeVarintRadio(dAtA, i, uint64(m.Rit))
+	}
+	if m.Xit != 0 {
+		dAtA[i] = 0x50
+		i++
+		i = encodeVarintRadio(dAtA, i, uint64(m.Xit))
+	}
+	if m.Split != nil {
+		dAtA[i] = 0x5a
+		i++
+		i = encodeVarintRadio(dAtA, i, uint64(m.Split.Size()))
+		n19, err := m.Split.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n19
+	}
+	if m.TuningStep != 0 {
+		dAtA[i] = 0x60
+		i++
+		i = encodeVarintRadio(dAtA, i, uint64(m.TuningStep))
+	}
+	if len(m.Functions) > 0 {
+		for _, s := range m.Functions {
+			dAtA[i] = 0x6a
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if len(m.Levels) > 0 {
+		for k, _ := range m.Levels {
+			dAtA[i] = 0x72
+			i++
+			v := m.Levels[k]
+			mapSize := 1 + len(k) + sovRadio(uint64(len(k))) + 1 + 4
+			i = encodeVarintRadio(dAtA, i, uint64(mapSize))
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintRadio(dAtA, i, uint64(len(k)))
+			i += copy(dAtA[i:], k)
+			dAtA[i] = 0x15
+			i++
+			i = encodeFixed32Radio(dAtA, i, uint32(math.Float32bits(float32(v))))
+		}
+	}
+	if len(m.Parameters) > 0 {
+		for k, _ := range m.Parameters {
+			dAtA[i] = 0x7a
+			i++
+			v := m.Parameters[k]
+			mapSize := 1 + len(k) + sovRadio(uint64(len(k))) + 1 + 4
+			i = encodeVarintRadio(dAtA, i, uint64(mapSize))
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintRadio(dAtA, i, uint64(len(k)))
+			i += copy(dAtA[i:], k)
+			dAtA[i] = 0x15
+			i++
+			i = encodeFixed32Radio(dAtA, i, uint32(math.Float32bits(float32(v))))
+		}
+	}
+	if m.CtcssTone != 0 {
+		dAtA[i] = 0x80
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintRadio(dAtA, i, uint64(m.CtcssTone))
+	}
+	if m.CtcssSql != 0 {
+		dAtA[i] = 0x88
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintRadio(dAtA, i, uint64(m.CtcssSql))
+	}
+	if m.DcsCode != 0 {
+		dAtA[i] = 0x90
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintRadio(dAtA, i, uint64(m.DcsCode))
+	}
+	if m.DcsSql != 0 {
+		dAtA[i] = 0x98
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintRadio(dAtA, i, uint64(m.DcsSql))
+	}
+	if len(m.RptrShift) > 0 {
+		dAtA[i] = 0xa2
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintRadio(dAtA, i, uint64(len(m.RptrShift)))
+		i += copy(dAtA[i:], m.RptrShift)
+	}
+	if m.RptrOffset != 0 {
+		dAtA[i] = 0xa8
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintRadio(dAtA, i, uint64(m.RptrOffset))
+	}
+	return i, nil
+}
+
+func (m *MetaData) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MetaData) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.HasFrequency {
+		dAtA[i] = 0x8
+		i++
+		if m.HasFrequency {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.HasMode {
+		dAtA[i] = 0x10
+		i++
+		if m.HasMode {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.HasPbWidth {
+		dAtA[i] = 0x18
+		i++
+		if m.HasPbWidth {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.HasAnt {
+		dAtA[i] = 0x20
+		i++
+		if m.HasAnt {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.HasRit {
+		dAtA[i] = 0x28
+		i++
+		if m.HasRit {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.HasXit {
+		dAtA[i] = 0x30
+		i++
+		if m.HasXit {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.HasSplit {
+		dAtA[i] = 0x38
+		i++
+		if m.HasSplit {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.HasTuningStep {
+		dAtA[i] = 0x40
+		i++
+		if m.HasTuningStep {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.HasFunctions {
+		dAtA[i] = 0x48
+		i++
+		if m.HasFunctions {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.HasLevels {
+		dAtA[i] = 0x50
+		i++
+		if m.HasLevels {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.HasParameters {
+		dAtA[i] = 0x58
+		i++
+		if m.HasParameters {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.HasPtt {
+		dAtA[i] = 0x60
+		i++
+		if m.HasPtt {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.HasRadioOn {
+		dAtA[i] = 0x68
+		i++
+		if m.HasRadioOn {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.HasPollingInterval {
+		dAtA[i] = 0x70
+		i++
+		if m.HasPollingInterval {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.HasCtcssTone {
+		dAtA[i] = 0x78
+		i++
+		if m.HasCtcssTone {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.HasCtcssSql {
+		dAtA[i] = 0x80
+		i++
+		dAtA[i] = 0x1
+		i++
+		if m.HasCtcssSql {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.HasDcsCode {
+		dAtA[i] = 0x88
+		i++
+		dAtA[i] = 0x1
+		i++
+		if m.HasDcsCode {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.HasDcsSql {
+		dAtA[i] = 0x90
+		i++
+		dAtA[i] = 0x1
+		i++
+		if m.HasDcsSql {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.HasRptrShift {
+		dAtA[i] = 0x98
+		i++
+		dAtA[i] = 0x1
+		i++
+		if m.HasRptrShift {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.HasRptrOffset {
+		dAtA[i] = 0xa0
+		i++
+		dAtA[i] = 0x1
+		i++
+		if m.HasRptrOffset {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	return i, nil
+}
+
+func (m *Channel) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Channel) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Channel != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintRadio(dAtA, i, uint64(m.Channel))
+	}
+	if len(m.Name) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintRadio(dAtA, i, uint64(len(m.Name)))
+		i += copy(dAtA[i:], m.Name)
+	}
+	if m.Frequency != 0 {
+		dAtA[i] = 0x19
+		i++
+		i = encodeFixed64Radio(dAtA, i, uint64(math.Float64bits(float64(m.Frequency))))
+	}
+	if len(m.Mode) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintRadio(dAtA, i, uint64(len(m.Mode)))
+		i += copy(dAtA[i:], m.Mode)
+	}
+	if m.PbWidth != 0 {
+		dAtA[i] = 0x28
+		i++
+		i = encodeVarintRadio(dAtA, i, uint64(m.PbWidth))
+	}
+	if m.Split != nil {
+		dAtA[i] = 0x32
+		i++
+		i = encodeVarintRadio(dAtA, i, uint64(m.Split.Size()))
+		n20, err := m.Split.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n20
+	}
+	if m.CtcssTone != 0 {
+		dAtA[i] = 0x38
+		i++
+		i = encodeVarintRadio(dAtA, i, uint64(m.CtcssTone))
+	}
+	if m.CtcssSql != 0 {
+		dAtA[i] = 0x40
+		i++
+		i = encodeVarintRadio(dAtA, i, uint64(m.CtcssSql))
+	}
+	if m.DcsCode != 0 {
+		dAtA[i] = 0x48
+		i++
+		i = encodeVarintRadio(dAtA, i, uint64(m.DcsCode))
+	}
+	if m.DcsSql != 0 {
+		dAtA[i] = 0x50
+		i++
+		i = encodeVarintRadio(dAtA, i, uint64(m.DcsSql))
+	}
+	return i, nil
+}
+
+func (m *MemoryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MemoryRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.UserId) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintRadio(dAtA, i, uint64(len(m.UserId)))
+		i += copy(dAtA[i:], m.UserId)
+	}
+	if len(m.RequestId) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintRadio(dAtA, i, uint64(len(m.RequestId)))
+		i += copy(dAtA[i:], m.RequestId)
+	}
+	if len(m.Operation) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintRadio(dAtA, i, uint64(len(m.Operation)))
+		i += copy(dAtA[i:], m.Operation)
+	}
+	if m.Channel != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintRadio(dAtA, i, uint64(m.Channel))
+	}
+	if m.Data != nil {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintRadio(dAtA, i, uint64(m.Data.Size()))
+		n21, err := m.Data.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n21
+	}
+	return i, nil
+}
+
+func (m *MemoryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MemoryResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.UserId) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintRadio(dAtA, i, uint64(len(m.UserId)))
+		i += copy(dAtA[i:], m.UserId)
+	}
+	if len(m.RequestId) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintRadio(dAtA, i, uint64(len(m.RequestId)))
+		i += copy(dAtA[i:], m.RequestId)
+	}
+	if len(m.Channels) > 0 {
+		for _, msg := range m.Channels {
+			dAtA[i] = 0x1a
+			i++
+			i = encodeVarintRadio(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
+
+func (m *Value) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Value) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Name) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintRadio(dAtA, i, uint64(len(m.Name)))
+		i += copy(dAtA[i:], m.Name)
+	}
+	if m.Step != 0 {
+		dAtA[i] = 0x15
+		i++
+		i = encodeFixed32Radio(dAtA, i, uint32(math.Float32bits(float32(m.Step))))
+	}
+	if m.Min != 0 {
+		dAtA[i] = 0x1d
+		i++
+		i = encodeFixed32Radio(dAtA, i, uint32(math.Float32bits(float32(m.Min))))
+	}
+	if m.Max != 0 {
+		dAtA[i] = 0x25
+		i++
+		i = encodeFixed32Radio(dAtA, i, uint32(math.Float32bits(float32(m.Max))))
+	}
+	return i, nil
+}
+
+func (m *Function) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Function) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Func) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintRadio(dAtA, i, uint64(len(m.Func)))
+		i += copy(dAtA[i:], m.Func)
+	}
+	if m.Value {
+		dAtA[i] = 0x10
+		i++
+		if m.Value {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	return i, nil
+}
+
+func (m *Level) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Level) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Func) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintRadio(dAtA, i, uint64(len(m.Func)))
+		i += copy(dAtA[i:], m.Func)
+	}
+	if m.Value {
+		dAtA[i] = 0x10
+		i++
+		if m.Value {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	return i, nil
+}
+
+func (m *Parameter) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Parameter) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Param) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintRadio(dAtA, i, uint64(len(m.Param)))
+		i += copy(dAtA[i:], m.Param)
+	}
+	if m.Value {
+		dAtA[i] = 0x10
+		i++
+		if m.Value {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	return i, nil
+}
+
+func (m *Split) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Split) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Enabled {
+		dAtA[i] = 0x8
+		i++
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if len(m.Vfo) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintRadio(dAtA, i, uint64(len(m.Vfo)))
+		i += copy(dAtA[i:], m.Vfo)
+	}
+	if m.Frequency != 0 {
+		dAtA[i] = 0x19
+		i++
+		i = encodeFixed64Radio(dAtA, i, uint64(math.Float64bits(float64(m.Frequency))))
+	}
+	if len(m.Mode) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintRadio(dAtA, i, uint64(len(m.Mode)))
+		i += copy(dAtA[i:], m.Mode)
+	}
+	if m.PbWidth != 0 {
+		dAtA[i] = 0x28
+		i++
+		i = encodeVarintRadio(dAtA, i, uint64(m.PbWidth))
+	}
+	return i, nil
+}
+
+func encodeFixed64Radio(dAtA []byte, offset int, v uint64) int {
+	dAtA[offset] = uint8(v)
+	dAtA[offset+1] = uint8(v >> 8)
+	dAtA[offset+2] = uint8(v >> 16)
+	dAtA[offset+3] = uint8(v >> 24)
+	dAtA[offset+4] = uint8(v >> 32)
+	dAtA[offset+5] = uint8(v >> 40)
+	dAtA[offset+6] = uint8(v >> 48)
+	dAtA[offset+7] = uint8(v >> 56)
+	return offset + 8
+}
+func encodeFixed32Radio(dAtA []byte, offset int, v uint32) int {
+	dAtA[offset] = uint8(v)
+	dAtA[offset+1] = uint8(v >> 8)
+	dAtA[offset+2] = uint8(v >> 16)
+	dAtA[offset+3] = uint8(v >> 24)
+	return offset + 4
+}
+func encodeVarintRadio(dAtA []byte, offset int, v uint64) int {
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return offset + 1
+}
+func (m *State) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.CurrentVfo)
+	if l > 0 {
+		n += 1 + l + sovRadio(uint64(l))
+	}
+	if m.Vfo != nil {
+		l = m.Vfo.Size()
+		n += 1 + l + sovRadio(uint64(l))
+	}
+	if m.Channel != nil {
+		l = m.Channel.Size()
+		n += 1 + l + sovRadio(uint64(l))
+	}
+	if m.RadioOn {
+		n += 2
+	}
+	if m.Ptt {
+		n += 2
+	}
+	if m.PollingInterval != 0 {
+		n += 1 + sovRadio(uint64(m.PollingInterval))
+	}
+	if m.Version != 0 {
+		n += 1 + sovRadio(uint64(m.Version))
+	}
+	l = len(m.PttOffReason)
+	if l > 0 {
+		n += 1 + l + sovRadio(uint64(l))
+	}
+	l = len(m.LockHolder)
+	if l > 0 {
+		n += 1 + l + sovRadio(uint64(l))
+	}
+	if m.LockExpires != 0 {
+		n += 1 + sovRadio(uint64(m.LockExpires))
+	}
+	return n
+}
+
+func (m *StateDelta) Size() (n int) {
+	var l int
+	_ = l
+	if m.Version != 0 {
+		n += 1 + sovRadio(uint64(m.Version))
+	}
+	if m.BaseVersion != 0 {
+		n += 1 + sovRadio(uint64(m.BaseVersion))
+	}
+	if len(m.Changed) > 0 {
+		for _, s := range m.Changed {
+			l = len(s)
+			n += 1 + l + sovRadio(uint64(l))
+		}
+	}
+	if m.State != nil {
+		l = m.State.Size()
+		n += 1 + l + sovRadio(uint64(l))
+	}
+	if len(m.Removed) > 0 {
+		for _, s := range m.Removed {
+			l = len(s)
+			n += 1 + l + sovRadio(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Meters) Size() (n int) {
+	var l int
+	_ = l
+	if m.Timestamp != 0 {
+		n += 1 + sovRadio(uint64(m.Timestamp))
+	}
+	if m.Ptt {
+		n += 2
+	}
+	if len(m.Values) > 0 {
+		for k, v := range m.Values {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovRadio(uint64(len(k))) + 1 + 4
+			n += mapEntrySize + 1 + sovRadio(uint64(mapEntrySize))
+		}
+	}
+	return n
+}
+
+func (m *ResyncRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.UserId)
+	if l > 0 {
+		n += 1 + l + sovRadio(uint64(l))
+	}
+	if m.Version != 0 {
+		n += 1 + sovRadio(uint64(m.Version))
+	}
+	return n
+}
+
+func (m *LogLine) Size() (n int) {
+	var l int
+	_ = l
+	if m.Timestamp != 0 {
+		n += 1 + sovRadio(uint64(m.Timestamp))
+	}
+	if m.Level != 0 {
+		n += 1 + sovRadio(uint64(m.Level))
+	}
+	l = len(m.Msg)
+	if l > 0 {
+		n += 1 + l + sovRadio(uint64(l))
+	}
+	return n
+}
+
+func (m *SetLogLevel) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.UserId)
+	if l > 0 {
+		n += 1 + l + sovRadio(uint64(l))
+	}
+	if m.Level != 0 {
+		n += 1 + sovRadio(uint64(m.Level))
+	}
+	l = len(m.RequestId)
+	if l > 0 {
+		n += 1 + l + sovRadio(uint64(l))
+	}
+	return n
+}
+
+func (m *SendMorse) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.UserId)
+	if l > 0 {
+		n += 1 + l + sovRadio(uint64(l))
+	}
+	l = len(m.RequestId)
+	if l > 0 {
+		n += 1 + l + sovRadio(uint64(l))
+	}
+	l = len(m.Text)
+	if l > 0 {
+		n += 1 + l + sovRadio(uint64(l))
+	}
+	if m.Speed != 0 {
+		n += 1 + sovRadio(uint64(m.Speed))
+	}
+	if m.Abort {
+		n += 2
+	}
+	return n
+}
+
+func (m *MorseProgress) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.RequestId)
+	if l > 0 {
+		n += 1 + l + sovRadio(uint64(l))
+	}
+	l = len(m.UserId)
+	if l > 0 {
+		n += 1 + l + sovRadio(uint64(l))
+	}
+	if m.Sent != 0 {
+		n += 1 + sovRadio(uint64(m.Sent))
+	}
+	if m.Total != 0 {
+		n += 1 + sovRadio(uint64(m.Total))
+	}
+	if m.Done {
+		n += 2
+	}
+	if m.Aborted {
+		n += 2
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovRadio(uint64(l))
+	}
+	return n
+}
+
+func (m *SetState) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.CurrentVfo)
+	if l > 0 {
+		n += 1 + l + sovRadio(uint64(l))
+	}
+	if m.Vfo != nil {
+		l = m.Vfo.Size()
+		n += 1 + l + sovRadio(uint64(l))
+	}
+	if m.Channel != nil {
+		l = m.Channel.Size()
+		n += 1 + l + sovRadio(uint64(l))
+	}
+	if len(m.VfoOperations) > 0 {
+		for _, s := range m.VfoOperations {
+			l = len(s)
+			n += 1 + l + sovRadio(uint64(l))
+		}
+	}
+	if m.RadioOn {
+		n += 2
+	}
+	if m.Ptt {
+		n += 2
+	}
+	if m.Md != nil {
+		l = m.Md.Size()
+		n += 1 + l + sovRadio(uint64(l))
+	}
+	if m.PollingInterval != 0 {
+		n += 1 + sovRadio(uint64(m.PollingInterval))
+	}
+	l = len(m.UserId)
+	if l > 0 {
+		n += 1 + l + sovRadio(uint64(l))
+	}
+	l = len(m.RequestId)
+	if l > 0 {
+		n += 1 + l + sovRadio(uint64(l))
+	}
+	if m.Atomic {
+		n += 2
+	}
+	return n
+}
+
+func (m *Error) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Field)
+	if l > 0 {
+		n += 1 + l + sovRadio(uint64(l))
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovRadio(uint64(l))
+	}
+	l = len(m.UserId)
+	if l > 0 {
+		n += 1 + l + sovRadio(uint64(l))
+	}
+	if m.Timestamp != 0 {
+		n += 1 + sovRadio(uint64(m.Timestamp))
+	}
+	l = len(m.RequestId)
+	if l > 0 {
+		n += 1 + l + sovRadio(uint64(l))
+	}
+	return n
+}
+
+func (m *Alarm) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Meter)
+	if l > 0 {
+		n += 1 + l + sovRadio(uint64(l))
+	}
+	if m.Value != 0 {
+		n += 5
+	}
+	if m.Limit != 0 {
+		n += 5
+	}
+	if m.Duration != 0 {
+		n += 1 + sovRadio(uint64(m.Duration))
+	}
+	if m.Frequency != 0 {
+		n += 9
+	}
+	l = len(m.Band)
+	if l > 0 {
+		n += 1 + l + sovRadio(uint64(l))
+	}
+	l = len(m.UserId)
+	if l > 0 {
+		n += 1 + l + sovRadio(uint64(l))
+	}
+	if len(m.Meters) > 0 {
+		for k, v := range m.Meters {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovRadio(uint64(len(k))) + 1 + 4
+			n += mapEntrySize + 1 + sovRadio(uint64(mapEntrySize))
+		}
+	}
+	if m.Rfpower != 0 {
+		n += 5
+	}
+	if m.Timestamp != 0 {
+		n += 1 + sovRadio(uint64(m.Timestamp))
+	}
+	return n
+}
+
+func (m *Ack) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.RequestId)
+	if l > 0 {
+		n += 1 + l + sovRadio(uint64(l))
+	}
+	l = len(m.UserId)
+	if l > 0 {
+		n += 1 + l + sovRadio(uint64(l))
+	}
+	if len(m.Applied) > 0 {
+		for _, s := range m.Applied {
+			l = len(s)
+			n += 1 + l + sovRadio(uint64(l))
+		}
+	}
+	if len(m.Rejected) > 0 {
+		for _, e := range m.Rejected {
+			l = e.Size()
+			n += 1 + l + sovRadio(uint64(l))
+		}
+	}
+	if m.StateVersion != 0 {
+		n += 1 + sovRadio(uint64(m.StateVersion))
+	}
+	if m.RolledBack {
+		n += 2
+	}
+	l = len(m.RollbackError)
+	if l > 0 {
+		n += 1 + l + sovRadio(uint64(l))
+	}
+	return n
+}
+
+func (m *Lock) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.UserId)
+	if l > 0 {
+		n += 1 + l + sovRadio(uint64(l))
+	}
+	if m.Acquire {
+		n += 2
+	}
+	if m.Takeover {
+		n += 2
+	}
+	l = len(m.RequestId)
+	if l > 0 {
+		n += 1 + l + sovRadio(uint64(l))
+	}
+	return n
+}
+
+func (m *Capabilities) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.Vfos) > 0 {
+		for _, s := range m.Vfos {
+			l = len(s)
+			n += 1 + l + sovRadio(uint64(l))
+		}
+	}
+	if len(m.Modes) > 0 {
+		for _, s := range m.Modes {
+			l = len(s)
+			n += 1 + l + sovRadio(uint64(l))
+		}
+	}
+	if len(m.VfoOps) > 0 {
+		for _, s := range m.VfoOps {
+			l = len(s)
+			n += 1 + l + sovRadio(uint64(l))
+		}
+	}
+	if len(m.GetFunctions) > 0 {
+		for _, s := range m.GetFunctions {
+			l = len(s)
+			n += 1 + l + sovRadio(uint64(l))
+		}
+	}
+	if len(m.SetFunctions) > 0 {
+		for _, s := range m.SetFunctions {
+			l = len(s)
+			n += 1 + l + sovRadio(uint64(l))
+		}
+	}
+	if len(m.GetLevels) > 0 {
+		for _, e := range m.GetLevels {
+			l = e.Size()
+			n += 1 + l + sovRadio(uint64(l))
+		}
+	}
+	if len(m.SetLevels) > 0 {
+		for _, e := range m.SetLevels {
+			l = e.Size()
+			n += 1 + l + sovRadio(uint64(l))
+		}
+	}
+	if len(m.GetParameters) > 0 {
+		for _, e := range m.GetParameters {
+			l = e.Size()
+			n += 1 + l + sovRadio(uint64(l))
+		}
+	}
+	if len(m.SetParameters) > 0 {
+		for _, e := range m.SetParameters {
+			l = e.Size()
+			n += 1 + l + sovRadio(uint64(l))
+		}
+	}
+	if m.MaxRit != 0 {
+		n += 1 + sovRadio(uint64(m.MaxRit))
+	}
+	if m.MaxXit != 0 {
+		n += 1 + sovRadio(uint64(m.MaxXit))
+	}
+	if m.MaxIfShift != 0 {
+		n += 1 + sovRadio(uint64(m.MaxIfShift))
+	}
+	if len(m.Filters) > 0 {
+		for k, v := range m.Filters {
+			_ = k
+			_ = v
+			l = 0
+			if v != nil {
+				l = v.Size()
+				l += 1 + sovRadio(uint64(l))
+			}
+			mapEntrySize := 1 + len(k) + sovRadio(uint64(len(k))) + l
+			n += mapEntrySize + 1 + sovRadio(uint64(mapEntrySize))
+		}
+	}
+	if len(m.TuningSteps) > 0 {
+		for k, v := range m.TuningSteps {
+			_ = k
+			_ = v
+			l = 0
+			if v != nil {
+				l = v.Size()
+				l += 1 + sovRadio(uint64(l))
+			}
+			mapEntrySize := 1 + len(k) + sovRadio(uint64(len(k))) + l
+			n += mapEntrySize + 1 + sovRadio(uint64(mapEntrySize))
+		}
+	}
+	if len(m.Preamps) > 0 {
+		l = 0
+		for _, e := range m.Preamps {
+			l += sovRadio(uint64(e))
+		}
+		n += 1 + sovRadio(uint64(l)) + l
+	}
+	if len(m.Attenuators) > 0 {
+		l = 0
+		for _, e := range m.Attenuators {
+			l += sovRadio(uint64(e))
+		}
+		n += 2 + sovRadio(uint64(l)) + l
+	}
+	if m.RigModel != 0 {
+		n += 2 + sovRadio(uint64(m.RigModel))
+	}
+	l = len(m.ModelName)
+	if l > 0 {
+		n += 2 + l + sovRadio(uint64(l))
+	}
+	l = len(m.Version)
+	if l > 0 {
+		n += 2 + l + sovRadio(uint64(l))
+	}
+	l = len(m.MfgName)
+	if l > 0 {
+		n += 2 + l + sovRadio(uint64(l))
+	}
+	l = len(m.Status)
+	if l > 0 {
+		n += 2 + l + sovRadio(uint64(l))
+	}
+	if m.MemFirst != 0 {
+		n += 2 + sovRadio(uint64(m.MemFirst))
+	}
+	if m.MemLast != 0 {
+		n += 2 + sovRadio(uint64(m.MemLast))
+	}
+	if len(m.CtcssList) > 0 {
+		l = 0
+		for _, e := range m.CtcssList {
+			l += sovRadio(uint64(e))
+		}
+		n += 2 + sovRadio(uint64(l)) + l
+	}
+	if len(m.DcsList) > 0 {
+		l = 0
+		for _, e := range m.DcsList {
+			l += sovRadio(uint64(e))
+		}
+		n += 2 + sovRadio(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *Int32List) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.Value) > 0 {
+		l = 0
+		for _, e := range m.Value {
+			l += sovRadio(uint64(e))
+		}
+		n += 1 + sovRadio(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *Vfo) Size() (n int) {
+	var l int
+	_ = l
+	if m.Frequency != 0 {
+		n += 9
+	}
+	l = len(m.Mode)
+	if l > 0 {
+		n += 1 + l + sovRadio(uint64(l))
+	}
+	if m.PbWidth != 0 {
+		n += 1 + sovRadio(uint64(m.PbWidth))
+	}
+	if m.Ant != 0 {
+		n += 1 + sovRadio(uint64(m.Ant))
+	}
+	if m.Rit != 0 {
+		n += 1 + sovRadio(uint64(m.Rit))
+	}
+	if m.Xit != 0 {
+		n += 1 + sovRadio(uint64(m.Xit))
+	}
+	if m.Split != nil {
+		l = m.Split.Size()
+		n += 1 + l + sovRadio(uint64(l))
+	}
+	if m.TuningStep != 0 {
+		n += 1 + sovRadio(uint64(m.TuningStep))
+	}
+	if len(m.Functions) > 0 {
+		for _, s := range m.Functions {
+			l = len(s)
+			n += 1 + l + sovRadio(uint64(l))
+		}
+	}
+	if len(m.Levels) > 0 {
+		for k, v := range m.Levels {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovRadio(uint64(len(k))) + 1 + 4
+			n += mapEntrySize + 1 + sovRadio(uint64(mapEntrySize))
+		}
+	}
+	if len(m.Parameters) > 0 {
+		for k, v := range m.Parameters {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovRadio(uint64(len(k))) + 1 + 4
+			n += mapEntrySize + 1 + sovRadio(uint64(mapEntrySize))
+		}
+	}
+	if m.CtcssTone != 0 {
+		n += 2 + sovRadio(uint64(m.CtcssTone))
+	}
+	if m.CtcssSql != 0 {
+		n += 2 + sovRadio(uint64(m.CtcssSql))
+	}
+	if m.DcsCode != 0 {
+		n += 2 + sovRadio(uint64(m.DcsCode))
+	}
+	if m.DcsSql != 0 {
+		n += 2 + sovRadio(uint64(m.DcsSql))
+	}
+	l = len(m.RptrShift)
+	if l > 0 {
+		n += 2 + l + sovRadio(uint64(l))
+	}
+	if m.RptrOffset != 0 {
+		n += 2 + sovRadio(uint64(m.RptrOffset))
+	}
+	return n
+}
+
+func (m *MetaData) Size() (n int) {
+	var l int
+	_ = l
+	if m.HasFrequency {
+		n += 2
+	}
+	if m.HasMode {
+		n += 2
+	}
+	if m.HasPbWidth {
+		n += 2
+	}
+	if m.HasAnt {
+		n += 2
+	}
+	if m.HasRit {
+		n += 2
+	}
+	if m.HasXit {
+		n += 2
+	}
+	if m.HasSplit {
+		n += 2
+	}
+	if m.HasTuningStep {
+		n += 2
+	}
+	if m.HasFunctions {
+		n += 2
+	}
+	if m.HasLevels {
+		n += 2
+	}
+	if m.HasParameters {
+		n += 2
+	}
+	if m.HasPtt {
+		n += 2
+	}
+	if m.HasRadioOn {
+		n += 2
+	}
+	if m.HasPollingInterval {
+		n += 2
+	}
+	if m.HasCtcssTone {
+		n += 2
+	}
+	if m.HasCtcssSql {
+		n += 3
+	}
+	if m.HasDcsCode {
+		n += 3
+	}
+	if m.HasDcsSql {
+		n += 3
+	}
+	if m.HasRptrShift {
+		n += 3
+	}
+	if m.HasRptrOffset {
+		n += 3
+	}
+	return n
+}
+
+func (m *Channel) Size() (n int) {
+	var l int
+	_ = l
+	if m.Channel != 0 {
+		n += 1 + sovRadio(uint64(m.Channel))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovRadio(uint64(l))
+	}
+	if m.Frequency != 0 {
+		n += 9
+	}
+	l = len(m.Mode)
+	if l > 0 {
+		n += 1 + l + sovRadio(uint64(l))
+	}
+	if m.PbWidth != 0 {
+		n += 1 + sovRadio(uint64(m.PbWidth))
+	}
+	if m.Split != nil {
+		l = m.Split.Size()
+		n += 1 + l + sovRadio(uint64(l))
+	}
+	if m.CtcssTone != 0 {
+		n += 1 + sovRadio(uint64(m.CtcssTone))
+	}
+	if m.CtcssSql != 0 {
+		n += 1 + sovRadio(uint64(m.CtcssSql))
+	}
+	if m.DcsCode != 0 {
+		n += 1 + sovRadio(uint64(m.DcsCode))
+	}
+	if m.DcsSql != 0 {
+		n += 1 + sovRadio(uint64(m.DcsSql))
+	}
+	return n
+}
+
+func (m *MemoryRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.UserId)
+	if l > 0 {
+		n += 1 + l + sovRadio(uint64(l))
+	}
+	l = len(m.RequestId)
+	if l > 0 {
+		n += 1 + l + sovRadio(uint64(l))
+	}
+	l = len(m.Operation)
+	if l > 0 {
+		n += 1 + l + sovRadio(uint64(l))
+	}
+	if m.Channel != 0 {
+		n += 1 + sovRadio(uint64(m.Channel))
+	}
+	if m.Data != nil {
+		l = m.Data.Size()
+		n += 1 + l + sovRadio(uint64(l))
+	}
+	return n
+}
+
+func (m *MemoryResponse) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.UserId)
+	if l > 0 {
+		n += 1 + l + sovRadio(uint64(l))
+	}
+	l = len(m.RequestId)
+	if l > 0 {
+		n += 1 + l + sovRadio(uint64(l))
+	}
+	if len(m.Channels) > 0 {
+		for _, e := range m.Channels {
+			l = e.Size()
+			n += 1 + l + sovRadio(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Value) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovRadio(uint64(l))
+	}
+	if m.Step != 0 {
+		n += 5
+	}
+	if m.Min != 0 {
+		n += 5
+	}
+	if m.Max != 0 {
+		n += 5
+	}
+	return n
+}
+
+func (m *Function) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Func)
+	if l > 0 {
+		n += 1 + l + sovRadio(uint64(l))
+	}
+	if m.Value {
+		n += 2
+	}
+	return n
+}
+
+func (m *Level) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Func)
+	if l > 0 {
+		n += 1 + l + sovRadio(uint64(l))
+	}
+	if m.Value {
+		n += 2
+	}
+	return n
+}
+
+func (m *Parameter) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Param)
+	if l > 0 {
+		n += 1 + l + sovRadio(uint64(l))
+	}
+	if m.Value {
+		n += 2
+	}
+	return n
+}
+
+func (m *Split) Size() (n int) {
+	var l int
+	_ = l
+	if m.Enabled {
+		n += 2
+	}
+	l = len(m.Vfo)
+	if l > 0 {
+		n += 1 + l + sovRadio(uint64(l))
+	}
+	if m.Frequency != 0 {
+		n += 9
+	}
+	l = len(m.Mode)
+	if l > 0 {
+		n += 1 + l + sovRadio(uint64(l))
+	}
+	if m.PbWidth != 0 {
+		n += 1 + sovRadio(uint64(m.PbWidth))
+	}
+	return n
+}
+
+func sovRadio(x uint64) (n int) {
+	for {
+		n++
+		x >>= 7
+		if x == 0 {
+			break
+		}
+	}
+	return n
+}
+func sozRadio(x uint64) (n int) {
+	return sovRadio(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *State) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRadio
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: State: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: State: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentVfo", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRadio
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRadio
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CurrentVfo = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Vfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRadio
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRadio
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Vfo == nil {
+				m.Vfo = &Vfo{}
+			}
+			if err := m.Vfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Channel", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRadio
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRadio
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Channel == nil {
+				m.Channel = &Channel{}
+			}
+			if err := m.Channel.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RadioOn", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRadio
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RadioOn = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ptt", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRadio
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Ptt = bool(v != 0)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PollingInterval", wireType)
+			}
+			m.PollingInterval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRadio
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PollingInterval |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRadio
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PttOffReason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRadio
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRadio
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PttOffReason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockHolder", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRadio
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRadio
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LockHolder = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockExpires", wireType)
+			}
+			m.LockExpires = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRadio
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LockExpires |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRadio(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRadio
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StateDelta) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRadio
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StateDelta: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StateDelta: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRadio
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseVersion", wireType)
+			}
+			m.BaseVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRadio
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BaseVersion |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Changed", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRadio
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRadio
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Changed = append(m.Changed, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRadio
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRadio
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.State == nil {
+				m.State = &State{}
+			}
+			if err := m.State.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Removed", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRadio
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRadio
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Removed = append(m.Removed, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRadio(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRadio
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Meters) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRadio
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Meters: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Meters: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			m.Timestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRadio
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Timestamp |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ptt", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRadio
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Ptt = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Values", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRadio
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRadio
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var keykey uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRadio
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				keykey |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			var stringLenmapkey uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRadio
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLenmapkey |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLenmapkey := int(stringLenmapkey)
+			if intStringLenmapkey < 0 {
+				return ErrInvalidLengthRadio
+			}
+			postStringIndexmapkey := iNdEx + intStringLenmapkey
+			if postStringIndexmapkey > l {
+				return io.ErrUnexpectedEOF
+			}
+			mapkey := string(dAtA[iNdEx:postStringIndexmapkey])
+			iNdEx = postStringIndexmapkey
+			if m.Values == nil {
+				m.Values = make(map[string]float32)
+			}
+			if iNdEx < postIndex {
+				var valuekey uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowRadio
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					valuekey |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				var mapvaluetemp uint32
+				if (iNdEx + 4) > l {
+					return io.ErrUnexpectedEOF
+				}
+				iNdEx += 4
+				mapvaluetemp = uint32(dAtA[iNdEx-4])
+				mapvaluetemp |= uint32(dAtA[iNdEx-3]) << 8
+				mapvaluetemp |= uint32(dAtA[iNdEx-2]) << 16
+				mapvaluetemp |= uint32(dAtA[iNdEx-1]) << 24
+				mapvalue := math.Float32frombits(mapvaluetemp)
+				m.Values[mapkey] = mapvalue
+			} else {
+				var mapvalue float32
+				m.Values[mapkey] = mapvalue
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRadio(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRadio
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ResyncRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRadio
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ResyncRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ResyncRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UserId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRadio
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRadio
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UserId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRadio
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRadio(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRadio
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LogLine) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRadio
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LogLine: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LogLine: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			m.Timestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRadio
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Timestamp |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Level", wireType)
+			}
+			m.Level = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRadio
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Level |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRadio
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRadio
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Msg = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRadio(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRadio
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SetLogLevel) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRadio
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SetLogLevel: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SetLogLevel: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UserId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRadio
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRadio
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UserId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Level", wireType)
+			}
+			m.Level = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRadio
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Level |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRadio
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRadio
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RequestId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRadio(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRadio
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SendMorse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRadio
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SendMorse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SendMorse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UserId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRadio
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRadio
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UserId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRadio
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRadio
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RequestId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Text", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRadio
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRadio
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Text = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Speed", wireType)
+			}
+			m.Speed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRadio
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Speed |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Abort", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRadio
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Abort = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRadio(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRadio
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MorseProgress) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRadio
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MorseProgress: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MorseProgress: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRadio
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRadio
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RequestId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UserId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRadio
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRadio
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UserId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sent", wireType)
+			}
+			m.Sent = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRadio
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sent |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Total", wireType)
+			}
+			m.Total = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRadio
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Total |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Done", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRadio
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Done = bool(v != 0)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Aborted", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRadio
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Aborted = bool(v != 0)
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRadio
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRadio
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRadio(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRadio
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SetState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRadio
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SetState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SetState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentVfo", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRadio
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRadio
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CurrentVfo = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Vfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRadio
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRadio
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Vfo == nil {
+				m.Vfo = &Vfo{}
+			}
+			if err := m.Vfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Channel", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRadio
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRadio
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Channel == nil {
+				m.Channel = &Channel{}
+			}
+			if err := m.Channel.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VfoOperations", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRadio
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRadio
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VfoOperations = append(m.VfoOperations, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RadioOn", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRadio
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RadioOn = bool(v != 0)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ptt", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRadio
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Ptt = bool(v != 0)
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Md", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRadio
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRadio
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Md == nil {
+				m.Md = &MetaData{}
+			}
+			if err := m.Md.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PollingInterval", wireType)
+			}
+			m.PollingInterval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRadio
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PollingInterval |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UserId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRadio
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRadio
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UserId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRadio
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRadio
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RequestId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Atomic", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRadio
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Atomic = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRadio(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRadio
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Error) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRadio
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Error: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Error: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Field", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRadio
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRadio
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Field = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRadio
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRadio
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UserId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRadio
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRadio
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UserId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			m.Timestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRadio
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Timestamp |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRadio
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRadio
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RequestId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRadio(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRadio
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Alarm) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRadio
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Alarm: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Alarm: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Meter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRadio
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRadio
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Meter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var v uint32
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += 4
+			v = uint32(dAtA[iNdEx-4])
+			v |= uint32(dAtA[iNdEx-3]) << 8
+			v |= uint32(dAtA[iNdEx-2]) << 16
+			v |= uint32(dAtA[iNdEx-1]) << 24
+			m.Value = float32(math.Float32frombits(v))
+		case 3:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			var v uint32
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += 4
+			v = uint32(dAtA[iNdEx-4])
+			v |= uint32(dAtA[iNdEx-3]) << 8
+			v |= uint32(dAtA[iNdEx-2]) << 16
+			v |= uint32(dAtA[iNdEx-1]) << 24
+			m.Limit = float32(math.Float32frombits(v))
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+			}
+			m.Duration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRadio
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Duration |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Frequency", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += 8
+			v = uint64(dAtA[iNdEx-8])
+			v |= uint64(dAtA[iNdEx-7]) << 8
+			v |= uint64(dAtA[iNdEx-6]) << 16
+			v |= uint64(dAtA[iNdEx-5]) << 24
+			v |= uint64(dAtA[iNdEx-4]) << 32
+			v |= uint64(dAtA[iNdEx-3]) << 40
+			v |= uint64(dAtA[iNdEx-2]) << 48
+			v |= uint64(dAtA[iNdEx-1]) << 56
+			m.Frequency = float64(math.Float64frombits(v))
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Band", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRadio
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRadio
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Band = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UserId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRadio
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRadio
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UserId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Meters", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRadio
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRadio
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var keykey uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRadio
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				keykey |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			var stringLenmapkey uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRadio
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLenmapkey |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLenmapkey := int(stringLenmapkey)
+			if intStringLenmapkey < 0 {
+				return ErrInvalidLengthRadio
+			}
+			postStringIndexmapkey := iNdEx + intStringLenmapkey
+			if postStringIndexmapkey > l {
+				return io.ErrUnexpectedEOF
+			}
+			mapkey := string(dAtA[iNdEx:postStringIndexmapkey])
+			iNdEx = postStringIndexmapkey
+			if m.Meters == nil {
+				m.Meters = make(map[string]float32)
+			}
+			if iNdEx < postIndex {
+				var valuekey uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowRadio
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					valuekey |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				var mapvaluetemp uint32
+				if (iNdEx + 4) > l {
+					return io.ErrUnexpectedEOF
+				}
+				iNdEx += 4
+				mapvaluetemp = uint32(dAtA[iNdEx-4])
+				mapvaluetemp |= uint32(dAtA[iNdEx-3]) << 8
+				mapvaluetemp |= uint32(dAtA[iNdEx-2]) << 16
+				mapvaluetemp |= uint32(dAtA[iNdEx-1]) << 24
+				mapvalue := math.Float32frombits(mapvaluetemp)
+				m.Meters[mapkey] = mapvalue
+			} else {
+				var mapvalue float32
+				m.Meters[mapkey] = mapvalue
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rfpower", wireType)
+			}
+			var v uint32
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += 4
+			v = uint32(dAtA[iNdEx-4])
+			v |= uint32(dAtA[iNdEx-3]) << 8
+			v |= uint32(dAtA[iNdEx-2]) << 16
+			v |= uint32(dAtA[iNdEx-1]) << 24
+			m.Rfpower = float32(math.Float32frombits(v))
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			m.Timestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRadio
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Timestamp |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRadio(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRadio
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Ack) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRadio
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Ack: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Ack: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRadio
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRadio
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RequestId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UserId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRadio
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRadio
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UserId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Applied", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRadio
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRadio
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Applied = append(m.Applied, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rejected", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRadio
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRadio
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Rejected = append(m.Rejected, &Error{})
+			if err := m.Rejected[len(m.Rejected)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StateVersion", wireType)
+			}
+			m.StateVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRadio
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StateVersion |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RolledBack", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRadio
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RolledBack = bool(v != 0)
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RollbackError", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRadio
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRadio
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RollbackError = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRadio(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRadio
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Lock) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRadio
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Lock: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Lock: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UserId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRadio
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRadio
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UserId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Acquire", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRadio
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Acquire = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Takeover", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRadio
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Takeover = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRadio
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRadio
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RequestId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRadio(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRadio
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Capabilities) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRadio
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Capabilities: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Capabilities: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Vfos", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRadio
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRadio
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Vfos = append(m.Vfos, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Modes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRadio
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRadio
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Modes = append(m.Modes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VfoOps", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRadio
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRadio
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VfoOps = append(m.VfoOps, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GetFunctions", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRadio
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRadio
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GetFunctions = append(m.GetFunctions, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SetFunctions", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRadio
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRadio
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SetFunctions = append(m.SetFunctions, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GetLevels", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRadio
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRadio
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GetLevels = append(m.GetLevels, &Value{})
+			if err := m.GetLevels[len(m.GetLevels)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SetLevels", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRadio
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRadio
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SetLevels = append(m.SetLevels, &Value{})
+			if err := m.SetLevels[len(m.SetLevels)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GetParameters", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRadio
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRadio
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GetParameters = append(m.GetParameters, &Value{})
+			if err := m.GetParameters[len(m.GetParameters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SetParameters", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRadio
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRadio
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SetParameters = append(m.SetParameters, &Value{})
+			if err := m.SetParameters[len(m.SetParameters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRit", wireType)
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: State: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: State: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CurrentVfo", wireType)
+			m.MaxRit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRadio
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxRit |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			var stringLen uint64
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxXit", wireType)
+			}
+			m.MaxXit = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRadio
@@ -2291,24 +7412,33 @@ func (m *State) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				m.MaxXit |= (int32(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthRadio
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxIfShift", wireType)
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.MaxIfShift = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRadio
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxIfShift |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.CurrentVfo = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
+		case 13:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Vfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Filters", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2332,16 +7462,104 @@ func (m *State) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Vfo == nil {
-				m.Vfo = &Vfo{}
+			var keykey uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRadio
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				keykey |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if err := m.Vfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var stringLenmapkey uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRadio
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLenmapkey |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLenmapkey := int(stringLenmapkey)
+			if intStringLenmapkey < 0 {
+				return ErrInvalidLengthRadio
+			}
+			postStringIndexmapkey := iNdEx + intStringLenmapkey
+			if postStringIndexmapkey > l {
+				return io.ErrUnexpectedEOF
+			}
+			mapkey := string(dAtA[iNdEx:postStringIndexmapkey])
+			iNdEx = postStringIndexmapkey
+			if m.Filters == nil {
+				m.Filters = make(map[string]*Int32List)
+			}
+			if iNdEx < postIndex {
+				var valuekey uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowRadio
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					valuekey |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				var mapmsglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowRadio
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					mapmsglen |= (int(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if mapmsglen < 0 {
+					return ErrInvalidLengthRadio
+				}
+				postmsgIndex := iNdEx + mapmsglen
+				if mapmsglen < 0 {
+					return ErrInvalidLengthRadio
+				}
+				if postmsgIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				mapvalue := &Int32List{}
+				if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+					return err
+				}
+				iNdEx = postmsgIndex
+				m.Filters[mapkey] = mapvalue
+			} else {
+				var mapvalue *Int32List
+				m.Filters[mapkey] = mapvalue
 			}
 			iNdEx = postIndex
-		case 3:
+		case 14:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Channel", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TuningSteps", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2365,58 +7583,230 @@ func (m *State) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Channel == nil {
-				m.Channel = &Channel{}
+			var keykey uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRadio
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				keykey |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if err := m.Channel.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var stringLenmapkey uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRadio
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLenmapkey |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLenmapkey := int(stringLenmapkey)
+			if intStringLenmapkey < 0 {
+				return ErrInvalidLengthRadio
+			}
+			postStringIndexmapkey := iNdEx + intStringLenmapkey
+			if postStringIndexmapkey > l {
+				return io.ErrUnexpectedEOF
+			}
+			mapkey := string(dAtA[iNdEx:postStringIndexmapkey])
+			iNdEx = postStringIndexmapkey
+			if m.TuningSteps == nil {
+				m.TuningSteps = make(map[string]*Int32List)
+			}
+			if iNdEx < postIndex {
+				var valuekey uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowRadio
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					valuekey |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				var mapmsglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowRadio
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					mapmsglen |= (int(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if mapmsglen < 0 {
+					return ErrInvalidLengthRadio
+				}
+				postmsgIndex := iNdEx + mapmsglen
+				if mapmsglen < 0 {
+					return ErrInvalidLengthRadio
+				}
+				if postmsgIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				mapvalue := &Int32List{}
+				if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+					return err
+				}
+				iNdEx = postmsgIndex
+				m.TuningSteps[mapkey] = mapvalue
+			} else {
+				var mapvalue *Int32List
+				m.TuningSteps[mapkey] = mapvalue
 			}
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RadioOn", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRadio
+		case 15:
+			if wireType == 0 {
+				var v int32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowRadio
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= (int32(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				if iNdEx >= l {
+				m.Preamps = append(m.Preamps, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowRadio
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= (int(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthRadio
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex > l {
 					return io.ErrUnexpectedEOF
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
+				for iNdEx < postIndex {
+					var v int32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowRadio
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= (int32(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Preamps = append(m.Preamps, v)
 				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Preamps", wireType)
 			}
-			m.RadioOn = bool(v != 0)
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Ptt", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRadio
+		case 16:
+			if wireType == 0 {
+				var v int32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowRadio
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= (int32(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				if iNdEx >= l {
+				m.Attenuators = append(m.Attenuators, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowRadio
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= (int(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthRadio
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex > l {
 					return io.ErrUnexpectedEOF
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
+				for iNdEx < postIndex {
+					var v int32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowRadio
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= (int32(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Attenuators = append(m.Attenuators, v)
 				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attenuators", wireType)
 			}
-			m.Ptt = bool(v != 0)
-		case 6:
+		case 17:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PollingInterval", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RigModel", wireType)
 			}
-			m.PollingInterval = 0
+			m.RigModel = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRadio
@@ -2426,64 +7816,14 @@ func (m *State) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PollingInterval |= (int32(b) & 0x7F) << shift
+				m.RigModel |= (int32(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipRadio(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthRadio
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *SetState) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowRadio
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: SetState: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SetState: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 18:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CurrentVfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ModelName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2508,13 +7848,13 @@ func (m *SetState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CurrentVfo = string(dAtA[iNdEx:postIndex])
+			m.ModelName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 19:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Vfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRadio
@@ -2524,30 +7864,26 @@ func (m *SetState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthRadio
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Vfo == nil {
-				m.Vfo = &Vfo{}
-			}
-			if err := m.Vfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Version = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 20:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Channel", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MfgName", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRadio
@@ -2557,28 +7893,24 @@ func (m *SetState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthRadio
 			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Channel == nil {
-				m.Channel = &Channel{}
-			}
-			if err := m.Channel.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.MfgName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 21:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VfoOperations", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2603,13 +7935,13 @@ func (m *SetState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.VfoOperations = append(m.VfoOperations, string(dAtA[iNdEx:postIndex]))
+			m.Status = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 22:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RadioOn", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MemFirst", wireType)
 			}
-			var v int
+			m.MemFirst = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRadio
@@ -2619,17 +7951,16 @@ func (m *SetState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= (int(b) & 0x7F) << shift
+				m.MemFirst |= (int32(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.RadioOn = bool(v != 0)
-		case 6:
+		case 23:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Ptt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MemLast", wireType)
 			}
-			var v int
+			m.MemLast = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRadio
@@ -2639,93 +7970,247 @@ func (m *SetState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= (int(b) & 0x7F) << shift
+				m.MemLast |= (int32(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Ptt = bool(v != 0)
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Md", wireType)
+		case 24:
+			if wireType == 0 {
+				var v uint32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowRadio
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= (uint32(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.CtcssList = append(m.CtcssList, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowRadio
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= (int(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthRadio
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				for iNdEx < postIndex {
+					var v uint32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowRadio
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= (uint32(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.CtcssList = append(m.CtcssList, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field CtcssList", wireType)
 			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRadio
+		case 25:
+			if wireType == 0 {
+				var v uint32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowRadio
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= (uint32(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				if iNdEx >= l {
+				m.DcsList = append(m.DcsList, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowRadio
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= (int(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthRadio
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex > l {
 					return io.ErrUnexpectedEOF
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
+				for iNdEx < postIndex {
+					var v uint32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowRadio
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= (uint32(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.DcsList = append(m.DcsList, v)
 				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field DcsList", wireType)
 			}
-			if msglen < 0 {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRadio(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
 				return ErrInvalidLengthRadio
 			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Md == nil {
-				m.Md = &MetaData{}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Int32List) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRadio
 			}
-			if err := m.Md.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			iNdEx = postIndex
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PollingInterval", wireType)
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			m.PollingInterval = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRadio
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Int32List: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Int32List: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 14:
+			if wireType == 0 {
+				var v int32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowRadio
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= (int32(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.PollingInterval |= (int32(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
+				m.Value = append(m.Value, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowRadio
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= (int(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-			}
-		case 9:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UserId", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRadio
+				if packedLen < 0 {
+					return ErrInvalidLengthRadio
 				}
-				if iNdEx >= l {
+				postIndex := iNdEx + packedLen
+				if postIndex > l {
 					return io.ErrUnexpectedEOF
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
+				for iNdEx < postIndex {
+					var v int32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowRadio
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= (int32(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Value = append(m.Value, v)
 				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthRadio
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.UserId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRadio(dAtA[iNdEx:])
@@ -2747,7 +8232,7 @@ func (m *SetState) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Capabilities) Unmarshal(dAtA []byte) error {
+func (m *Vfo) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2770,131 +8255,33 @@ func (m *Capabilities) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Capabilities: wiretype end group for non-group")
+			return fmt.Errorf("proto: Vfo: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Capabilities: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Vfo: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Vfos", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRadio
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthRadio
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Vfos = append(m.Vfos, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Modes", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRadio
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthRadio
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Frequency", wireType)
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
+			var v uint64
+			if (iNdEx + 8) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Modes = append(m.Modes, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
+			iNdEx += 8
+			v = uint64(dAtA[iNdEx-8])
+			v |= uint64(dAtA[iNdEx-7]) << 8
+			v |= uint64(dAtA[iNdEx-6]) << 16
+			v |= uint64(dAtA[iNdEx-5]) << 24
+			v |= uint64(dAtA[iNdEx-4]) << 32
+			v |= uint64(dAtA[iNdEx-3]) << 40
+			v |= uint64(dAtA[iNdEx-2]) << 48
+			v |= uint64(dAtA[iNdEx-1]) << 56
+			m.Frequency = float64(math.Float64frombits(v))
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VfoOps", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRadio
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthRadio
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.VfoOps = append(m.VfoOps, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GetFunctions", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRadio
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthRadio
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.GetFunctions = append(m.GetFunctions, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SetFunctions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Mode", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2919,106 +8306,13 @@ func (m *Capabilities) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SetFunctions = append(m.SetFunctions, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GetLevels", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRadio
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthRadio
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.GetLevels = append(m.GetLevels, &Value{})
-			if err := m.GetLevels[len(m.GetLevels)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SetLevels", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRadio
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthRadio
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.SetLevels = append(m.SetLevels, &Value{})
-			if err := m.SetLevels[len(m.SetLevels)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 8:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GetParameters", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRadio
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthRadio
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.GetParameters = append(m.GetParameters, &Value{})
-			if err := m.GetParameters[len(m.GetParameters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 9:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SetParameters", wireType)
+			m.Mode = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PbWidth", wireType)
 			}
-			var msglen int
+			m.PbWidth = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRadio
@@ -3028,28 +8322,16 @@ func (m *Capabilities) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				m.PbWidth |= (int32(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthRadio
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.SetParameters = append(m.SetParameters, &Value{})
-			if err := m.SetParameters[len(m.SetParameters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 10:
+		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxRit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Ant", wireType)
 			}
-			m.MaxRit = 0
+			m.Ant = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRadio
@@ -3059,16 +8341,16 @@ func (m *Capabilities) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MaxRit |= (int32(b) & 0x7F) << shift
+				m.Ant |= (int32(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 11:
+		case 9:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxXit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Rit", wireType)
 			}
-			m.MaxXit = 0
+			m.Rit = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRadio
@@ -3078,16 +8360,16 @@ func (m *Capabilities) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MaxXit |= (int32(b) & 0x7F) << shift
+				m.Rit |= (int32(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 12:
+		case 10:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxIfShift", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Xit", wireType)
 			}
-			m.MaxIfShift = 0
+			m.Xit = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRadio
@@ -3097,14 +8379,14 @@ func (m *Capabilities) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MaxIfShift |= (int32(b) & 0x7F) << shift
+				m.Xit |= (int32(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 13:
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Filters", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Split", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3128,7 +8410,18 @@ func (m *Capabilities) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var keykey uint64
+			if m.Split == nil {
+				m.Split = &Split{}
+			}
+			if err := m.Split.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TuningStep", wireType)
+			}
+			m.TuningStep = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRadio
@@ -3138,12 +8431,16 @@ func (m *Capabilities) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				keykey |= (uint64(b) & 0x7F) << shift
+				m.TuningStep |= (int32(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			var stringLenmapkey uint64
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Functions", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRadio
@@ -3153,79 +8450,24 @@ func (m *Capabilities) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLenmapkey |= (uint64(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLenmapkey := int(stringLenmapkey)
-			if intStringLenmapkey < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthRadio
 			}
-			postStringIndexmapkey := iNdEx + intStringLenmapkey
-			if postStringIndexmapkey > l {
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			mapkey := string(dAtA[iNdEx:postStringIndexmapkey])
-			iNdEx = postStringIndexmapkey
-			if m.Filters == nil {
-				m.Filters = make(map[string]*Int32List)
-			}
-			if iNdEx < postIndex {
-				var valuekey uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowRadio
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					valuekey |= (uint64(b) & 0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				var mapmsglen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowRadio
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					mapmsglen |= (int(b) & 0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if mapmsglen < 0 {
-					return ErrInvalidLengthRadio
-				}
-				postmsgIndex := iNdEx + mapmsglen
-				if mapmsglen < 0 {
-					return ErrInvalidLengthRadio
-				}
-				if postmsgIndex > l {
-					return io.ErrUnexpectedEOF
-				}
-				mapvalue := &Int32List{}
-				if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
-					return err
-				}
-				iNdEx = postmsgIndex
-				m.Filters[mapkey] = mapvalue
-			} else {
-				var mapvalue *Int32List
-				m.Filters[mapkey] = mapvalue
-			}
+			m.Functions = append(m.Functions, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 14:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TuningSteps", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Levels", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3289,8 +8531,8 @@ func (m *Capabilities) Unmarshal(dAtA []byte) error {
 			}
 			mapkey := string(dAtA[iNdEx:postStringIndexmapkey])
 			iNdEx = postStringIndexmapkey
-			if m.TuningSteps == nil {
-				m.TuningSteps = make(map[string]*Int32List)
+			if m.Levels == nil {
+				m.Levels = make(map[string]float32)
 			}
 			if iNdEx < postIndex {
 				var valuekey uint64
@@ -3308,124 +8550,93 @@ func (m *Capabilities) Unmarshal(dAtA []byte) error {
 						break
 					}
 				}
-				var mapmsglen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowRadio
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					mapmsglen |= (int(b) & 0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if mapmsglen < 0 {
-					return ErrInvalidLengthRadio
-				}
-				postmsgIndex := iNdEx + mapmsglen
-				if mapmsglen < 0 {
-					return ErrInvalidLengthRadio
-				}
-				if postmsgIndex > l {
+				var mapvaluetemp uint32
+				if (iNdEx + 4) > l {
 					return io.ErrUnexpectedEOF
 				}
-				mapvalue := &Int32List{}
-				if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
-					return err
-				}
-				iNdEx = postmsgIndex
-				m.TuningSteps[mapkey] = mapvalue
+				iNdEx += 4
+				mapvaluetemp = uint32(dAtA[iNdEx-4])
+				mapvaluetemp |= uint32(dAtA[iNdEx-3]) << 8
+				mapvaluetemp |= uint32(dAtA[iNdEx-2]) << 16
+				mapvaluetemp |= uint32(dAtA[iNdEx-1]) << 24
+				mapvalue := math.Float32frombits(mapvaluetemp)
+				m.Levels[mapkey] = mapvalue
 			} else {
-				var mapvalue *Int32List
-				m.TuningSteps[mapkey] = mapvalue
+				var mapvalue float32
+				m.Levels[mapkey] = mapvalue
 			}
 			iNdEx = postIndex
 		case 15:
-			if wireType == 0 {
-				var v int32
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowRadio
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= (int32(b) & 0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Parameters", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRadio
 				}
-				m.Preamps = append(m.Preamps, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowRadio
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= (int(b) & 0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
 				}
-				if packedLen < 0 {
-					return ErrInvalidLengthRadio
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRadio
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var keykey uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRadio
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				keykey |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			var stringLenmapkey uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRadio
 				}
-				postIndex := iNdEx + packedLen
-				if postIndex > l {
+				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
 				}
-				for iNdEx < postIndex {
-					var v int32
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowRadio
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= (int32(b) & 0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.Preamps = append(m.Preamps, v)
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLenmapkey |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field Preamps", wireType)
 			}
-		case 16:
-			if wireType == 0 {
-				var v int32
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowRadio
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= (int32(b) & 0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.Attenuators = append(m.Attenuators, v)
-			} else if wireType == 2 {
-				var packedLen int
+			intStringLenmapkey := int(stringLenmapkey)
+			if intStringLenmapkey < 0 {
+				return ErrInvalidLengthRadio
+			}
+			postStringIndexmapkey := iNdEx + intStringLenmapkey
+			if postStringIndexmapkey > l {
+				return io.ErrUnexpectedEOF
+			}
+			mapkey := string(dAtA[iNdEx:postStringIndexmapkey])
+			iNdEx = postStringIndexmapkey
+			if m.Parameters == nil {
+				m.Parameters = make(map[string]float32)
+			}
+			if iNdEx < postIndex {
+				var valuekey uint64
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return ErrIntOverflowRadio
@@ -3435,44 +8646,32 @@ func (m *Capabilities) Unmarshal(dAtA []byte) error {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					packedLen |= (int(b) & 0x7F) << shift
+					valuekey |= (uint64(b) & 0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if packedLen < 0 {
-					return ErrInvalidLengthRadio
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex > l {
+				var mapvaluetemp uint32
+				if (iNdEx + 4) > l {
 					return io.ErrUnexpectedEOF
 				}
-				for iNdEx < postIndex {
-					var v int32
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowRadio
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= (int32(b) & 0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.Attenuators = append(m.Attenuators, v)
-				}
+				iNdEx += 4
+				mapvaluetemp = uint32(dAtA[iNdEx-4])
+				mapvaluetemp |= uint32(dAtA[iNdEx-3]) << 8
+				mapvaluetemp |= uint32(dAtA[iNdEx-2]) << 16
+				mapvaluetemp |= uint32(dAtA[iNdEx-1]) << 24
+				mapvalue := math.Float32frombits(mapvaluetemp)
+				m.Parameters[mapkey] = mapvalue
 			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field Attenuators", wireType)
+				var mapvalue float32
+				m.Parameters[mapkey] = mapvalue
 			}
-		case 17:
+			iNdEx = postIndex
+		case 16:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RigModel", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CtcssTone", wireType)
 			}
-			m.RigModel = 0
+			m.CtcssTone = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRadio
@@ -3482,16 +8681,16 @@ func (m *Capabilities) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.RigModel |= (int32(b) & 0x7F) << shift
+				m.CtcssTone |= (uint32(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 18:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ModelName", wireType)
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CtcssSql", wireType)
 			}
-			var stringLen uint64
+			m.CtcssSql = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRadio
@@ -3501,26 +8700,16 @@ func (m *Capabilities) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				m.CtcssSql |= (uint32(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthRadio
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ModelName = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 19:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+		case 18:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DcsCode", wireType)
 			}
-			var stringLen uint64
+			m.DcsCode = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRadio
@@ -3530,26 +8719,16 @@ func (m *Capabilities) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				m.DcsCode |= (uint32(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthRadio
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Version = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 20:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MfgName", wireType)
+		case 19:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DcsSql", wireType)
 			}
-			var stringLen uint64
+			m.DcsSql = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRadio
@@ -3559,24 +8738,14 @@ func (m *Capabilities) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				m.DcsSql |= (uint32(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthRadio
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.MfgName = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 21:
+		case 20:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RptrShift", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3601,119 +8770,26 @@ func (m *Capabilities) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Status = string(dAtA[iNdEx:postIndex])
+			m.RptrShift = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipRadio(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthRadio
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Int32List) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowRadio
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
+		case 21:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RptrOffset", wireType)
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Int32List: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Int32List: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 14:
-			if wireType == 0 {
-				var v int32
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowRadio
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= (int32(b) & 0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.Value = append(m.Value, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowRadio
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= (int(b) & 0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthRadio
+			m.RptrOffset = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRadio
 				}
-				postIndex := iNdEx + packedLen
-				if postIndex > l {
+				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
 				}
-				for iNdEx < postIndex {
-					var v int32
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowRadio
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= (int32(b) & 0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.Value = append(m.Value, v)
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RptrOffset |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
 		default:
 			iNdEx = preIndex
@@ -3736,7 +8812,7 @@ func (m *Int32List) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Status) Unmarshal(dAtA []byte) error {
+func (m *MetaData) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3759,15 +8835,15 @@ func (m *Status) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Status: wiretype end group for non-group")
+			return fmt.Errorf("proto: MetaData: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Status: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MetaData: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Online", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HasFrequency", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -3784,80 +8860,72 @@ func (m *Status) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.Online = bool(v != 0)
-		default:
-			iNdEx = preIndex
-			skippy, err := skipRadio(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthRadio
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			m.HasFrequency = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HasMode", wireType)
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Vfo) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowRadio
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRadio
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			m.HasMode = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HasPbWidth", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRadio
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Vfo: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Vfo: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 2:
-			if wireType != 1 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Frequency", wireType)
+			m.HasPbWidth = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HasAnt", wireType)
 			}
-			var v uint64
-			if (iNdEx + 8) > l {
-				return io.ErrUnexpectedEOF
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRadio
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx += 8
-			v = uint64(dAtA[iNdEx-8])
-			v |= uint64(dAtA[iNdEx-7]) << 8
-			v |= uint64(dAtA[iNdEx-6]) << 16
-			v |= uint64(dAtA[iNdEx-5]) << 24
-			v |= uint64(dAtA[iNdEx-4]) << 32
-			v |= uint64(dAtA[iNdEx-3]) << 40
-			v |= uint64(dAtA[iNdEx-2]) << 48
-			v |= uint64(dAtA[iNdEx-1]) << 56
-			m.Frequency = float64(math.Float64frombits(v))
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Mode", wireType)
+			m.HasAnt = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HasRit", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRadio
@@ -3867,26 +8935,37 @@ func (m *Vfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				v |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthRadio
+			m.HasRit = bool(v != 0)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HasXit", wireType)
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRadio
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.Mode = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
+			m.HasXit = bool(v != 0)
+		case 7:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PbWidth", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HasSplit", wireType)
 			}
-			m.PbWidth = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRadio
@@ -3896,16 +8975,17 @@ func (m *Vfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PbWidth |= (int32(b) & 0x7F) << shift
+				v |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
+			m.HasSplit = bool(v != 0)
+		case 8:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Ant", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HasTuningStep", wireType)
 			}
-			m.Ant = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRadio
@@ -3915,16 +8995,17 @@ func (m *Vfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Ant |= (int32(b) & 0x7F) << shift
+				v |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.HasTuningStep = bool(v != 0)
 		case 9:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Rit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HasFunctions", wireType)
 			}
-			m.Rit = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRadio
@@ -3934,16 +9015,17 @@ func (m *Vfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Rit |= (int32(b) & 0x7F) << shift
+				v |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.HasFunctions = bool(v != 0)
 		case 10:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Xit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HasLevels", wireType)
 			}
-			m.Xit = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRadio
@@ -3953,16 +9035,17 @@ func (m *Vfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Xit |= (int32(b) & 0x7F) << shift
+				v |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.HasLevels = bool(v != 0)
 		case 11:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Split", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HasParameters", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRadio
@@ -3972,30 +9055,37 @@ func (m *Vfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				v |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthRadio
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Split == nil {
-				m.Split = &Split{}
+			m.HasParameters = bool(v != 0)
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HasPtt", wireType)
 			}
-			if err := m.Split.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRadio
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
-		case 12:
+			m.HasPtt = bool(v != 0)
+		case 13:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TuningStep", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HasRadioOn", wireType)
 			}
-			m.TuningStep = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRadio
@@ -4005,16 +9095,17 @@ func (m *Vfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TuningStep |= (int32(b) & 0x7F) << shift
+				v |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 13:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Functions", wireType)
+			m.HasRadioOn = bool(v != 0)
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HasPollingInterval", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRadio
@@ -4024,26 +9115,17 @@ func (m *Vfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				v |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthRadio
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Functions = append(m.Functions, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		case 14:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Levels", wireType)
+			m.HasPollingInterval = bool(v != 0)
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HasCtcssTone", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRadio
@@ -4053,19 +9135,17 @@ func (m *Vfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				v |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthRadio
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.HasCtcssTone = bool(v != 0)
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HasCtcssSql", wireType)
 			}
-			var keykey uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRadio
@@ -4075,12 +9155,17 @@ func (m *Vfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				keykey |= (uint64(b) & 0x7F) << shift
+				v |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			var stringLenmapkey uint64
+			m.HasCtcssSql = bool(v != 0)
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HasDcsCode", wireType)
+			}
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRadio
@@ -4090,61 +9175,17 @@ func (m *Vfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLenmapkey |= (uint64(b) & 0x7F) << shift
+				v |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLenmapkey := int(stringLenmapkey)
-			if intStringLenmapkey < 0 {
-				return ErrInvalidLengthRadio
-			}
-			postStringIndexmapkey := iNdEx + intStringLenmapkey
-			if postStringIndexmapkey > l {
-				return io.ErrUnexpectedEOF
-			}
-			mapkey := string(dAtA[iNdEx:postStringIndexmapkey])
-			iNdEx = postStringIndexmapkey
-			if m.Levels == nil {
-				m.Levels = make(map[string]float32)
-			}
-			if iNdEx < postIndex {
-				var valuekey uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowRadio
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					valuekey |= (uint64(b) & 0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				var mapvaluetemp uint32
-				if (iNdEx + 4) > l {
-					return io.ErrUnexpectedEOF
-				}
-				iNdEx += 4
-				mapvaluetemp = uint32(dAtA[iNdEx-4])
-				mapvaluetemp |= uint32(dAtA[iNdEx-3]) << 8
-				mapvaluetemp |= uint32(dAtA[iNdEx-2]) << 16
-				mapvaluetemp |= uint32(dAtA[iNdEx-1]) << 24
-				mapvalue := math.Float32frombits(mapvaluetemp)
-				m.Levels[mapkey] = mapvalue
-			} else {
-				var mapvalue float32
-				m.Levels[mapkey] = mapvalue
-			}
-			iNdEx = postIndex
-		case 15:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Parameters", wireType)
+			m.HasDcsCode = bool(v != 0)
+		case 18:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HasDcsSql", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRadio
@@ -4154,19 +9195,17 @@ func (m *Vfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				v |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthRadio
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.HasDcsSql = bool(v != 0)
+		case 19:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HasRptrShift", wireType)
 			}
-			var keykey uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRadio
@@ -4176,12 +9215,17 @@ func (m *Vfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				keykey |= (uint64(b) & 0x7F) << shift
+				v |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			var stringLenmapkey uint64
+			m.HasRptrShift = bool(v != 0)
+		case 20:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HasRptrOffset", wireType)
+			}
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRadio
@@ -4191,56 +9235,12 @@ func (m *Vfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLenmapkey |= (uint64(b) & 0x7F) << shift
+				v |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLenmapkey := int(stringLenmapkey)
-			if intStringLenmapkey < 0 {
-				return ErrInvalidLengthRadio
-			}
-			postStringIndexmapkey := iNdEx + intStringLenmapkey
-			if postStringIndexmapkey > l {
-				return io.ErrUnexpectedEOF
-			}
-			mapkey := string(dAtA[iNdEx:postStringIndexmapkey])
-			iNdEx = postStringIndexmapkey
-			if m.Parameters == nil {
-				m.Parameters = make(map[string]float32)
-			}
-			if iNdEx < postIndex {
-				var valuekey uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowRadio
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					valuekey |= (uint64(b) & 0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				var mapvaluetemp uint32
-				if (iNdEx + 4) > l {
-					return io.ErrUnexpectedEOF
-				}
-				iNdEx += 4
-				mapvaluetemp = uint32(dAtA[iNdEx-4])
-				mapvaluetemp |= uint32(dAtA[iNdEx-3]) << 8
-				mapvaluetemp |= uint32(dAtA[iNdEx-2]) << 16
-				mapvaluetemp |= uint32(dAtA[iNdEx-1]) << 24
-				mapvalue := math.Float32frombits(mapvaluetemp)
-				m.Parameters[mapkey] = mapvalue
-			} else {
-				var mapvalue float32
-				m.Parameters[mapkey] = mapvalue
-			}
-			iNdEx = postIndex
+			m.HasRptrOffset = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRadio(dAtA[iNdEx:])
@@ -4262,7 +9262,7 @@ func (m *Vfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MetaData) Unmarshal(dAtA []byte) error {
+func (m *Channel) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4285,17 +9285,17 @@ func (m *MetaData) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MetaData: wiretype end group for non-group")
+			return fmt.Errorf("proto: Channel: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MetaData: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Channel: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HasFrequency", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Channel", wireType)
 			}
-			var v int
+			m.Channel = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRadio
@@ -4305,17 +9305,16 @@ func (m *MetaData) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= (int(b) & 0x7F) << shift
+				m.Channel |= (int32(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.HasFrequency = bool(v != 0)
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HasMode", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRadio
@@ -4325,17 +9324,44 @@ func (m *MetaData) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.HasMode = bool(v != 0)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRadio
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HasPbWidth", wireType)
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Frequency", wireType)
 			}
-			var v int
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += 8
+			v = uint64(dAtA[iNdEx-8])
+			v |= uint64(dAtA[iNdEx-7]) << 8
+			v |= uint64(dAtA[iNdEx-6]) << 16
+			v |= uint64(dAtA[iNdEx-5]) << 24
+			v |= uint64(dAtA[iNdEx-4]) << 32
+			v |= uint64(dAtA[iNdEx-3]) << 40
+			v |= uint64(dAtA[iNdEx-2]) << 48
+			v |= uint64(dAtA[iNdEx-1]) << 56
+			m.Frequency = float64(math.Float64frombits(v))
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mode", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRadio
@@ -4345,17 +9371,26 @@ func (m *MetaData) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.HasPbWidth = bool(v != 0)
-		case 4:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRadio
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Mode = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HasAnt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PbWidth", wireType)
 			}
-			var v int
+			m.PbWidth = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRadio
@@ -4365,17 +9400,16 @@ func (m *MetaData) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= (int(b) & 0x7F) << shift
+				m.PbWidth |= (int32(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.HasAnt = bool(v != 0)
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HasRit", wireType)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Split", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRadio
@@ -4385,17 +9419,30 @@ func (m *MetaData) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= (int(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.HasRit = bool(v != 0)
-		case 6:
+			if msglen < 0 {
+				return ErrInvalidLengthRadio
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Split == nil {
+				m.Split = &Split{}
+			}
+			if err := m.Split.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HasXit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CtcssTone", wireType)
 			}
-			var v int
+			m.CtcssTone = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRadio
@@ -4405,17 +9452,16 @@ func (m *MetaData) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= (int(b) & 0x7F) << shift
+				m.CtcssTone |= (uint32(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.HasXit = bool(v != 0)
-		case 7:
+		case 8:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HasSplit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CtcssSql", wireType)
 			}
-			var v int
+			m.CtcssSql = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRadio
@@ -4425,17 +9471,16 @@ func (m *MetaData) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= (int(b) & 0x7F) << shift
+				m.CtcssSql |= (uint32(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.HasSplit = bool(v != 0)
-		case 8:
+		case 9:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HasTuningStep", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DcsCode", wireType)
 			}
-			var v int
+			m.DcsCode = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRadio
@@ -4445,17 +9490,16 @@ func (m *MetaData) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= (int(b) & 0x7F) << shift
+				m.DcsCode |= (uint32(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.HasTuningStep = bool(v != 0)
-		case 9:
+		case 10:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HasFunctions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DcsSql", wireType)
 			}
-			var v int
+			m.DcsSql = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRadio
@@ -4465,17 +9509,66 @@ func (m *MetaData) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= (int(b) & 0x7F) << shift
+				m.DcsSql |= (uint32(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.HasFunctions = bool(v != 0)
-		case 10:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HasLevels", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRadio(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			var v int
+			if skippy < 0 {
+				return ErrInvalidLengthRadio
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MemoryRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRadio
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MemoryRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MemoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UserId", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRadio
@@ -4485,17 +9578,26 @@ func (m *MetaData) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.HasLevels = bool(v != 0)
-		case 11:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HasParameters", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRadio
 			}
-			var v int
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UserId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestId", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRadio
@@ -4505,17 +9607,26 @@ func (m *MetaData) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.HasParameters = bool(v != 0)
-		case 12:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HasPtt", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRadio
 			}
-			var v int
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RequestId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Operation", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRadio
@@ -4525,17 +9636,26 @@ func (m *MetaData) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.HasPtt = bool(v != 0)
-		case 13:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRadio
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Operation = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HasRadioOn", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Channel", wireType)
 			}
-			var v int
+			m.Channel = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRadio
@@ -4545,17 +9665,16 @@ func (m *MetaData) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= (int(b) & 0x7F) << shift
+				m.Channel |= (int32(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.HasRadioOn = bool(v != 0)
-		case 14:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HasPollingInterval", wireType)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRadio
@@ -4565,12 +9684,25 @@ func (m *MetaData) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= (int(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.HasPollingInterval = bool(v != 0)
+			if msglen < 0 {
+				return ErrInvalidLengthRadio
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Data == nil {
+				m.Data = &Channel{}
+			}
+			if err := m.Data.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRadio(dAtA[iNdEx:])
@@ -4592,7 +9724,7 @@ func (m *MetaData) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Channel) Unmarshal(dAtA []byte) error {
+func (m *MemoryResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4615,17 +9747,17 @@ func (m *Channel) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Channel: wiretype end group for non-group")
+			return fmt.Errorf("proto: MemoryResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Channel: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MemoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Channel", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UserId", wireType)
 			}
-			m.Channel = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRadio
@@ -4635,11 +9767,81 @@ func (m *Channel) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Channel |= (int32(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRadio
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UserId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRadio
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRadio
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RequestId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Channels", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRadio
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthRadio
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Channels = append(m.Channels, &Channel{})
+			if err := m.Channels[len(m.Channels)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRadio(dAtA[iNdEx:])
@@ -5352,82 +10554,142 @@ var (
 func init() { proto.RegisterFile("radio.proto", fileDescriptorRadio) }
 
 var fileDescriptorRadio = []byte{
-	// 1230 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x09, 0x6e, 0x88, 0x02, 0xff, 0xc4, 0x56, 0x4d, 0x8f, 0x1b, 0x45,
-	0x13, 0x7e, 0xc7, 0xde, 0xf1, 0xcc, 0x94, 0xbd, 0xde, 0x7d, 0x3b, 0x1b, 0xb6, 0x93, 0x90, 0x8d,
-	0x71, 0x14, 0xe4, 0x08, 0xb1, 0x90, 0x0f, 0x29, 0x80, 0xe0, 0x00, 0x0b, 0x91, 0x56, 0x4a, 0xc8,
-	0x6a, 0x0c, 0x01, 0x4e, 0xa3, 0xf6, 0xba, 0xc7, 0x1e, 0x65, 0x3e, 0xcc, 0x74, 0xdb, 0x38, 0x27,
-	0xfe, 0x06, 0xff, 0x08, 0x8e, 0x1c, 0x38, 0x71, 0x42, 0x41, 0xdc, 0xf8, 0x11, 0xa8, 0xaa, 0x67,
-	0xc6, 0x63, 0xc7, 0x59, 0x29, 0x12, 0x12, 0xb7, 0xae, 0xcf, 0xee, 0xaa, 0x7e, 0xea, 0xe9, 0x86,
-	0x76, 0x2e, 0xc6, 0x51, 0x76, 0x3c, 0xcb, 0x33, 0x9d, 0xb1, 0xae, 0x9a, 0x8a, 0xf3, 0x67, 0xa3,
-	0xb9, 0x3a, 0x26, 0x6d, 0xff, 0x77, 0x0b, 0xec, 0xa1, 0x16, 0x5a, 0xb2, 0x1b, 0xd0, 0x3e, 0x9f,
-	0xe7, 0xb9, 0x4c, 0x75, 0xb0, 0x08, 0x33, 0x6e, 0xf5, 0xac, 0x81, 0xe7, 0x43, 0xa1, 0x7a, 0x1a,
-	0x66, 0xec, 0x16, 0x34, 0xd1, 0xd0, 0xe8, 0x59, 0x83, 0xf6, 0xdd, 0x4b, 0xc7, 0xeb, 0x89, 0x8e,
-	0x9f, 0x86, 0x99, 0x8f, 0x76, 0x76, 0x07, 0x9c, 0xf3, 0xa9, 0x48, 0x53, 0x19, 0xf3, 0x26, 0xb9,
-	0x1e, 0x6e, 0xba, 0x9e, 0x18, 0xb3, 0x5f, 0xfa, 0xb1, 0x2b, 0xe0, 0x92, 0x25, 0xc8, 0x52, 0xbe,
-	0xd3, 0xb3, 0x06, 0xae, 0xef, 0x90, 0xfc, 0x24, 0x65, 0xfb, 0xd0, 0x9c, 0x69, 0xcd, 0x6d, 0xd2,
-	0xe2, 0x92, 0xdd, 0x86, 0xfd, 0x59, 0x16, 0xc7, 0x51, 0x3a, 0x09, 0xa2, 0x54, 0xcb, 0x7c, 0x21,
-	0x62, 0xde, 0xea, 0x59, 0x03, 0xdb, 0xdf, 0x2b, 0xf4, 0xa7, 0x85, 0xba, 0xff, 0x73, 0x03, 0xdc,
-	0xa1, 0xd4, 0xff, 0x79, 0x7d, 0xb7, 0xa0, 0xbb, 0x08, 0xb3, 0x20, 0x9b, 0xc9, 0x5c, 0xe8, 0x28,
-	0x4b, 0x15, 0xdf, 0xe9, 0x35, 0x07, 0x9e, 0xbf, 0xbb, 0x08, 0xb3, 0x27, 0x95, 0x72, 0xad, 0x0d,
-	0xf6, 0xd6, 0x36, 0xb4, 0x56, 0x6d, 0x18, 0x40, 0x23, 0x19, 0x73, 0x87, 0x4e, 0xc0, 0x37, 0x4f,
-	0xf0, 0x58, 0x6a, 0xf1, 0xb9, 0xd0, 0xc2, 0x6f, 0x24, 0xe3, 0xad, 0x0d, 0x73, 0xb7, 0x36, 0x8c,
-	0x1d, 0x82, 0x33, 0x57, 0x32, 0x0f, 0xa2, 0x31, 0xf7, 0xa8, 0x3f, 0x2d, 0x14, 0x4f, 0xc7, 0xfd,
-	0xbf, 0x1c, 0xe8, 0x9c, 0x88, 0x99, 0x18, 0x45, 0x71, 0xa4, 0x23, 0xa9, 0x18, 0x83, 0x9d, 0x45,
-	0x98, 0x29, 0x6e, 0x51, 0x21, 0xb4, 0x66, 0x07, 0x60, 0x27, 0xd9, 0x58, 0x2a, 0xde, 0x20, 0xa5,
-	0x11, 0x30, 0xa7, 0x29, 0x5e, 0xf1, 0x26, 0xe9, 0x5b, 0x54, 0xb5, 0x62, 0x37, 0x61, 0x77, 0x22,
-	0x75, 0x10, 0xce, 0xd3, 0xf3, 0x7a, 0x53, 0x3a, 0x13, 0xa9, 0x1f, 0x96, 0x3a, 0x74, 0x52, 0x6b,
-	0x4e, 0xb6, 0x71, 0x52, 0x75, 0xa7, 0xfb, 0x00, 0x98, 0x29, 0x96, 0x0b, 0x19, 0x2b, 0xde, 0xea,
-	0x35, 0x07, 0xed, 0xbb, 0x97, 0x5f, 0xba, 0x40, 0x11, 0xcf, 0xa5, 0xef, 0x4d, 0xa4, 0x7e, 0x44,
-	0x7e, 0x18, 0xa5, 0x56, 0x51, 0xce, 0x85, 0x51, 0xaa, 0x8a, 0xfa, 0x18, 0xba, 0xb8, 0xd7, 0x4c,
-	0xe4, 0x22, 0x91, 0x5a, 0xe6, 0x8a, 0xbb, 0x17, 0x45, 0x62, 0x89, 0x67, 0x95, 0x2f, 0x46, 0xab,
-	0xf5, 0x68, 0xef, 0xc2, 0x68, 0xb5, 0x16, 0x7d, 0x08, 0x4e, 0x22, 0x96, 0x41, 0x1e, 0x69, 0x0e,
-	0x74, 0x81, 0xad, 0x44, 0x2c, 0xfd, 0x48, 0x97, 0x86, 0x65, 0xa4, 0x79, 0xbb, 0x32, 0x7c, 0x1b,
-	0x69, 0xd6, 0x83, 0x0e, 0x1a, 0xa2, 0x30, 0x50, 0xd3, 0x28, 0xd4, 0xbc, 0x43, 0x56, 0x48, 0xc4,
-	0xf2, 0x34, 0x1c, 0xa2, 0x86, 0x9d, 0x80, 0x13, 0x46, 0x31, 0x1d, 0x65, 0x97, 0x8e, 0x72, 0xfb,
-	0x25, 0x38, 0xd7, 0xee, 0xfd, 0xf8, 0xa1, 0xf1, 0xfd, 0x22, 0xd5, 0xf9, 0x73, 0xbf, 0x8c, 0x64,
-	0x67, 0xd0, 0xd1, 0xf3, 0x14, 0x11, 0xa6, 0xb4, 0x9c, 0x29, 0xde, 0xa5, 0x4c, 0xef, 0x5e, 0x98,
-	0xe9, 0x2b, 0x0a, 0x18, 0xa2, 0xbf, 0xc9, 0xd6, 0xd6, 0x2b, 0x0d, 0xe3, 0xe0, 0xcc, 0x72, 0x29,
-	0x92, 0x99, 0xe2, 0x7b, 0xbd, 0xe6, 0xc0, 0xf6, 0x4b, 0x91, 0xf5, 0xa0, 0x2d, 0xb4, 0x96, 0xe9,
-	0x5c, 0xe8, 0x2c, 0x57, 0x7c, 0x9f, 0xac, 0x75, 0x15, 0xbb, 0x06, 0x5e, 0x1e, 0x4d, 0x02, 0x84,
-	0x5f, 0xcc, 0xff, 0x4f, 0x15, 0xbb, 0x79, 0x34, 0x79, 0x8c, 0x32, 0xbb, 0x0e, 0x40, 0x86, 0x20,
-	0x15, 0x89, 0xe4, 0x8c, 0x50, 0xee, 0x91, 0xe6, 0x4b, 0x91, 0x48, 0xdc, 0x77, 0x21, 0x73, 0x15,
-	0x65, 0x29, 0xbf, 0x44, 0xb6, 0x52, 0xc4, 0xe9, 0x4c, 0xc2, 0x89, 0x09, 0x3b, 0x30, 0xa6, 0x24,
-	0x9c, 0x50, 0xd0, 0x1b, 0xd0, 0x52, 0x5a, 0xe8, 0xb9, 0xe2, 0x97, 0xcd, 0xd4, 0x18, 0xe9, 0xea,
-	0xd7, 0xd0, 0xa9, 0xf7, 0x0b, 0xa7, 0xf8, 0x99, 0x7c, 0x5e, 0x50, 0x0f, 0x2e, 0xd9, 0x7b, 0x60,
-	0x2f, 0xf0, 0xa6, 0x0b, 0xd6, 0xb9, 0xb2, 0xd9, 0xb1, 0xd3, 0x54, 0xdf, 0xbb, 0xfb, 0x28, 0x52,
-	0xda, 0x37, 0x7e, 0x1f, 0x35, 0x3e, 0xb0, 0xae, 0x7e, 0x07, 0xfb, 0x9b, 0xcd, 0xfb, 0x97, 0x52,
-	0xf7, 0xdf, 0x02, 0xaf, 0xd2, 0xe3, 0x3c, 0x9b, 0x0c, 0x5d, 0xea, 0xb1, 0x11, 0xfa, 0x3d, 0x68,
-	0x0d, 0xa9, 0x3c, 0x2c, 0x3b, 0x4b, 0xe3, 0x28, 0x95, 0xb4, 0xad, 0xeb, 0x17, 0x52, 0xff, 0xef,
-	0x26, 0x34, 0x91, 0x50, 0xdf, 0x04, 0x2f, 0xcc, 0xe5, 0xf7, 0x73, 0x99, 0x9e, 0x3f, 0xa7, 0x53,
-	0x58, 0xfe, 0x4a, 0x81, 0x0c, 0x82, 0x6d, 0x27, 0x12, 0xf5, 0x7c, 0x5a, 0x63, 0x8f, 0x67, 0xa3,
-	0xe0, 0x87, 0x68, 0xac, 0xa7, 0xf4, 0x10, 0xe0, 0xb5, 0x8f, 0xbe, 0x41, 0x11, 0x0b, 0x14, 0xa9,
-	0x79, 0x08, 0x6c, 0x1f, 0x97, 0xa8, 0xc1, 0x49, 0xf0, 0x8c, 0x26, 0x8f, 0x48, 0xb3, 0xac, 0x66,
-	0x03, 0x97, 0xec, 0x1d, 0xb0, 0xd5, 0x2c, 0x2e, 0xc6, 0x62, 0xcb, 0x98, 0x0d, 0xd1, 0xe8, 0x1b,
-	0x1f, 0x7c, 0x21, 0x6a, 0x28, 0x2e, 0x67, 0x65, 0x85, 0x4a, 0x2a, 0xa8, 0x22, 0xa2, 0x5d, 0x22,
-	0xa2, 0x95, 0x82, 0x3d, 0x80, 0x56, 0xc1, 0x25, 0x06, 0xfe, 0x37, 0xb6, 0x3c, 0x21, 0xc7, 0x86,
-	0x45, 0x0c, 0xe0, 0x0b, 0x77, 0x76, 0x02, 0x50, 0x23, 0x84, 0x3d, 0x0a, 0xbe, 0xb9, 0x2d, 0x78,
-	0x45, 0x05, 0x26, 0x41, 0x2d, 0xec, 0xea, 0x87, 0xd0, 0xae, 0xe5, 0xde, 0x82, 0x87, 0x83, 0x3a,
-	0x1e, 0x1a, 0x75, 0x3c, 0x7d, 0x02, 0x7b, 0x1b, 0x99, 0x5f, 0x27, 0xbc, 0xff, 0x5b, 0x13, 0xdc,
-	0xf2, 0xc1, 0x41, 0xbe, 0x9e, 0x0a, 0x15, 0xac, 0xee, 0xdd, 0x40, 0xa3, 0x33, 0x15, 0xea, 0x61,
-	0x75, 0xf5, 0x57, 0xc0, 0x45, 0x27, 0xba, 0xfe, 0x86, 0x79, 0xe8, 0xa6, 0x42, 0xe1, 0x7c, 0x22,
-	0x61, 0xa1, 0xa9, 0x42, 0x41, 0x93, 0xcc, 0x30, 0x15, 0xea, 0xac, 0x00, 0xc2, 0x21, 0xa0, 0x73,
-	0x80, 0x60, 0x30, 0x7f, 0x85, 0xd6, 0x54, 0xa8, 0x4f, 0x53, 0x5d, 0x1a, 0x10, 0x13, 0x76, 0x65,
-	0x28, 0xd8, 0x11, 0x0d, 0x08, 0x8d, 0x56, 0x65, 0x40, 0x76, 0xbc, 0x06, 0x1e, 0x1a, 0x0c, 0x42,
-	0x1c, 0x32, 0xe1, 0xc1, 0x08, 0x14, 0xec, 0x6d, 0xd8, 0x43, 0x63, 0x1d, 0x11, 0x2e, 0xb9, 0x60,
-	0x81, 0xab, 0xf9, 0xab, 0x2a, 0xae, 0x80, 0xe1, 0xad, 0x2a, 0xae, 0xb0, 0x71, 0x1d, 0xb0, 0x84,
-	0xf2, 0xad, 0x01, 0xf2, 0xc0, 0xbd, 0x8b, 0x47, 0xe5, 0x16, 0x74, 0xa9, 0xea, 0x15, 0x0a, 0xda,
-	0xd5, 0x56, 0xeb, 0xfc, 0x4f, 0x6e, 0xda, 0x10, 0xb9, 0x29, 0xe4, 0x4c, 0xeb, 0xb2, 0x6b, 0xd5,
-	0xef, 0x61, 0xb7, 0xea, 0x9a, 0x5f, 0x7c, 0x20, 0xde, 0x87, 0x03, 0x0a, 0xdd, 0xfc, 0x08, 0x74,
-	0xc9, 0x93, 0x61, 0x9e, 0x8d, 0xcf, 0xd3, 0x4d, 0x70, 0x8a, 0x8f, 0x0c, 0x92, 0x62, 0xf9, 0xe5,
-	0xb1, 0xcc, 0x54, 0x16, 0x62, 0x7f, 0x08, 0x36, 0xbd, 0x54, 0x38, 0xcd, 0xc4, 0x8c, 0x06, 0x31,
-	0xb4, 0x46, 0x1d, 0xb5, 0xcd, 0x20, 0x86, 0xd6, 0x08, 0xac, 0x24, 0x4a, 0xe9, 0x5a, 0x1b, 0x3e,
-	0x2e, 0x49, 0x23, 0x96, 0x74, 0x97, 0xa8, 0x11, 0xcb, 0xfe, 0x7d, 0x70, 0xcb, 0xce, 0x61, 0x0e,
-	0xec, 0x6c, 0x99, 0x17, 0xd7, 0xeb, 0x50, 0x74, 0x4b, 0x5e, 0xba, 0x03, 0x36, 0x75, 0xf3, 0x35,
-	0x42, 0x1e, 0x80, 0x57, 0x75, 0x17, 0x5d, 0xa8, 0xff, 0x45, 0x9c, 0x11, 0x5e, 0x11, 0xf8, 0x23,
-	0xd8, 0x06, 0x24, 0x1c, 0x1c, 0x99, 0x8a, 0x51, 0x2c, 0xc7, 0x05, 0xd0, 0x4b, 0x11, 0xcb, 0x2a,
-	0x7f, 0x93, 0x9e, 0xf9, 0x38, 0xae, 0xd1, 0x61, 0xf3, 0x55, 0x74, 0xb8, 0x53, 0xa3, 0x43, 0x7c,
-	0x04, 0x0d, 0xea, 0x0b, 0xde, 0x2b, 0xc5, 0xcf, 0xf6, 0x7f, 0x79, 0x71, 0x64, 0xfd, 0xfa, 0xe2,
-	0xc8, 0xfa, 0xe3, 0xc5, 0x91, 0xf5, 0xd3, 0x9f, 0x47, 0xff, 0x1b, 0xb5, 0xe8, 0x7f, 0x7f, 0xef,
-	0x9f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x83, 0x7a, 0x0b, 0xa9, 0xee, 0x0b, 0x00, 0x00,
+	// 2185 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x09, 0x6e, 0x88, 0x02, 0xff, 0xc4, 0x58, 0x4f, 0x6f, 0x1c, 0x49,
+	0x15, 0xa7, 0x67, 0xa6, 0x67, 0xa6, 0xdf, 0xcc, 0xd8, 0xde, 0x8e, 0x37, 0xee, 0x24, 0x4b, 0x32,
+	0x99, 0xdd, 0x20, 0xaf, 0x56, 0x18, 0xe2, 0xac, 0xb4, 0xec, 0x0a, 0x0e, 0x89, 0x93, 0x88, 0x48,
+	0x09, 0x89, 0xca, 0x4b, 0x00, 0x71, 0x68, 0x95, 0xa7, 0xab, 0xc7, 0x8d, 0xfb, 0x5f, 0xba, 0x6a,
+	0xbc, 0xf6, 0x01, 0x71, 0xe0, 0xc4, 0x37, 0xe0, 0xc0, 0x85, 0x2b, 0x42, 0x70, 0xe2, 0x3b, 0x70,
+	0xe4, 0xc6, 0x0d, 0xa1, 0xf0, 0x21, 0xb8, 0x21, 0xf4, 0x5e, 0x55, 0xff, 0x1b, 0xc6, 0xce, 0x06,
+	0x90, 0xf6, 0x56, 0xef, 0x6f, 0xbf, 0x7a, 0xf5, 0xde, 0xef, 0x55, 0x17, 0x8c, 0x0a, 0x1e, 0x44,
+	0xd9, 0x5e, 0x5e, 0x64, 0x2a, 0x73, 0x37, 0xe4, 0x31, 0x9f, 0x9f, 0x1c, 0x2d, 0xe5, 0x1e, 0x71,
+	0x67, 0x7f, 0xeb, 0x80, 0x7d, 0xa8, 0xb8, 0x12, 0xee, 0x2d, 0x18, 0xcd, 0x97, 0x45, 0x21, 0x52,
+	0xe5, 0x9f, 0x86, 0x99, 0x67, 0x4d, 0xad, 0x5d, 0x87, 0x81, 0x61, 0xbd, 0x0c, 0x33, 0xf7, 0x0e,
+	0x74, 0x51, 0xd0, 0x99, 0x5a, 0xbb, 0xa3, 0xfd, 0x2b, 0x7b, 0x6d, 0x47, 0x7b, 0x2f, 0xc3, 0x8c,
+	0xa1, 0xdc, 0xbd, 0x0b, 0x83, 0xf9, 0x31, 0x4f, 0x53, 0x11, 0x7b, 0x5d, 0x52, 0xdd, 0x59, 0x55,
+	0x3d, 0xd0, 0x62, 0x56, 0xea, 0xb9, 0xd7, 0x60, 0x48, 0x12, 0x3f, 0x4b, 0xbd, 0xde, 0xd4, 0xda,
+	0x1d, 0xb2, 0x01, 0xd1, 0xcf, 0x53, 0x77, 0x0b, 0xba, 0xb9, 0x52, 0x9e, 0x4d, 0x5c, 0x5c, 0xba,
+	0x1f, 0xc2, 0x56, 0x9e, 0xc5, 0x71, 0x94, 0x2e, 0xfc, 0x28, 0x55, 0xa2, 0x38, 0xe5, 0xb1, 0xd7,
+	0x9f, 0x5a, 0xbb, 0x36, 0xdb, 0x34, 0xfc, 0x27, 0x86, 0xed, 0x7a, 0x30, 0x38, 0x15, 0x85, 0x8c,
+	0xb2, 0xd4, 0x1b, 0x4c, 0xad, 0xdd, 0x1e, 0x2b, 0x49, 0xf7, 0x03, 0xd8, 0xc8, 0x95, 0xf2, 0xb3,
+	0x30, 0xf4, 0x0b, 0xc1, 0x65, 0x96, 0x7a, 0x43, 0xda, 0xef, 0x38, 0x57, 0xea, 0x79, 0x18, 0x32,
+	0xe2, 0x61, 0x4a, 0xe2, 0x6c, 0x7e, 0xe2, 0x1f, 0x67, 0x71, 0x20, 0x0a, 0xcf, 0xd1, 0x29, 0x41,
+	0xd6, 0xf7, 0x89, 0xe3, 0xde, 0x86, 0x31, 0x29, 0x88, 0xb3, 0x3c, 0x2a, 0x84, 0xf4, 0x60, 0x6a,
+	0xed, 0x76, 0x19, 0x19, 0x3d, 0xd2, 0xac, 0xd9, 0xef, 0x2c, 0x00, 0x4a, 0xf0, 0x43, 0x11, 0x2b,
+	0xde, 0x0c, 0xc9, 0x6a, 0x87, 0x74, 0x1b, 0xc6, 0x47, 0x5c, 0x0a, 0xbf, 0x14, 0x77, 0x48, 0x3c,
+	0x42, 0xde, 0x4b, 0xa3, 0xe2, 0xe9, 0xd4, 0x2e, 0x44, 0xe0, 0x75, 0xa7, 0xdd, 0x5d, 0x87, 0x95,
+	0xa4, 0xfb, 0x11, 0xd8, 0x12, 0x3f, 0x42, 0xe9, 0x1b, 0xed, 0xbf, 0xbb, 0x9a, 0x72, 0x8a, 0x80,
+	0x69, 0x1d, 0x74, 0x53, 0x88, 0x24, 0x3b, 0x15, 0x81, 0x67, 0x6b, 0x37, 0x86, 0x9c, 0xfd, 0xd1,
+	0x82, 0xfe, 0x33, 0xa1, 0x44, 0x21, 0xdd, 0xf7, 0xc0, 0x51, 0x51, 0x22, 0xa4, 0xe2, 0x49, 0x4e,
+	0xa1, 0x76, 0x59, 0xcd, 0x28, 0x8f, 0xa5, 0x53, 0x1f, 0xcb, 0x67, 0xd0, 0x3f, 0xe5, 0xf1, 0x52,
+	0x48, 0x0a, 0x6d, 0xb4, 0x3f, 0x5b, 0x0d, 0x41, 0xfb, 0xdd, 0x7b, 0x49, 0x4a, 0x8f, 0x52, 0x55,
+	0x9c, 0x33, 0x63, 0x71, 0xfd, 0x53, 0x18, 0x35, 0xd8, 0xe8, 0xfc, 0x44, 0x9c, 0x9b, 0x0a, 0xc4,
+	0xa5, 0xbb, 0x0d, 0x36, 0xa9, 0xd2, 0x07, 0x3b, 0x4c, 0x13, 0x9f, 0x75, 0xbe, 0x63, 0xcd, 0x1e,
+	0xc0, 0x84, 0x09, 0x79, 0x9e, 0xce, 0x99, 0x78, 0xb5, 0x14, 0x52, 0xb9, 0x3b, 0x30, 0x58, 0x4a,
+	0x51, 0xf8, 0x51, 0x60, 0x1c, 0xf4, 0x91, 0x7c, 0x12, 0x34, 0x33, 0xdf, 0x69, 0x65, 0x7e, 0xf6,
+	0x1c, 0x06, 0x4f, 0xb3, 0xc5, 0xd3, 0x28, 0x15, 0x6f, 0xd8, 0xf5, 0x36, 0xd8, 0xb1, 0x38, 0x15,
+	0x31, 0x39, 0xb0, 0x99, 0x26, 0x30, 0xdc, 0x44, 0x2e, 0xa8, 0xd8, 0x1d, 0x86, 0xcb, 0xd9, 0x4f,
+	0x61, 0x74, 0x28, 0x14, 0xfa, 0x24, 0x85, 0x0b, 0x43, 0x5a, 0xef, 0xef, 0xeb, 0x00, 0x85, 0xde,
+	0x0c, 0x5a, 0x68, 0xb7, 0x8e, 0xe1, 0x3c, 0x09, 0x66, 0xbf, 0xb4, 0xc0, 0x39, 0x14, 0x69, 0xf0,
+	0x2c, 0x2b, 0xa4, 0xb8, 0xd8, 0x77, 0xdb, 0x4b, 0x67, 0xc5, 0x8b, 0xeb, 0x42, 0x4f, 0x89, 0x33,
+	0x65, 0xdc, 0xd3, 0x1a, 0xc3, 0x91, 0xb9, 0x10, 0x01, 0x15, 0x91, 0xcd, 0x34, 0x81, 0x5c, 0x7e,
+	0x94, 0x15, 0x65, 0x0f, 0x6a, 0x62, 0xf6, 0x27, 0x0b, 0x26, 0x14, 0xc1, 0x8b, 0x22, 0x5b, 0x14,
+	0x42, 0xca, 0x95, 0x0f, 0x5a, 0xab, 0x1f, 0x6c, 0x04, 0xda, 0x69, 0x05, 0xea, 0x42, 0x4f, 0x8a,
+	0x54, 0x47, 0x62, 0x33, 0x5a, 0xe3, 0x37, 0x55, 0xa6, 0x78, 0x5c, 0x46, 0x42, 0x04, 0x6a, 0x06,
+	0x59, 0x2a, 0x4c, 0x20, 0xb4, 0xc6, 0x53, 0xa5, 0x80, 0x44, 0x40, 0x20, 0x30, 0x64, 0x25, 0x89,
+	0x3e, 0x44, 0x51, 0x64, 0x05, 0xb5, 0xbe, 0xc3, 0x34, 0x31, 0xfb, 0x57, 0x07, 0x86, 0x87, 0x42,
+	0x7d, 0xe5, 0x90, 0x77, 0x07, 0x36, 0x4e, 0xc3, 0xcc, 0xcf, 0x72, 0x51, 0x70, 0x15, 0x65, 0xa9,
+	0xf4, 0x7a, 0xd4, 0x8a, 0x93, 0xd3, 0x30, 0x7b, 0x5e, 0x31, 0x5b, 0xc8, 0x68, 0xaf, 0x45, 0xc6,
+	0x7e, 0xdd, 0x82, 0xbb, 0xd0, 0x49, 0x02, 0xda, 0xee, 0x68, 0xdf, 0x5b, 0xd3, 0x7e, 0xfc, 0x21,
+	0x57, 0x9c, 0x75, 0x92, 0x60, 0x2d, 0x86, 0x0e, 0xd7, 0x63, 0x68, 0xe3, 0xdc, 0x9c, 0x4b, 0x0a,
+	0x0c, 0x56, 0xcf, 0xfb, 0x2a, 0xf4, 0xb9, 0xca, 0x92, 0x68, 0xee, 0x8d, 0x28, 0x42, 0x43, 0xcd,
+	0x7e, 0x65, 0x81, 0xfd, 0x08, 0x8f, 0x02, 0x0f, 0x28, 0x8c, 0x44, 0x5c, 0xd6, 0x8a, 0x26, 0xea,
+	0x63, 0xeb, 0x34, 0x8e, 0xad, 0x19, 0x45, 0xb7, 0x15, 0x45, 0xab, 0x61, 0x7b, 0xab, 0x0d, 0xdb,
+	0x8e, 0xd1, 0x5e, 0x6d, 0x25, 0x1c, 0x7e, 0xf7, 0x63, 0x5e, 0x24, 0xf8, 0xd5, 0x04, 0xf1, 0xa9,
+	0x8c, 0x85, 0x88, 0xf5, 0xb0, 0x83, 0xdc, 0x38, 0x4a, 0x22, 0x5d, 0xb1, 0x1d, 0xa6, 0x09, 0xf7,
+	0x3a, 0x0c, 0x83, 0xa5, 0x3e, 0x36, 0x13, 0x47, 0x45, 0x63, 0x90, 0x21, 0x7d, 0x35, 0x9d, 0x9f,
+	0x53, 0x14, 0x16, 0xab, 0x19, 0x58, 0xd6, 0x47, 0x3c, 0xd5, 0xf5, 0xeb, 0x30, 0x5a, 0x37, 0xf7,
+	0x3b, 0x68, 0xed, 0xf7, 0x53, 0xe8, 0x53, 0x6c, 0xd2, 0x1b, 0x12, 0xcc, 0xde, 0x5e, 0x3d, 0x67,
+	0xda, 0x8f, 0x01, 0x5b, 0x83, 0xb2, 0xda, 0x80, 0x60, 0x3f, 0xcc, 0xb3, 0x2f, 0xcc, 0x24, 0xeb,
+	0xb0, 0x92, 0x6c, 0x27, 0x11, 0x56, 0x92, 0x88, 0xe8, 0xdc, 0x70, 0xf7, 0x56, 0xe8, 0xfc, 0x4f,
+	0x0b, 0xba, 0xf7, 0xe7, 0x27, 0xff, 0x35, 0x36, 0x60, 0x77, 0xe7, 0x79, 0x1c, 0xd5, 0x03, 0xcf,
+	0x90, 0xee, 0x5d, 0x18, 0x16, 0xe2, 0x67, 0x62, 0xae, 0x08, 0xae, 0xba, 0xeb, 0x66, 0x1e, 0x55,
+	0x19, 0xab, 0xd4, 0xdc, 0xf7, 0x61, 0x42, 0xf3, 0xaf, 0x9a, 0xb0, 0x36, 0x8d, 0x81, 0x31, 0x31,
+	0xcb, 0x11, 0x7b, 0x0b, 0x46, 0x45, 0x16, 0xc7, 0x22, 0xf0, 0x8f, 0xf8, 0xfc, 0xc4, 0x74, 0x17,
+	0x68, 0xd6, 0x03, 0x3e, 0x3f, 0xc1, 0xc6, 0x45, 0x0a, 0xa5, 0x7e, 0x13, 0x5f, 0x26, 0x25, 0x97,
+	0x3e, 0x3b, 0x53, 0xd0, 0x7b, 0x9a, 0xcd, 0x4f, 0x2e, 0x1d, 0x47, 0x7c, 0xfe, 0x6a, 0x19, 0x15,
+	0xc2, 0x4c, 0xd1, 0x92, 0xc4, 0x4a, 0x52, 0xfc, 0x44, 0x64, 0xa7, 0xa2, 0xa0, 0x12, 0x1b, 0xb2,
+	0x8a, 0x5e, 0x49, 0x64, 0x6f, 0xb5, 0xa0, 0xff, 0x3a, 0x84, 0xf1, 0x01, 0xcf, 0xf9, 0x51, 0x14,
+	0x47, 0x2a, 0x12, 0x12, 0x6b, 0xeb, 0x34, 0xcc, 0xa4, 0x67, 0x51, 0xf6, 0x68, 0x4d, 0xb5, 0x9e,
+	0x05, 0x42, 0x7a, 0x1d, 0x62, 0x6a, 0x02, 0x03, 0xd5, 0x80, 0x24, 0x4d, 0xaa, 0xfb, 0x84, 0x44,
+	0x12, 0xd3, 0xb6, 0x10, 0xca, 0x0f, 0x97, 0xe9, 0xbc, 0x09, 0x54, 0xe3, 0x85, 0x50, 0x8f, 0x4b,
+	0x1e, 0xe5, 0xb6, 0xa5, 0xa4, 0x2f, 0x16, 0x63, 0xd9, 0x54, 0xfa, 0x18, 0x00, 0x3d, 0xd1, 0x94,
+	0x93, 0x5e, 0x7f, 0xfd, 0xa9, 0xd1, 0x45, 0x80, 0x39, 0x0b, 0xa1, 0x68, 0x78, 0x92, 0x95, 0xac,
+	0xad, 0x06, 0x97, 0x5a, 0xc9, 0xca, 0xea, 0xbb, 0xb0, 0x81, 0xdf, 0xca, 0x79, 0xc1, 0x5b, 0xfd,
+	0x72, 0x81, 0x25, 0x6e, 0xf1, 0x45, 0xa5, 0x8b, 0xd6, 0xb2, 0x6d, 0xed, 0x5c, 0x6a, 0x2d, 0x5b,
+	0xd6, 0x3b, 0x30, 0x48, 0xf8, 0x99, 0x5f, 0x44, 0x8a, 0x9a, 0xc9, 0x66, 0xfd, 0x84, 0x9f, 0xb1,
+	0x48, 0x95, 0x82, 0xb3, 0x48, 0x79, 0xa3, 0x4a, 0xf0, 0xe3, 0x48, 0xb9, 0x53, 0x18, 0xa3, 0x20,
+	0x0a, 0x7d, 0x79, 0x1c, 0x85, 0xca, 0x1b, 0x93, 0x14, 0x12, 0x7e, 0xf6, 0x24, 0x3c, 0x44, 0x8e,
+	0x7b, 0x00, 0x83, 0x30, 0x8a, 0x29, 0x94, 0x09, 0x85, 0xf2, 0xe1, 0x7f, 0x8c, 0x98, 0xc6, 0xb9,
+	0xef, 0x3d, 0x8e, 0xe2, 0xaa, 0x63, 0x59, 0x69, 0xe9, 0xbe, 0x80, 0xb1, 0x5a, 0xa6, 0x88, 0xfa,
+	0x52, 0x89, 0x5c, 0x7a, 0x1b, 0xe4, 0xe9, 0x9b, 0x97, 0x7a, 0xfa, 0x9c, 0x0c, 0x0e, 0x51, 0x5f,
+	0x7b, 0x1b, 0xa9, 0x9a, 0x83, 0x55, 0x9c, 0x17, 0x82, 0x27, 0xb9, 0xf4, 0x36, 0xa7, 0xdd, 0x5d,
+	0x9b, 0x95, 0xa4, 0x3b, 0x85, 0x11, 0x57, 0x4a, 0xa4, 0x4b, 0xae, 0xb2, 0x42, 0x7a, 0x5b, 0x24,
+	0x6d, 0xb2, 0xdc, 0x1b, 0xe0, 0x14, 0xd1, 0xc2, 0xc7, 0xf2, 0x8b, 0xbd, 0x77, 0x68, 0xc7, 0xc3,
+	0x22, 0x5a, 0x3c, 0x43, 0x1a, 0x0b, 0x9d, 0x04, 0x7e, 0xca, 0x13, 0xe1, 0xb9, 0xba, 0xd0, 0x89,
+	0xf3, 0x03, 0x9e, 0x88, 0xe6, 0x65, 0xee, 0x0a, 0xc9, 0x4a, 0x12, 0x27, 0x66, 0x12, 0x2e, 0xb4,
+	0xd9, 0xb6, 0x16, 0x25, 0xe1, 0x82, 0x8c, 0xae, 0x42, 0x1f, 0x7b, 0x7d, 0x29, 0xbd, 0x77, 0x75,
+	0x2b, 0x6a, 0x0a, 0x03, 0x49, 0x44, 0xe2, 0x87, 0x51, 0x21, 0x95, 0x77, 0x55, 0x07, 0x92, 0x88,
+	0xe4, 0x31, 0xd2, 0xe4, 0x4f, 0x24, 0x7e, 0xcc, 0xa5, 0xf2, 0x76, 0x48, 0x36, 0x48, 0x44, 0xf2,
+	0x94, 0x4b, 0x85, 0x31, 0xce, 0xd5, 0x5c, 0x4a, 0x3f, 0x8e, 0xa4, 0xf2, 0xbc, 0x69, 0x77, 0x77,
+	0xc2, 0x1c, 0xe2, 0x3c, 0x8d, 0xb4, 0x65, 0x30, 0x37, 0xc2, 0x6b, 0x24, 0x1c, 0x04, 0x73, 0x12,
+	0x5d, 0xff, 0x21, 0x8c, 0x9b, 0x27, 0xb4, 0x06, 0x53, 0xbf, 0xd5, 0xc4, 0xd4, 0xd1, 0xfe, 0xb5,
+	0xd5, 0x33, 0x7a, 0x92, 0xaa, 0x7b, 0xfb, 0xe8, 0xab, 0x01, 0xb7, 0xd7, 0x7f, 0x02, 0x5b, 0xab,
+	0xc7, 0xf5, 0x7f, 0x72, 0x3d, 0xbb, 0x0d, 0x4e, 0xc5, 0xaf, 0x01, 0x7f, 0x83, 0x4e, 0x55, 0x13,
+	0xb3, 0xdf, 0xd8, 0xd0, 0xc5, 0x4b, 0x53, 0x6b, 0xda, 0x75, 0xd6, 0x4c, 0x3b, 0x3c, 0xc6, 0xf2,
+	0xe2, 0x89, 0x6b, 0xcc, 0x54, 0x7e, 0xe4, 0x7f, 0x11, 0x05, 0xea, 0xd8, 0xdc, 0xf8, 0x06, 0xf9,
+	0xd1, 0x8f, 0x90, 0xc4, 0xf0, 0x79, 0xaa, 0xef, 0x9e, 0x36, 0xc3, 0x25, 0x72, 0xb0, 0xb3, 0x1c,
+	0xcd, 0x29, 0x22, 0xe2, 0x9c, 0x55, 0xbd, 0x86, 0x4b, 0xfa, 0x1d, 0xca, 0x63, 0xd3, 0x66, 0xeb,
+	0x7e, 0x87, 0x50, 0xc8, 0xb4, 0x0e, 0x42, 0x7e, 0xa3, 0x2b, 0xca, 0xde, 0xab, 0xab, 0x9c, 0x36,
+	0x54, 0x01, 0xdb, 0x84, 0x80, 0xad, 0x66, 0xb8, 0x9f, 0x40, 0xdf, 0x60, 0x93, 0x6e, 0xa7, 0x5b,
+	0x6b, 0xae, 0x89, 0x7b, 0x1a, 0x95, 0xcc, 0x3c, 0xd6, 0xea, 0xee, 0x01, 0x40, 0x03, 0x60, 0x36,
+	0xc9, 0xf8, 0xfd, 0x75, 0xc6, 0x35, 0xb4, 0x68, 0x07, 0x0d, 0xb3, 0xba, 0x06, 0x15, 0xde, 0x8c,
+	0xb7, 0xa6, 0x56, 0x55, 0x83, 0x9f, 0xe3, 0xf5, 0xf8, 0x06, 0x68, 0xc2, 0x97, 0xaf, 0x74, 0x8f,
+	0x4d, 0xd8, 0x90, 0x18, 0x87, 0xaf, 0xe2, 0xb2, 0x40, 0xe7, 0x59, 0xa0, 0x3b, 0x4c, 0x17, 0xe8,
+	0x01, 0x9e, 0xc8, 0x0e, 0xe0, 0x92, 0xac, 0xae, 0x90, 0xa4, 0x1f, 0xcc, 0xc9, 0x06, 0x07, 0x50,
+	0xae, 0x0a, 0x83, 0x53, 0xdb, 0x66, 0x00, 0xe5, 0xaa, 0xd0, 0x30, 0x85, 0xe3, 0x13, 0xc5, 0x59,
+	0x18, 0x4a, 0xa1, 0xa8, 0xcf, 0x6c, 0x46, 0x16, 0xcf, 0x89, 0x83, 0x97, 0x89, 0x46, 0x2e, 0xde,
+	0xe6, 0x32, 0x71, 0xfd, 0x7b, 0xb0, 0xb9, 0x92, 0x89, 0xb7, 0xba, 0x8b, 0xfc, 0xc1, 0x86, 0x61,
+	0x79, 0x09, 0xc6, 0x79, 0x75, 0xcc, 0xa5, 0x5f, 0xd7, 0xa9, 0x45, 0x83, 0x76, 0x7c, 0xcc, 0xe5,
+	0xe3, 0x92, 0x87, 0xf9, 0x41, 0x25, 0x2a, 0x57, 0x33, 0xa3, 0x8f, 0xb9, 0x44, 0x7c, 0x42, 0xc0,
+	0x46, 0x51, 0x55, 0xb5, 0x7a, 0x4e, 0xc3, 0x31, 0x97, 0x2f, 0x4c, 0xe1, 0xee, 0x00, 0x2a, 0xfb,
+	0x58, 0xbc, 0xfa, 0x49, 0xa3, 0x7f, 0xcc, 0xe5, 0xfd, 0x54, 0x95, 0x82, 0x22, 0xd2, 0x55, 0xad,
+	0x05, 0x2c, 0xaa, 0x04, 0x58, 0xca, 0xfd, 0x4a, 0x80, 0xd3, 0xe1, 0x06, 0x38, 0x28, 0xd0, 0x15,
+	0x3d, 0x20, 0x11, 0x06, 0x46, 0x45, 0xec, 0x7e, 0x03, 0x36, 0x51, 0xd8, 0xac, 0xe0, 0x21, 0xa9,
+	0xe0, 0x06, 0x6b, 0x34, 0xa8, 0x76, 0x5c, 0x15, 0xb2, 0x53, 0xef, 0xb8, 0xe4, 0xe1, 0xe9, 0xa2,
+	0x92, 0xa9, 0x67, 0x20, 0x0d, 0xfc, 0xb6, 0x19, 0xaa, 0x77, 0x60, 0x83, 0x76, 0x5d, 0x57, 0xed,
+	0xa8, 0xfa, 0x54, 0x7b, 0xfe, 0x91, 0x9a, 0xd2, 0x83, 0x4c, 0x6f, 0xe4, 0x85, 0x52, 0x65, 0xd6,
+	0xaa, 0x3f, 0x9a, 0x49, 0x95, 0x35, 0x66, 0x7e, 0x6a, 0xbe, 0x0d, 0xdb, 0x64, 0xba, 0xfa, 0x73,
+	0xb2, 0x41, 0x9a, 0x2e, 0xfa, 0x59, 0xf9, 0x3f, 0xf9, 0x40, 0xc7, 0xd4, 0x68, 0x82, 0xcd, 0x6a,
+	0x63, 0x07, 0x55, 0x1f, 0xcc, 0x60, 0x52, 0x6b, 0x61, 0x55, 0x6f, 0x91, 0xd2, 0xa8, 0x54, 0xc2,
+	0xd2, 0x36, 0xd1, 0x55, 0x2d, 0xf1, 0x4e, 0x15, 0xdd, 0x43, 0xd3, 0x15, 0x37, 0x61, 0x54, 0x6a,
+	0xa0, 0x0f, 0xb7, 0xca, 0xcf, 0x43, 0xdd, 0x1c, 0x26, 0x96, 0x46, 0x83, 0x5c, 0xa9, 0x62, 0x61,
+	0x55, 0x8f, 0x98, 0x13, 0x6b, 0xf6, 0xc9, 0x76, 0x95, 0x46, 0x56, 0xb5, 0xca, 0xec, 0xb7, 0x1d,
+	0x18, 0x98, 0xff, 0x46, 0xd7, 0xab, 0xff, 0x30, 0x2d, 0x0d, 0x90, 0x86, 0x44, 0x3c, 0xa5, 0x59,
+	0xa7, 0x2f, 0xce, 0xb4, 0x6e, 0x23, 0x70, 0xf7, 0x22, 0x04, 0xee, 0x5d, 0x80, 0xc0, 0x76, 0x1b,
+	0x81, 0x2b, 0x2c, 0xed, 0x7f, 0x09, 0x2c, 0x6d, 0xc3, 0xd1, 0xe0, 0x52, 0x38, 0x1a, 0x5e, 0x02,
+	0x47, 0xce, 0x85, 0x70, 0x04, 0x4d, 0x38, 0x9a, 0xfd, 0x1e, 0x9f, 0x21, 0x44, 0x92, 0x15, 0xe7,
+	0x6f, 0x7c, 0xff, 0x79, 0xc3, 0x83, 0xc8, 0x7b, 0xe0, 0x54, 0x3f, 0xe3, 0xe5, 0xa3, 0x4b, 0xc5,
+	0x68, 0xe6, 0xbf, 0xd7, 0xce, 0xff, 0x47, 0xd0, 0x0b, 0xb8, 0xe2, 0x9e, 0x7d, 0xf9, 0x8f, 0x3f,
+	0x29, 0xcd, 0x7e, 0x0e, 0x1b, 0x65, 0xb4, 0x32, 0xcf, 0xd2, 0xff, 0xe1, 0xfd, 0xe6, 0x1e, 0x0c,
+	0x4d, 0x04, 0xe5, 0x83, 0xdb, 0x85, 0x9f, 0xae, 0x14, 0x67, 0x87, 0x60, 0xd3, 0x85, 0xb5, 0x2a,
+	0x1a, 0xab, 0x51, 0x34, 0xf8, 0x0e, 0x83, 0xe8, 0xa1, 0x81, 0x93, 0xd6, 0xf4, 0xb4, 0x15, 0xa5,
+	0xe6, 0x47, 0x17, 0x97, 0xc4, 0xe1, 0x67, 0x5e, 0xcf, 0x70, 0xf8, 0xd9, 0xec, 0x63, 0x18, 0x96,
+	0x00, 0x82, 0x3e, 0x10, 0x60, 0x4a, 0xbf, 0xb8, 0x6e, 0x23, 0xf2, 0xb0, 0xbc, 0x2c, 0xdc, 0x05,
+	0x5b, 0x3f, 0x8e, 0x7d, 0x79, 0x93, 0x4f, 0xc0, 0xa9, 0x40, 0x06, 0x55, 0x08, 0x86, 0x8c, 0x9d,
+	0x26, 0x2e, 0x30, 0xfc, 0x05, 0xd8, 0x1a, 0x2b, 0x3d, 0x18, 0x88, 0x94, 0x1f, 0xc5, 0x22, 0x30,
+	0x78, 0x5f, 0x92, 0xee, 0x56, 0xfd, 0xd0, 0xe3, 0xe8, 0x37, 0x9d, 0xb7, 0xef, 0x21, 0x0f, 0xca,
+	0x9e, 0x59, 0x69, 0xa1, 0x07, 0x5b, 0x7f, 0x7e, 0x7d, 0xd3, 0xfa, 0xcb, 0xeb, 0x9b, 0xd6, 0xdf,
+	0x5f, 0xdf, 0xb4, 0x7e, 0xfd, 0x8f, 0x9b, 0x5f, 0x3b, 0xea, 0xd3, 0x6b, 0xfc, 0xbd, 0x7f, 0x0f,
+	0x00, 0x2c, 0x1f, 0xdd, 0x81, 0x9c, 0x17, 0x00, 0x00,
 }
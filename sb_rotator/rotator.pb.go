@@ -0,0 +1,1433 @@
+// Code generated by protoc-gen-gogo.
+// source: rotator.proto
+// DO NOT EDIT!
+
+/*
+	Package shackbus_rotator is a generated protocol buffer package.
+
+	It is generated from these files:
+		rotator.proto
+
+	It has these top-level messages:
+		Capabilities
+		Position
+		Request
+		Ack
+*/
+package shackbus_rotator
+
+import proto "github.com/golang/protobuf/proto"
+import fmt "fmt"
+import math "math"
+
+import io "io"
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
+
+type Capabilities struct {
+	RotModel     int32   `protobuf:"varint,1,opt,name=rot_model,json=rotModel,proto3" json:"rot_model,omitempty"`
+	ModelName    string  `protobuf:"bytes,2,opt,name=model_name,json=modelName,proto3" json:"model_name,omitempty"`
+	MfgName      string  `protobuf:"bytes,3,opt,name=mfg_name,json=mfgName,proto3" json:"mfg_name,omitempty"`
+	Version      string  `protobuf:"bytes,4,opt,name=version,proto3" json:"version,omitempty"`
+	Status       string  `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
+	MinAzimuth   float32 `protobuf:"fixed32,6,opt,name=min_azimuth,json=minAzimuth,proto3" json:"min_azimuth,omitempty"`
+	MaxAzimuth   float32 `protobuf:"fixed32,7,opt,name=max_azimuth,json=maxAzimuth,proto3" json:"max_azimuth,omitempty"`
+	MinElevation float32 `protobuf:"fixed32,8,opt,name=min_elevation,json=minElevation,proto3" json:"min_elevation,omitempty"`
+	MaxElevation float32 `protobuf:"fixed32,9,opt,name=max_elevation,json=maxElevation,proto3" json:"max_elevation,omitempty"`
+}
+
+func (m *Capabilities) Reset()                    { *m = Capabilities{} }
+func (m *Capabilities) String() string            { return proto.CompactTextString(m) }
+func (*Capabilities) ProtoMessage()               {}
+func (*Capabilities) Descriptor() ([]byte, []int) { return fileDescriptorRotator, []int{0} }
+
+func (m *Capabilities) GetRotModel() int32 {
+	if m != nil {
+		return m.RotModel
+	}
+	return 0
+}
+
+func (m *Capabilities) GetModelName() string {
+	if m != nil {
+		return m.ModelName
+	}
+	return ""
+}
+
+func (m *Capabilities) GetMfgName() string {
+	if m != nil {
+		return m.MfgName
+	}
+	return ""
+}
+
+func (m *Capabilities) GetVersion() string {
+	if m != nil {
+		return m.Version
+	}
+	return ""
+}
+
+func (m *Capabilities) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
+func (m *Capabilities) GetMinAzimuth() float32 {
+	if m != nil {
+		return m.MinAzimuth
+	}
+	return 0
+}
+
+func (m *Capabilities) GetMaxAzimuth() float32 {
+	if m != nil {
+		return m.MaxAzimuth
+	}
+	return 0
+}
+
+func (m *Capabilities) GetMinElevation() float32 {
+	if m != nil {
+		return m.MinElevation
+	}
+	return 0
+}
+
+func (m *Capabilities) GetMaxElevation() float32 {
+	if m != nil {
+		return m.MaxElevation
+	}
+	return 0
+}
+
+type Position struct {
+	Azimuth         float32 `protobuf:"fixed32,1,opt,name=azimuth,proto3" json:"azimuth,omitempty"`
+	Elevation       float32 `protobuf:"fixed32,2,opt,name=elevation,proto3" json:"elevation,omitempty"`
+	Moving          bool    `protobuf:"varint,3,opt,name=moving,proto3" json:"moving,omitempty"`
+	HasTarget       bool    `protobuf:"varint,4,opt,name=has_target,json=hasTarget,proto3" json:"has_target,omitempty"`
+	TargetAzimuth   float32 `protobuf:"fixed32,5,opt,name=target_azimuth,json=targetAzimuth,proto3" json:"target_azimuth,omitempty"`
+	TargetElevation float32 `protobuf:"fixed32,6,opt,name=target_elevation,json=targetElevation,proto3" json:"target_elevation,omitempty"`
+}
+
+func (m *Position) Reset()                    { *m = Position{} }
+func (m *Position) String() string            { return proto.CompactTextString(m) }
+func (*Position) ProtoMessage()               {}
+func (*Position) Descriptor() ([]byte, []int) { return fileDescriptorRotator, []int{1} }
+
+func (m *Position) GetAzimuth() float32 {
+	if m != nil {
+		return m.Azimuth
+	}
+	return 0
+}
+
+func (m *Position) GetElevation() float32 {
+	if m != nil {
+		return m.Elevation
+	}
+	return 0
+}
+
+func (m *Position) GetMoving() bool {
+	if m != nil {
+		return m.Moving
+	}
+	return false
+}
+
+func (m *Position) GetHasTarget() bool {
+	if m != nil {
+		return m.HasTarget
+	}
+	return false
+}
+
+func (m *Position) GetTargetAzimuth() float32 {
+	if m != nil {
+		return m.TargetAzimuth
+	}
+	return 0
+}
+
+func (m *Position) GetTargetElevation() float32 {
+	if m != nil {
+		return m.TargetElevation
+	}
+	return 0
+}
+
+type Request struct {
+	UserId    string  `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	RequestId string  `protobuf:"bytes,2,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	Operation string  `protobuf:"bytes,3,opt,name=operation,proto3" json:"operation,omitempty"`
+	Azimuth   float32 `protobuf:"fixed32,4,opt,name=azimuth,proto3" json:"azimuth,omitempty"`
+	Elevation float32 `protobuf:"fixed32,5,opt,name=elevation,proto3" json:"elevation,omitempty"`
+}
+
+func (m *Request) Reset()                    { *m = Request{} }
+func (m *Request) String() string            { return proto.CompactTextString(m) }
+func (*Request) ProtoMessage()               {}
+func (*Request) Descriptor() ([]byte, []int) { return fileDescriptorRotator, []int{2} }
+
+func (m *Request) GetUserId() string {
+	if m != nil {
+		return m.UserId
+	}
+	return ""
+}
+
+func (m *Request) GetRequestId() string {
+	if m != nil {
+		return m.RequestId
+	}
+	return ""
+}
+
+func (m *Request) GetOperation() string {
+	if m != nil {
+		return m.Operation
+	}
+	return ""
+}
+
+func (m *Request) GetAzimuth() float32 {
+	if m != nil {
+		return m.Azimuth
+	}
+	return 0
+}
+
+func (m *Request) GetElevation() float32 {
+	if m != nil {
+		return m.Elevation
+	}
+	return 0
+}
+
+type Ack struct {
+	UserId    string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	RequestId string `protobuf:"bytes,2,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	Error     string `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (m *Ack) Reset()                    { *m = Ack{} }
+func (m *Ack) String() string            { return proto.CompactTextString(m) }
+func (*Ack) ProtoMessage()               {}
+func (*Ack) Descriptor() ([]byte, []int) { return fileDescriptorRotator, []int{3} }
+
+func (m *Ack) GetUserId() string {
+	if m != nil {
+		return m.UserId
+	}
+	return ""
+}
+
+func (m *Ack) GetRequestId() string {
+	if m != nil {
+		return m.RequestId
+	}
+	return ""
+}
+
+func (m *Ack) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*Capabilities)(nil), "shackbus.rotator.Capabilities")
+	proto.RegisterType((*Position)(nil), "shackbus.rotator.Position")
+	proto.RegisterType((*Request)(nil), "shackbus.rotator.Request")
+	proto.RegisterType((*Ack)(nil), "shackbus.rotator.Ack")
+}
+func (m *Capabilities) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Capabilities) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.RotModel != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintRotator(dAtA, i, uint64(m.RotModel))
+	}
+	if len(m.ModelName) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintRotator(dAtA, i, uint64(len(m.ModelName)))
+		i += copy(dAtA[i:], m.ModelName)
+	}
+	if len(m.MfgName) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintRotator(dAtA, i, uint64(len(m.MfgName)))
+		i += copy(dAtA[i:], m.MfgName)
+	}
+	if len(m.Version) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintRotator(dAtA, i, uint64(len(m.Version)))
+		i += copy(dAtA[i:], m.Version)
+	}
+	if len(m.Status) > 0 {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintRotator(dAtA, i, uint64(len(m.Status)))
+		i += copy(dAtA[i:], m.Status)
+	}
+	if m.MinAzimuth != 0 {
+		dAtA[i] = 0x35
+		i++
+		i = encodeFixed32Rotator(dAtA, i, uint32(math.Float32bits(float32(m.MinAzimuth))))
+	}
+	if m.MaxAzimuth != 0 {
+		dAtA[i] = 0x3d
+		i++
+		i = encodeFixed32Rotator(dAtA, i, uint32(math.Float32bits(float32(m.MaxAzimuth))))
+	}
+	if m.MinElevation != 0 {
+		dAtA[i] = 0x45
+		i++
+		i = encodeFixed32Rotator(dAtA, i, uint32(math.Float32bits(float32(m.MinElevation))))
+	}
+	if m.MaxElevation != 0 {
+		dAtA[i] = 0x4d
+		i++
+		i = encodeFixed32Rotator(dAtA, i, uint32(math.Float32bits(float32(m.MaxElevation))))
+	}
+	return i, nil
+}
+
+func (m *Position) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Position) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Azimuth != 0 {
+		dAtA[i] = 0xd
+		i++
+		i = encodeFixed32Rotator(dAtA, i, uint32(math.Float32bits(float32(m.Azimuth))))
+	}
+	if m.Elevation != 0 {
+		dAtA[i] = 0x15
+		i++
+		i = encodeFixed32Rotator(dAtA, i, uint32(math.Float32bits(float32(m.Elevation))))
+	}
+	if m.Moving {
+		dAtA[i] = 0x18
+		i++
+		if m.Moving {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.HasTarget {
+		dAtA[i] = 0x20
+		i++
+		if m.HasTarget {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.TargetAzimuth != 0 {
+		dAtA[i] = 0x2d
+		i++
+		i = encodeFixed32Rotator(dAtA, i, uint32(math.Float32bits(float32(m.TargetAzimuth))))
+	}
+	if m.TargetElevation != 0 {
+		dAtA[i] = 0x35
+		i++
+		i = encodeFixed32Rotator(dAtA, i, uint32(math.Float32bits(float32(m.TargetElevation))))
+	}
+	return i, nil
+}
+
+func (m *Request) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Request) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.UserId) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintRotator(dAtA, i, uint64(len(m.UserId)))
+		i += copy(dAtA[i:], m.UserId)
+	}
+	if len(m.RequestId) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintRotator(dAtA, i, uint64(len(m.RequestId)))
+		i += copy(dAtA[i:], m.RequestId)
+	}
+	if len(m.Operation) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintRotator(dAtA, i, uint64(len(m.Operation)))
+		i += copy(dAtA[i:], m.Operation)
+	}
+	if m.Azimuth != 0 {
+		dAtA[i] = 0x25
+		i++
+		i = encodeFixed32Rotator(dAtA, i, uint32(math.Float32bits(float32(m.Azimuth))))
+	}
+	if m.Elevation != 0 {
+		dAtA[i] = 0x2d
+		i++
+		i = encodeFixed32Rotator(dAtA, i, uint32(math.Float32bits(float32(m.Elevation))))
+	}
+	return i, nil
+}
+
+func (m *Ack) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Ack) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.UserId) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintRotator(dAtA, i, uint64(len(m.UserId)))
+		i += copy(dAtA[i:], m.UserId)
+	}
+	if len(m.RequestId) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintRotator(dAtA, i, uint64(len(m.RequestId)))
+		i += copy(dAtA[i:], m.RequestId)
+	}
+	if len(m.Error) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintRotator(dAtA, i, uint64(len(m.Error)))
+		i += copy(dAtA[i:], m.Error)
+	}
+	return i, nil
+}
+
+func encodeFixed64Rotator(dAtA []byte, offset int, v uint64) int {
+	dAtA[offset] = uint8(v)
+	dAtA[offset+1] = uint8(v >> 8)
+	dAtA[offset+2] = uint8(v >> 16)
+	dAtA[offset+3] = uint8(v >> 24)
+	dAtA[offset+4] = uint8(v >> 32)
+	dAtA[offset+5] = uint8(v >> 40)
+	dAtA[offset+6] = uint8(v >> 48)
+	dAtA[offset+7] = uint8(v >> 56)
+	return offset + 8
+}
+func encodeFixed32Rotator(dAtA []byte, offset int, v uint32) int {
+	dAtA[offset] = uint8(v)
+	dAtA[offset+1] = uint8(v >> 8)
+	dAtA[offset+2] = uint8(v >> 16)
+	dAtA[offset+3] = uint8(v >> 24)
+	return offset + 4
+}
+func encodeVarintRotator(dAtA []byte, offset int, v uint64) int {
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return offset + 1
+}
+func (m *Capabilities) Size() (n int) {
+	var l int
+	_ = l
+	if m.RotModel != 0 {
+		n += 1 + sovRotator(uint64(m.RotModel))
+	}
+	l = len(m.ModelName)
+	if l > 0 {
+		n += 1 + l + sovRotator(uint64(l))
+	}
+	l = len(m.MfgName)
+	if l > 0 {
+		n += 1 + l + sovRotator(uint64(l))
+	}
+	l = len(m.Version)
+	if l > 0 {
+		n += 1 + l + sovRotator(uint64(l))
+	}
+	l = len(m.Status)
+	if l > 0 {
+		n += 1 + l + sovRotator(uint64(l))
+	}
+	if m.MinAzimuth != 0 {
+		n += 5
+	}
+	if m.MaxAzimuth != 0 {
+		n += 5
+	}
+	if m.MinElevation != 0 {
+		n += 5
+	}
+	if m.MaxElevation != 0 {
+		n += 5
+	}
+	return n
+}
+
+func (m *Position) Size() (n int) {
+	var l int
+	_ = l
+	if m.Azimuth != 0 {
+		n += 5
+	}
+	if m.Elevation != 0 {
+		n += 5
+	}
+	if m.Moving {
+		n += 2
+	}
+	if m.HasTarget {
+		n += 2
+	}
+	if m.TargetAzimuth != 0 {
+		n += 5
+	}
+	if m.TargetElevation != 0 {
+		n += 5
+	}
+	return n
+}
+
+func (m *Request) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.UserId)
+	if l > 0 {
+		n += 1 + l + sovRotator(uint64(l))
+	}
+	l = len(m.RequestId)
+	if l > 0 {
+		n += 1 + l + sovRotator(uint64(l))
+	}
+	l = len(m.Operation)
+	if l > 0 {
+		n += 1 + l + sovRotator(uint64(l))
+	}
+	if m.Azimuth != 0 {
+		n += 5
+	}
+	if m.Elevation != 0 {
+		n += 5
+	}
+	return n
+}
+
+func (m *Ack) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.UserId)
+	if l > 0 {
+		n += 1 + l + sovRotator(uint64(l))
+	}
+	l = len(m.RequestId)
+	if l > 0 {
+		n += 1 + l + sovRotator(uint64(l))
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovRotator(uint64(l))
+	}
+	return n
+}
+
+func sovRotator(x uint64) (n int) {
+	for {
+		n++
+		x >>= 7
+		if x == 0 {
+			break
+		}
+	}
+	return n
+}
+func sozRotator(x uint64) (n int) {
+	return sovRotator(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Capabilities) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRotator
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Capabilities: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Capabilities: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RotModel", wireType)
+			}
+			m.RotModel = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRotator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RotModel |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ModelName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRotator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRotator
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ModelName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MfgName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRotator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRotator
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MfgName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRotator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRotator
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Version = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRotator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRotator
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Status = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinAzimuth", wireType)
+			}
+			var v uint32
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += 4
+			v = uint32(dAtA[iNdEx-4])
+			v |= uint32(dAtA[iNdEx-3]) << 8
+			v |= uint32(dAtA[iNdEx-2]) << 16
+			v |= uint32(dAtA[iNdEx-1]) << 24
+			m.MinAzimuth = float32(math.Float32frombits(v))
+		case 7:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxAzimuth", wireType)
+			}
+			var v uint32
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += 4
+			v = uint32(dAtA[iNdEx-4])
+			v |= uint32(dAtA[iNdEx-3]) << 8
+			v |= uint32(dAtA[iNdEx-2]) << 16
+			v |= uint32(dAtA[iNdEx-1]) << 24
+			m.MaxAzimuth = float32(math.Float32frombits(v))
+		case 8:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinElevation", wireType)
+			}
+			var v uint32
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += 4
+			v = uint32(dAtA[iNdEx-4])
+			v |= uint32(dAtA[iNdEx-3]) << 8
+			v |= uint32(dAtA[iNdEx-2]) << 16
+			v |= uint32(dAtA[iNdEx-1]) << 24
+			m.MinElevation = float32(math.Float32frombits(v))
+		case 9:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxElevation", wireType)
+			}
+			var v uint32
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += 4
+			v = uint32(dAtA[iNdEx-4])
+			v |= uint32(dAtA[iNdEx-3]) << 8
+			v |= uint32(dAtA[iNdEx-2]) << 16
+			v |= uint32(dAtA[iNdEx-1]) << 24
+			m.MaxElevation = float32(math.Float32frombits(v))
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRotator(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRotator
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Position) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRotator
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Position: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Position: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Azimuth", wireType)
+			}
+			var v uint32
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += 4
+			v = uint32(dAtA[iNdEx-4])
+			v |= uint32(dAtA[iNdEx-3]) << 8
+			v |= uint32(dAtA[iNdEx-2]) << 16
+			v |= uint32(dAtA[iNdEx-1]) << 24
+			m.Azimuth = float32(math.Float32frombits(v))
+		case 2:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Elevation", wireType)
+			}
+			var v uint32
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += 4
+			v = uint32(dAtA[iNdEx-4])
+			v |= uint32(dAtA[iNdEx-3]) << 8
+			v |= uint32(dAtA[iNdEx-2]) << 16
+			v |= uint32(dAtA[iNdEx-1]) << 24
+			m.Elevation = float32(math.Float32frombits(v))
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Moving", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRotator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Moving = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HasTarget", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRotator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.HasTarget = bool(v != 0)
+		case 5:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetAzimuth", wireType)
+			}
+			var v uint32
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += 4
+			v = uint32(dAtA[iNdEx-4])
+			v |= uint32(dAtA[iNdEx-3]) << 8
+			v |= uint32(dAtA[iNdEx-2]) << 16
+			v |= uint32(dAtA[iNdEx-1]) << 24
+			m.TargetAzimuth = float32(math.Float32frombits(v))
+		case 6:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetElevation", wireType)
+			}
+			var v uint32
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += 4
+			v = uint32(dAtA[iNdEx-4])
+			v |= uint32(dAtA[iNdEx-3]) << 8
+			v |= uint32(dAtA[iNdEx-2]) << 16
+			v |= uint32(dAtA[iNdEx-1]) << 24
+			m.TargetElevation = float32(math.Float32frombits(v))
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRotator(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRotator
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Request) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRotator
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Request: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Request: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UserId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRotator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRotator
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UserId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRotator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRotator
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RequestId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Operation", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRotator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRotator
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Operation = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Azimuth", wireType)
+			}
+			var v uint32
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += 4
+			v = uint32(dAtA[iNdEx-4])
+			v |= uint32(dAtA[iNdEx-3]) << 8
+			v |= uint32(dAtA[iNdEx-2]) << 16
+			v |= uint32(dAtA[iNdEx-1]) << 24
+			m.Azimuth = float32(math.Float32frombits(v))
+		case 5:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Elevation", wireType)
+			}
+			var v uint32
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += 4
+			v = uint32(dAtA[iNdEx-4])
+			v |= uint32(dAtA[iNdEx-3]) << 8
+			v |= uint32(dAtA[iNdEx-2]) << 16
+			v |= uint32(dAtA[iNdEx-1]) << 24
+			m.Elevation = float32(math.Float32frombits(v))
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRotator(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRotator
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Ack) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRotator
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Ack: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Ack: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UserId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRotator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRotator
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UserId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRotator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRotator
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RequestId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRotator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRotator
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRotator(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRotator
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipRotator(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowRotator
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowRotator
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+			return iNdEx, nil
+		case 1:
+			iNdEx += 8
+			return iNdEx, nil
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowRotator
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			iNdEx += length
+			if length < 0 {
+				return 0, ErrInvalidLengthRotator
+			}
+			return iNdEx, nil
+		case 3:
+			for {
+				var innerWire uint64
+				var start int = iNdEx
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return 0, ErrIntOverflowRotator
+					}
+					if iNdEx >= l {
+						return 0, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					innerWire |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				innerWireType := int(innerWire & 0x7)
+				if innerWireType == 4 {
+					break
+				}
+				next, err := skipRotator(dAtA[start:])
+				if err != nil {
+					return 0, err
+				}
+				iNdEx = start + next
+			}
+			return iNdEx, nil
+		case 4:
+			return iNdEx, nil
+		case 5:
+			iNdEx += 4
+			return iNdEx, nil
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+	}
+	panic("unreachable")
+}
+
+var (
+	ErrInvalidLengthRotator = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowRotator   = fmt.Errorf("proto: integer overflow")
+)
+
+func init() { proto.RegisterFile("rotator.proto", fileDescriptorRotator) }
+
+var fileDescriptorRotator = []byte{
+	// 426 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x09, 0x6e, 0x88, 0x02, 0xff, 0x9c, 0x93, 0x41, 0x8e, 0xd3, 0x30,
+	0x14, 0x86, 0x71, 0x67, 0xd2, 0x24, 0x8f, 0x29, 0x54, 0x16, 0x82, 0x20, 0xa0, 0x54, 0x45, 0x48,
+	0x65, 0x33, 0x1b, 0x4e, 0x30, 0x20, 0x16, 0xb3, 0x00, 0xa1, 0xc0, 0x3e, 0x7a, 0x9d, 0x7a, 0x5a,
+	0x6b, 0xea, 0xb8, 0xd8, 0x4e, 0x15, 0x71, 0x11, 0xd8, 0x73, 0x19, 0x24, 0x36, 0x1c, 0x01, 0x95,
+	0x8b, 0x20, 0x3f, 0x3b, 0x4d, 0xc5, 0x82, 0x05, 0xcb, 0xff, 0xff, 0xbf, 0xd8, 0xef, 0xfd, 0x56,
+	0x60, 0x64, 0xb4, 0x43, 0xa7, 0xcd, 0xf9, 0xd6, 0x68, 0xa7, 0xf9, 0xd8, 0xae, 0xf1, 0xea, 0x66,
+	0xd1, 0xd8, 0xf3, 0xe8, 0xcf, 0xbe, 0x0d, 0xe0, 0xec, 0x35, 0x6e, 0x71, 0x21, 0x37, 0xd2, 0x49,
+	0x61, 0xf9, 0x23, 0xc8, 0x8d, 0x76, 0x95, 0xd2, 0x4b, 0xb1, 0x29, 0xd8, 0x94, 0xcd, 0x93, 0x32,
+	0x33, 0xda, 0xbd, 0xf5, 0x9a, 0x3f, 0x01, 0xa0, 0xa0, 0xaa, 0x51, 0x89, 0x62, 0x30, 0x65, 0xf3,
+	0xbc, 0xcc, 0xc9, 0x79, 0x87, 0x4a, 0xf0, 0x87, 0x90, 0xa9, 0xeb, 0x55, 0x08, 0x4f, 0x28, 0x4c,
+	0xd5, 0xf5, 0x8a, 0xa2, 0x02, 0xd2, 0x9d, 0x30, 0x56, 0xea, 0xba, 0x38, 0x0d, 0x49, 0x94, 0xfc,
+	0x3e, 0x0c, 0xad, 0x43, 0xd7, 0xd8, 0x22, 0xa1, 0x20, 0x2a, 0xfe, 0x14, 0x6e, 0x2b, 0x59, 0x57,
+	0xf8, 0x59, 0xaa, 0xc6, 0xad, 0x8b, 0xe1, 0x94, 0xcd, 0x07, 0x25, 0x28, 0x59, 0x5f, 0x04, 0x87,
+	0x00, 0x6c, 0x0f, 0x40, 0x1a, 0x01, 0x6c, 0x3b, 0xe0, 0x19, 0x8c, 0xfc, 0x09, 0x62, 0x23, 0x76,
+	0xe8, 0xfc, 0xcd, 0x19, 0x21, 0x67, 0x4a, 0xd6, 0x6f, 0x3a, 0x8f, 0x20, 0x6c, 0x8f, 0xa0, 0x3c,
+	0x42, 0xd8, 0x1e, 0xa0, 0xd9, 0x0f, 0x06, 0xd9, 0x7b, 0x6d, 0x25, 0x7d, 0x51, 0x40, 0xda, 0xdd,
+	0xc9, 0x88, 0xed, 0x24, 0x7f, 0x0c, 0x79, 0x7f, 0xce, 0x80, 0xb2, 0xde, 0xf0, 0x8b, 0x2a, 0xbd,
+	0x93, 0xf5, 0x8a, 0xba, 0xc9, 0xca, 0xa8, 0x7c, 0xa9, 0x6b, 0xb4, 0x95, 0x43, 0xb3, 0x12, 0x8e,
+	0xda, 0xc9, 0xca, 0x7c, 0x8d, 0xf6, 0x23, 0x19, 0xfc, 0x39, 0xdc, 0x09, 0xd1, 0x61, 0xd3, 0x84,
+	0x4e, 0x1e, 0x05, 0xb7, 0x5b, 0xf6, 0x05, 0x8c, 0x23, 0xd6, 0x8f, 0x10, 0x3a, 0xbb, 0x1b, 0xfc,
+	0x7e, 0x9b, 0x2f, 0x0c, 0xd2, 0x52, 0x7c, 0x6a, 0x84, 0x75, 0xfc, 0x01, 0xa4, 0x8d, 0x15, 0xa6,
+	0x92, 0x4b, 0x5a, 0x26, 0x2f, 0x87, 0x5e, 0x5e, 0x2e, 0xfd, 0x54, 0x26, 0x30, 0x3e, 0x8b, 0x4f,
+	0x1d, 0x9d, 0xcb, 0xa5, 0x5f, 0x55, 0x6f, 0x85, 0x09, 0xf7, 0x84, 0xb7, 0xee, 0x8d, 0xe3, 0x8a,
+	0x4e, 0xff, 0x51, 0x51, 0xf2, 0x57, 0x45, 0xb3, 0x0f, 0x70, 0x72, 0x71, 0x75, 0xf3, 0xdf, 0x43,
+	0xdd, 0x83, 0x44, 0x18, 0xa3, 0x4d, 0x1c, 0x28, 0x88, 0x57, 0xe3, 0xef, 0xfb, 0x09, 0xfb, 0xb9,
+	0x9f, 0xb0, 0x5f, 0xfb, 0x09, 0xfb, 0xfa, 0x7b, 0x72, 0x6b, 0x31, 0xa4, 0xbf, 0xe1, 0xe5, 0x9f,
+	0x01, 0x00, 0xab, 0xf9, 0x48, 0xe5, 0x1e, 0x03, 0x00, 0x00,
+}
@@ -31,7 +31,11 @@ var _ = math.Inf
 const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
 
 type Status struct {
-	Online bool `protobuf:"varint,1,opt,name=online,proto3" json:"online,omitempty"`
+	Online       bool   `protobuf:"varint,1,opt,name=online,proto3" json:"online,omitempty"`
+	Server       string `protobuf:"bytes,2,opt,name=server,proto3" json:"server,omitempty"`
+	Radio        string `protobuf:"bytes,3,opt,name=radio,proto3" json:"radio,omitempty"`
+	RigConnected bool   `protobuf:"varint,4,opt,name=rig_connected,json=rigConnected,proto3" json:"rig_connected,omitempty"`
+	RigError     string `protobuf:"bytes,5,opt,name=rig_error,json=rigError,proto3" json:"rig_error,omitempty"`
 }
 
 func (m *Status) Reset()                    { *m = Status{} }
@@ -46,6 +50,34 @@ func (m *Status) GetOnline() bool {
 	return false
 }
 
+func (m *Status) GetServer() string {
+	if m != nil {
+		return m.Server
+	}
+	return ""
+}
+
+func (m *Status) GetRadio() string {
+	if m != nil {
+		return m.Radio
+	}
+	return ""
+}
+
+func (m *Status) GetRigConnected() bool {
+	if m != nil {
+		return m.RigConnected
+	}
+	return false
+}
+
+func (m *Status) GetRigError() string {
+	if m != nil {
+		return m.RigError
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*Status)(nil), "shackbus.status.Status")
 }
@@ -74,6 +106,34 @@ func (m *Status) MarshalTo(dAtA []byte) (int, error) {
 		}
 		i++
 	}
+	if len(m.Server) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintStatus(dAtA, i, uint64(len(m.Server)))
+		i += copy(dAtA[i:], m.Server)
+	}
+	if len(m.Radio) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintStatus(dAtA, i, uint64(len(m.Radio)))
+		i += copy(dAtA[i:], m.Radio)
+	}
+	if m.RigConnected {
+		dAtA[i] = 0x20
+		i++
+		if m.RigConnected {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if len(m.RigError) > 0 {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintStatus(dAtA, i, uint64(len(m.RigError)))
+		i += copy(dAtA[i:], m.RigError)
+	}
 	return i, nil
 }
 
@@ -110,6 +170,21 @@ func (m *Status) Size() (n int) {
 	if m.Online {
 		n += 2
 	}
+	l = len(m.Server)
+	if l > 0 {
+		n += 1 + l + sovStatus(uint64(l))
+	}
+	l = len(m.Radio)
+	if l > 0 {
+		n += 1 + l + sovStatus(uint64(l))
+	}
+	if m.RigConnected {
+		n += 2
+	}
+	l = len(m.RigError)
+	if l > 0 {
+		n += 1 + l + sovStatus(uint64(l))
+	}
 	return n
 }
 
@@ -175,6 +250,113 @@ func (m *Status) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.Online = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Server", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStatus
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStatus
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Server = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Radio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStatus
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStatus
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Radio = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RigConnected", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStatus
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RigConnected = bool(v != 0)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RigError", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStatus
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStatus
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RigError = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipStatus(dAtA[iNdEx:])
@@ -304,12 +486,17 @@ var (
 func init() { proto.RegisterFile("status.proto", fileDescriptorStatus) }
 
 var fileDescriptorStatus = []byte{
-	// 101 bytes of a gzipped FileDescriptorProto
+	// 180 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x09, 0x6e, 0x88, 0x02, 0xff, 0xe2, 0xe2, 0x29, 0x2e, 0x49, 0x2c,
 	0x29, 0x2d, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0xe2, 0x2f, 0xce, 0x48, 0x4c, 0xce, 0x4e,
-	0x2a, 0x2d, 0xd6, 0x83, 0x08, 0x2b, 0x29, 0x70, 0xb1, 0x05, 0x83, 0x59, 0x42, 0x62, 0x5c, 0x6c,
-	0xf9, 0x79, 0x39, 0x99, 0x79, 0xa9, 0x12, 0x8c, 0x0a, 0x8c, 0x1a, 0x1c, 0x41, 0x50, 0x9e, 0x93,
-	0xc0, 0x89, 0x47, 0x72, 0x8c, 0x17, 0x1e, 0xc9, 0x31, 0x3e, 0x78, 0x24, 0xc7, 0x38, 0xe3, 0xb1,
-	0x1c, 0x43, 0x12, 0x1b, 0xd8, 0x2c, 0x63, 0x40, 0x00, 0x00, 0x00, 0xff, 0xff, 0x23, 0x83, 0xd9,
-	0xdb, 0x5b, 0x00, 0x00, 0x00,
+	0x2a, 0x2d, 0xd6, 0x83, 0x08, 0x2b, 0x4d, 0x60, 0xe4, 0x62, 0x0b, 0x06, 0x33, 0x85, 0xc4, 0xb8,
+	0xd8, 0xf2, 0xf3, 0x72, 0x32, 0xf3, 0x52, 0x25, 0x18, 0x15, 0x18, 0x35, 0x38, 0x82, 0xa0, 0x3c,
+	0x90, 0x78, 0x71, 0x6a, 0x51, 0x59, 0x6a, 0x91, 0x04, 0x93, 0x02, 0xa3, 0x06, 0x67, 0x10, 0x94,
+	0x27, 0x24, 0xc2, 0xc5, 0x5a, 0x94, 0x98, 0x92, 0x99, 0x2f, 0xc1, 0x0c, 0x16, 0x86, 0x70, 0x84,
+	0x94, 0xb9, 0x78, 0x8b, 0x32, 0xd3, 0xe3, 0x93, 0xf3, 0xf3, 0xf2, 0x52, 0x93, 0x4b, 0x52, 0x53,
+	0x24, 0x58, 0xc0, 0x86, 0xf1, 0x14, 0x65, 0xa6, 0x3b, 0xc3, 0xc4, 0x84, 0xa4, 0xb9, 0x38, 0x41,
+	0x8a, 0x52, 0x8b, 0x8a, 0xf2, 0x8b, 0x24, 0x58, 0xc1, 0xda, 0x39, 0x8a, 0x32, 0xd3, 0x5d, 0x41,
+	0x7c, 0x27, 0x81, 0x13, 0x8f, 0xe4, 0x18, 0x2f, 0x3c, 0x92, 0x63, 0x7c, 0xf0, 0x48, 0x8e, 0x71,
+	0xc6, 0x63, 0x39, 0x86, 0x24, 0x36, 0xb0, 0xe3, 0x8d, 0x01, 0x03, 0x00, 0x8b, 0xca, 0x75, 0x79,
+	0xcc, 0x00, 0x00, 0x00,
 }
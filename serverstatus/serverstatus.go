@@ -5,7 +5,7 @@ import (
 	"sync"
 
 	"github.com/cskr/pubsub"
-	"github.com/dh1tw/remoteAudio/events"
+	"github.com/dh1tw/remoteRadio/events"
 	sbStatus "github.com/dh1tw/remoteRadio/sb_status"
 )
 
@@ -22,6 +22,8 @@ func MonitorServerStatus(s Settings) {
 
 	shutdownCh := s.Events.Sub(events.Shutdown)
 
+	tracker := NewTracker()
+
 	for {
 		select {
 		case msg := <-s.ServerStatusCh:
@@ -31,10 +33,70 @@ func MonitorServerStatus(s Settings) {
 				s.Logger.Println("Unable to Unmarshal Server Status Msg", err.Error())
 				break
 			}
-			s.Events.Pub(status.Online, events.ServerOnline)
+			rigConnected := tracker.RigConnected()
+			s.Events.Pub(tracker.Update(status), events.ServerOnline)
+			if tracker.RigConnected() != rigConnected {
+				if tracker.RigConnected() {
+					s.Logger.Println("Rig connected")
+				} else {
+					s.Logger.Println("Rig disconnected:", tracker.RigError())
+				}
+				s.Events.Pub(tracker.RigConnected(), events.RigConnected)
+			}
 
 		case <-shutdownCh:
 			return
 		}
 	}
 }
+
+// Tracker combines the status of a radio with the status of the server
+// process which serves it. Since MQTT supports only one last will per
+// connection, the last will of a server only marks the server offline,
+// which implicitly takes all of its radios offline.
+type Tracker struct {
+	radioOnline  bool
+	rigConnected bool
+	rigError     string
+	server       string
+	servers      map[string]bool
+}
+
+func NewTracker() *Tracker {
+	return &Tracker{
+		servers: make(map[string]bool),
+	}
+}
+
+// Update processes a status message of either the radio or a server
+// and returns if the radio is online.
+func (t *Tracker) Update(status sbStatus.Status) bool {
+	if status.GetRadio() == "" && status.GetServer() != "" {
+		t.servers[status.GetServer()] = status.GetOnline()
+	} else {
+		t.radioOnline = status.GetOnline()
+		t.rigConnected = status.GetRigConnected()
+		t.rigError = status.GetRigError()
+		t.server = status.GetServer()
+	}
+	return t.Online()
+}
+
+// Online returns if the radio and the server serving it are online.
+func (t *Tracker) Online() bool {
+	if online, ok := t.servers[t.server]; ok && !online {
+		return false
+	}
+	return t.radioOnline
+}
+
+// RigConnected returns if the server is connected to the rig. The radio
+// can be online while the server tries to reconnect to the rig.
+func (t *Tracker) RigConnected() bool {
+	return t.rigConnected
+}
+
+// RigError returns the reason why the rig is disconnected.
+func (t *Tracker) RigError() string {
+	return t.rigError
+}
@@ -0,0 +1,33 @@
+package utils
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// MaxLogLevel is the most verbose debug level of hamlib (trace).
+const MaxLogLevel = 5
+
+// names of the hamlib debug levels, indexed by level
+var logLevelNames = []string{"NONE", "BUG", "ERR", "WARN", "VERBOSE", "TRACE"}
+
+// LogLevelName returns the name of a hamlib debug level.
+func LogLevelName(level int) string {
+	if level < 0 || level > MaxLogLevel {
+		return strconv.Itoa(level)
+	}
+	return logLevelNames[level]
+}
+
+// ParseLogLevel accepts the number or the (case insensitive) name of
+// a hamlib debug level.
+func ParseLogLevel(s string) (int, error) {
+	for level, name := range logLevelNames {
+		if strings.EqualFold(name, s) || strconv.Itoa(level) == s {
+			return level, nil
+		}
+	}
+	return 0, fmt.Errorf("invalid log level %s (0-%d or %s)", s,
+		MaxLogLevel, strings.Join(logLevelNames, ", "))
+}
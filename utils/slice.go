@@ -54,6 +54,15 @@ func Int32InSlice(a int32, list []int32) bool {
 	return false
 }
 
+func Uint32InSlice(a uint32, list []uint32) bool {
+	for _, b := range list {
+		if b == a {
+			return true
+		}
+	}
+	return false
+}
+
 func RemoveStringFromSlice(el string, slice []string) []string {
 	for i, v := range slice {
 		if v == el {
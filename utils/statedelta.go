@@ -0,0 +1,288 @@
+package utils
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	sbRadio "github.com/dh1tw/remoteRadio/sb_radio"
+)
+
+// CopyState returns a deep copy of a State.
+func CopyState(s *sbRadio.State) (sbRadio.State, error) {
+	c := sbRadio.State{}
+	data, err := s.Marshal()
+	if err != nil {
+		return c, err
+	}
+	err = c.Unmarshal(data)
+	return c, err
+}
+
+// StateDiff returns a StateDelta which contains the fields that differ
+// between the old and the new State. Levels and parameters only
+// contain the keys whose values have changed; keys which have been
+// removed are listed in StateDelta.Removed.
+func StateDiff(old, new *sbRadio.State) sbRadio.StateDelta {
+
+	d := sbRadio.StateDelta{
+		Version:     new.Version,
+		BaseVersion: old.Version,
+		Changed:     []string{},
+		State:       &sbRadio.State{Vfo: &sbRadio.Vfo{}},
+	}
+
+	changed := func(name string) {
+		d.Changed = append(d.Changed, name)
+	}
+
+	if new.CurrentVfo != old.CurrentVfo {
+		d.State.CurrentVfo = new.CurrentVfo
+		changed("current_vfo")
+	}
+	if new.RadioOn != old.RadioOn {
+		d.State.RadioOn = new.RadioOn
+		changed("radio_on")
+	}
+	if new.Ptt != old.Ptt {
+		d.State.Ptt = new.Ptt
+		changed("ptt")
+	}
+	if new.PttOffReason != old.PttOffReason {
+		d.State.PttOffReason = new.PttOffReason
+		changed("ptt_off_reason")
+	}
+	if new.PollingInterval != old.PollingInterval {
+		d.State.PollingInterval = new.PollingInterval
+		changed("polling_interval")
+	}
+	if new.LockHolder != old.LockHolder || new.LockExpires != old.LockExpires {
+		d.State.LockHolder = new.LockHolder
+		d.State.LockExpires = new.LockExpires
+		changed("lock")
+	}
+	if !reflect.DeepEqual(new.GetChannel(), old.GetChannel()) {
+		d.State.Channel = new.Channel
+		changed("channel")
+	}
+
+	oldVfo := old.GetVfo()
+	if oldVfo == nil {
+		oldVfo = &sbRadio.Vfo{}
+	}
+	newVfo := new.GetVfo()
+	if newVfo == nil {
+		newVfo = &sbRadio.Vfo{}
+	}
+	vfo := d.State.Vfo
+
+	if newVfo.Frequency != oldVfo.Frequency {
+		vfo.Frequency = newVfo.Frequency
+		changed("frequency")
+	}
+	if newVfo.Mode != oldVfo.Mode {
+		vfo.Mode = newVfo.Mode
+		changed("mode")
+	}
+	if newVfo.PbWidth != oldVfo.PbWidth {
+		vfo.PbWidth = newVfo.PbWidth
+		changed("pb_width")
+	}
+	if newVfo.Ant != oldVfo.Ant {
+		vfo.Ant = newVfo.Ant
+		changed("ant")
+	}
+	if newVfo.Rit != oldVfo.Rit {
+		vfo.Rit = newVfo.Rit
+		changed("rit")
+	}
+	if newVfo.Xit != oldVfo.Xit {
+		vfo.Xit = newVfo.Xit
+		changed("xit")
+	}
+	if !reflect.DeepEqual(newVfo.Split, oldVfo.Split) {
+		vfo.Split = newVfo.Split
+		changed("split")
+	}
+	if newVfo.TuningStep != oldVfo.TuningStep {
+		vfo.TuningStep = newVfo.TuningStep
+		changed("tuning_step")
+	}
+	if newVfo.CtcssTone != oldVfo.CtcssTone {
+		vfo.CtcssTone = newVfo.CtcssTone
+		changed("ctcss_tone")
+	}
+	if newVfo.CtcssSql != oldVfo.CtcssSql {
+		vfo.CtcssSql = newVfo.CtcssSql
+		changed("ctcss_sql")
+	}
+	if newVfo.DcsCode != oldVfo.DcsCode {
+		vfo.DcsCode = newVfo.DcsCode
+		changed("dcs_code")
+	}
+	if newVfo.DcsSql != oldVfo.DcsSql {
+		vfo.DcsSql = newVfo.DcsSql
+		changed("dcs_sql")
+	}
+	if newVfo.RptrShift != oldVfo.RptrShift {
+		vfo.RptrShift = newVfo.RptrShift
+		changed("rptr_shift")
+	}
+	if newVfo.RptrOffset != oldVfo.RptrOffset {
+		vfo.RptrOffset = newVfo.RptrOffset
+		changed("rptr_offset")
+	}
+	if len(newVfo.Functions) != len(oldVfo.Functions) ||
+		len(SliceDiff(newVfo.Functions, oldVfo.Functions)) > 0 {
+		vfo.Functions = newVfo.Functions
+		changed("functions")
+	}
+	levels := changedValues(oldVfo.Levels, newVfo.Levels)
+	removedLevels := removedKeys("levels", oldVfo.Levels, newVfo.Levels)
+	if len(levels) > 0 || len(removedLevels) > 0 {
+		vfo.Levels = levels
+		d.Removed = append(d.Removed, removedLevels...)
+		changed("levels")
+	}
+	params := changedValues(oldVfo.Parameters, newVfo.Parameters)
+	removedParams := removedKeys("parameters", oldVfo.Parameters, newVfo.Parameters)
+	if len(params) > 0 || len(removedParams) > 0 {
+		vfo.Parameters = params
+		d.Removed = append(d.Removed, removedParams...)
+		changed("parameters")
+	}
+
+	return d
+}
+
+// MergeStateDelta applies a StateDelta on a copy of the base State.
+// An error is returned if the delta doesn't apply to the version of
+// the base State, since updates have been missed.
+func MergeStateDelta(base *sbRadio.State, d *sbRadio.StateDelta) (sbRadio.State, error) {
+
+	if d.BaseVersion != base.Version {
+		return sbRadio.State{}, fmt.Errorf("missed state updates (have version %d, delta applies to %d)",
+			base.Version, d.BaseVersion)
+	}
+
+	s, err := CopyState(base)
+	if err != nil {
+		return s, err
+	}
+
+	if s.Vfo == nil {
+		s.Vfo = &sbRadio.Vfo{}
+	}
+	if s.Vfo.Levels == nil {
+		s.Vfo.Levels = make(map[string]float32)
+	}
+	if s.Vfo.Parameters == nil {
+		s.Vfo.Parameters = make(map[string]float32)
+	}
+
+	ds := d.GetState()
+	if ds == nil {
+		ds = &sbRadio.State{}
+	}
+	dVfo := ds.GetVfo()
+	if dVfo == nil {
+		dVfo = &sbRadio.Vfo{}
+	}
+
+	for _, name := range d.Changed {
+		switch name {
+		case "current_vfo":
+			s.CurrentVfo = ds.CurrentVfo
+		case "radio_on":
+			s.RadioOn = ds.RadioOn
+		case "ptt":
+			s.Ptt = ds.Ptt
+		case "ptt_off_reason":
+			s.PttOffReason = ds.PttOffReason
+		case "polling_interval":
+			s.PollingInterval = ds.PollingInterval
+		case "lock":
+			s.LockHolder = ds.LockHolder
+			s.LockExpires = ds.LockExpires
+		case "channel":
+			s.Channel = ds.Channel
+		case "frequency":
+			s.Vfo.Frequency = dVfo.Frequency
+		case "mode":
+			s.Vfo.Mode = dVfo.Mode
+		case "pb_width":
+			s.Vfo.PbWidth = dVfo.PbWidth
+		case "ant":
+			s.Vfo.Ant = dVfo.Ant
+		case "rit":
+			s.Vfo.Rit = dVfo.Rit
+		case "xit":
+			s.Vfo.Xit = dVfo.Xit
+		case "split":
+			s.Vfo.Split = dVfo.Split
+		case "tuning_step":
+			s.Vfo.TuningStep = dVfo.TuningStep
+		case "ctcss_tone":
+			s.Vfo.CtcssTone = dVfo.CtcssTone
+		case "ctcss_sql":
+			s.Vfo.CtcssSql = dVfo.CtcssSql
+		case "dcs_code":
+			s.Vfo.DcsCode = dVfo.DcsCode
+		case "dcs_sql":
+			s.Vfo.DcsSql = dVfo.DcsSql
+		case "rptr_shift":
+			s.Vfo.RptrShift = dVfo.RptrShift
+		case "rptr_offset":
+			s.Vfo.RptrOffset = dVfo.RptrOffset
+		case "functions":
+			s.Vfo.Functions = dVfo.Functions
+		case "levels":
+			for k, v := range dVfo.Levels {
+				s.Vfo.Levels[k] = v
+			}
+		case "parameters":
+			for k, v := range dVfo.Parameters {
+				s.Vfo.Parameters[k] = v
+			}
+		}
+	}
+
+	for _, key := range d.Removed {
+		switch {
+		case strings.HasPrefix(key, "levels."):
+			delete(s.Vfo.Levels, strings.TrimPrefix(key, "levels."))
+		case strings.HasPrefix(key, "parameters."):
+			delete(s.Vfo.Parameters, strings.TrimPrefix(key, "parameters."))
+		}
+	}
+
+	s.Version = d.Version
+
+	return s, nil
+}
+
+// changedValues returns the keys and values of map2 which are not
+// present or different in map1.
+func changedValues(map1, map2 map[string]float32) map[string]float32 {
+	diff := make(map[string]float32)
+	for key, value := range map2 {
+		if oldValue, found := map1[key]; !found || oldValue != value {
+			diff[key] = value
+		}
+	}
+	return diff
+}
+
+// removedKeys returns the keys of map1 which are not present in map2,
+// prefixed with the name of the map.
+func removedKeys(name string, map1, map2 map[string]float32) []string {
+	removed := []string{}
+	for key := range map1 {
+		if _, found := map2[key]; !found {
+			removed = append(removed, name+"."+key)
+		}
+	}
+	sort.Strings(removed)
+	return removed
+}
@@ -0,0 +1,193 @@
+package utils
+
+import (
+	"reflect"
+	"testing"
+
+	sbRadio "github.com/dh1tw/remoteRadio/sb_radio"
+)
+
+func testState() *sbRadio.State {
+	return &sbRadio.State{
+		Version:    7,
+		CurrentVfo: "VFOA",
+		RadioOn:    true,
+		Vfo: &sbRadio.Vfo{
+			Frequency:  14250000,
+			Mode:       "USB",
+			PbWidth:    2400,
+			Split:      &sbRadio.Split{},
+			Functions:  []string{"NB", "NR"},
+			Levels:     map[string]float32{"AF": 0.5, "RFPOWER": 1},
+			Parameters: map[string]float32{"BACKLIGHT": 0.2},
+		},
+		Channel: &sbRadio.Channel{},
+	}
+}
+
+// normalize makes States comparable which have passed through the
+// protobuf encoding.
+func normalize(s *sbRadio.State) {
+	if len(s.Vfo.Functions) == 0 {
+		s.Vfo.Functions = nil
+	}
+	if s.Vfo.Levels == nil {
+		s.Vfo.Levels = map[string]float32{}
+	}
+	if s.Vfo.Parameters == nil {
+		s.Vfo.Parameters = map[string]float32{}
+	}
+}
+
+func TestStateDiff(t *testing.T) {
+
+	tests := []struct {
+		name        string
+		modify      func(s *sbRadio.State)
+		wantChanged []string
+		wantRemoved []string
+	}{
+		{
+			name:   "unchanged",
+			modify: func(s *sbRadio.State) {},
+		},
+		{
+			name:        "frequency",
+			modify:      func(s *sbRadio.State) { s.Vfo.Frequency = 7025000 },
+			wantChanged: []string{"frequency"},
+		},
+		{
+			name: "ptt",
+			modify: func(s *sbRadio.State) {
+				s.Ptt = true
+				s.PttOffReason = "TX timeout"
+			},
+			wantChanged: []string{"ptt", "ptt_off_reason"},
+		},
+		{
+			name: "lock",
+			modify: func(s *sbRadio.State) {
+				s.LockHolder = "dh1tw"
+				s.LockExpires = 1000
+			},
+			wantChanged: []string{"lock"},
+		},
+		{
+			name:        "split",
+			modify:      func(s *sbRadio.State) { s.Vfo.Split = &sbRadio.Split{Enabled: true, Vfo: "VFOB", Frequency: 14255000} },
+			wantChanged: []string{"split"},
+		},
+		{
+			name:        "function turned off",
+			modify:      func(s *sbRadio.State) { s.Vfo.Functions = []string{"NB"} },
+			wantChanged: []string{"functions"},
+		},
+		{
+			name:        "all functions turned off",
+			modify:      func(s *sbRadio.State) { s.Vfo.Functions = []string{} },
+			wantChanged: []string{"functions"},
+		},
+		{
+			name:        "functions reordered",
+			modify:      func(s *sbRadio.State) { s.Vfo.Functions = []string{"NR", "NB"} },
+			wantChanged: nil,
+		},
+		{
+			name:        "level changed",
+			modify:      func(s *sbRadio.State) { s.Vfo.Levels["AF"] = 0.7 },
+			wantChanged: []string{"levels"},
+		},
+		{
+			name:        "level removed",
+			modify:      func(s *sbRadio.State) { delete(s.Vfo.Levels, "AF") },
+			wantChanged: []string{"levels"},
+			wantRemoved: []string{"levels.AF"},
+		},
+		{
+			name: "level added and parameters removed",
+			modify: func(s *sbRadio.State) {
+				s.Vfo.Levels["KEYSPD"] = 24
+				s.Vfo.Parameters = map[string]float32{}
+			},
+			wantChanged: []string{"levels", "parameters"},
+			wantRemoved: []string{"parameters.BACKLIGHT"},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			old := testState()
+			next, err := CopyState(old)
+			if err != nil {
+				t.Fatal(err)
+			}
+			tc.modify(&next)
+			next.Version = old.Version + 1
+
+			d := StateDiff(old, &next)
+
+			if len(d.Changed) != len(tc.wantChanged) ||
+				(len(tc.wantChanged) > 0 && !reflect.DeepEqual(d.Changed, tc.wantChanged)) {
+				t.Errorf("changed = %v, want %v", d.Changed, tc.wantChanged)
+			}
+			if len(d.Removed) != len(tc.wantRemoved) ||
+				(len(tc.wantRemoved) > 0 && !reflect.DeepEqual(d.Removed, tc.wantRemoved)) {
+				t.Errorf("removed = %v, want %v", d.Removed, tc.wantRemoved)
+			}
+
+			// the delta has to survive the wire
+			data, err := d.Marshal()
+			if err != nil {
+				t.Fatal(err)
+			}
+			wire := sbRadio.StateDelta{}
+			if err := wire.Unmarshal(data); err != nil {
+				t.Fatal(err)
+			}
+
+			merged, err := MergeStateDelta(old, &wire)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(d.Changed) == 0 {
+				// reordered functions are not a change
+				next.Vfo.Functions = old.Vfo.Functions
+			}
+			normalize(&merged)
+			normalize(&next)
+			if !reflect.DeepEqual(merged.Vfo, next.Vfo) {
+				t.Errorf("merged vfo = %v, want %v", merged.Vfo, next.Vfo)
+			}
+			merged.Vfo, next.Vfo = nil, nil
+			if !reflect.DeepEqual(merged, next) {
+				t.Errorf("merged state = %v, want %v", merged, next)
+			}
+		})
+	}
+}
+
+func TestMergeStateDeltaVersion(t *testing.T) {
+
+	tests := []struct {
+		name        string
+		baseVersion uint64
+		wantErr     bool
+	}{
+		{"matching version", 7, false},
+		{"missed updates", 6, true},
+		{"newer base", 8, true},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			d := sbRadio.StateDelta{Version: tc.baseVersion + 1, BaseVersion: tc.baseVersion}
+			s, err := MergeStateDelta(testState(), &d)
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("MergeStateDelta() error = %v, wantErr %v", err, tc.wantErr)
+			}
+			if err == nil && s.Version != d.Version {
+				t.Errorf("version = %d, want %d", s.Version, d.Version)
+			}
+		})
+	}
+}